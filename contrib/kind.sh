@@ -988,11 +988,13 @@ install_ovn() {
   pushd ${MANIFEST_OUTPUT_DIR}
 
   run_kubectl apply -f k8s.ovn.org_egressfirewalls.yaml
+  run_kubectl apply -f k8s.ovn.org_clusteregressfirewalls.yaml
   run_kubectl apply -f k8s.ovn.org_egressips.yaml
   run_kubectl apply -f k8s.ovn.org_egressqoses.yaml
   run_kubectl apply -f k8s.ovn.org_egressservices.yaml
   run_kubectl apply -f k8s.ovn.org_adminpolicybasedexternalroutes.yaml
   run_kubectl apply -f k8s.ovn.org_networkqoses.yaml
+  run_kubectl apply -f k8s.ovn.org_observabilityconfigs.yaml
   run_kubectl apply -f k8s.ovn.org_userdefinednetworks.yaml
   run_kubectl apply -f k8s.ovn.org_clusteruserdefinednetworks.yaml
   run_kubectl apply -f k8s.ovn.org_routeadvertisements.yaml
@@ -79,6 +79,7 @@ OVN_V4_MASQUERADE_SUBNET=""
 OVN_V6_MASQUERADE_SUBNET=""
 OVN_V4_TRANSIT_SWITCH_SUBNET=""
 OVN_V6_TRANSIT_SWITCH_SUBNET=""
+OVN_UDN_ALLOWED_LOCALNETS=""
 OVN_NETFLOW_TARGETS=""
 OVN_SFLOW_TARGETS=""
 OVN_IPFIX_TARGETS=""
@@ -300,6 +301,9 @@ while [ "$1" != "" ]; do
   --v6-transit-switch-subnet)
     OVN_V6_TRANSIT_SWITCH_SUBNET=$VALUE
     ;; 
+  --udn-allowed-localnets)
+    OVN_UDN_ALLOWED_LOCALNETS=$VALUE
+    ;;
   --netflow-targets)
     OVN_NETFLOW_TARGETS=$VALUE
     ;;
@@ -528,6 +532,8 @@ ovn_v4_transit_switch_subnet=${OVN_V4_TRANSIT_SWITCH_SUBNET}
 echo "ovn_v4_transit_switch_subnet: ${ovn_v4_transit_switch_subnet}"
 ovn_v6_transit_switch_subnet=${OVN_V6_TRANSIT_SWITCH_SUBNET}
 echo "ovn_v6_transit_switch_subnet: ${ovn_v6_transit_switch_subnet}"
+ovn_udn_allowed_localnets=${OVN_UDN_ALLOWED_LOCALNETS}
+echo "ovn_udn_allowed_localnets: ${ovn_udn_allowed_localnets}"
 ovn_netflow_targets=${OVN_NETFLOW_TARGETS}
 echo "ovn_netflow_targets: ${ovn_netflow_targets}"
 ovn_sflow_targets=${OVN_SFLOW_TARGETS}
@@ -826,6 +832,7 @@ ovn_image=${ovnkube_image} \
   ovn_network_qos_enable=${ovn_network_qos_enable} \
   ovn_v4_transit_switch_subnet=${ovn_v4_transit_switch_subnet} \
   ovn_v6_transit_switch_subnet=${ovn_v6_transit_switch_subnet} \
+  ovn_udn_allowed_localnets=${ovn_udn_allowed_localnets} \
   ovn_enable_persistent_ips=${ovn_enable_persistent_ips} \
   ovn_enable_dnsnameresolver=${ovn_enable_dnsnameresolver} \
   ovn_observ_enable=${ovn_observ_enable} \
@@ -1070,11 +1077,13 @@ cp ../templates/rbac-ovnkube-identity.yaml.j2 ${output_dir}/rbac-ovnkube-identit
 cp ../templates/rbac-ovnkube-db.yaml.j2 ${output_dir}/rbac-ovnkube-db.yaml
 cp ../templates/ovnkube-monitor.yaml.j2 ${output_dir}/ovnkube-monitor.yaml
 cp ../templates/k8s.ovn.org_egressfirewalls.yaml.j2 ${output_dir}/k8s.ovn.org_egressfirewalls.yaml
+cp ../templates/k8s.ovn.org_clusteregressfirewalls.yaml.j2 ${output_dir}/k8s.ovn.org_clusteregressfirewalls.yaml
 cp ../templates/k8s.ovn.org_egressips.yaml.j2 ${output_dir}/k8s.ovn.org_egressips.yaml
 cp ../templates/k8s.ovn.org_egressqoses.yaml.j2 ${output_dir}/k8s.ovn.org_egressqoses.yaml
 cp ../templates/k8s.ovn.org_egressservices.yaml.j2 ${output_dir}/k8s.ovn.org_egressservices.yaml
 cp ../templates/k8s.ovn.org_adminpolicybasedexternalroutes.yaml.j2 ${output_dir}/k8s.ovn.org_adminpolicybasedexternalroutes.yaml
 cp ../templates/k8s.ovn.org_networkqoses.yaml.j2 ${output_dir}/k8s.ovn.org_networkqoses.yaml
+cp ../templates/k8s.ovn.org_observabilityconfigs.yaml.j2 ${output_dir}/k8s.ovn.org_observabilityconfigs.yaml
 cp ../templates/k8s.ovn.org_userdefinednetworks.yaml.j2 ${output_dir}/k8s.ovn.org_userdefinednetworks.yaml
 cp ../templates/k8s.ovn.org_clusteruserdefinednetworks.yaml.j2 ${output_dir}/k8s.ovn.org_clusteruserdefinednetworks.yaml
 cp ../templates/k8s.ovn.org_routeadvertisements.yaml.j2 ${output_dir}/k8s.ovn.org_routeadvertisements.yaml
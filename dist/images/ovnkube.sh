@@ -242,6 +242,8 @@ ovn_v6_masquerade_subnet=${OVN_V6_MASQUERADE_SUBNET:-}
 ovn_v4_transit_switch_subnet=${OVN_V4_TRANSIT_SWITCH_SUBNET:-}
 # OVN_V6_TRANSIT_SWITCH_SUBNET - v6 Transit switch subnet
 ovn_v6_transit_switch_subnet=${OVN_V6_TRANSIT_SWITCH_SUBNET:-}
+# OVN_UDN_ALLOWED_LOCALNETS - physicalNetworkName[:vlanID] pairs allowed for Localnet UserDefinedNetworks
+ovn_udn_allowed_localnets=${OVN_UDN_ALLOWED_LOCALNETS:-}
 #OVN_REMOTE_PROBE_INTERVAL - ovn remote probe interval in ms (default 100000)
 ovn_remote_probe_interval=${OVN_REMOTE_PROBE_INTERVAL:-100000}
 #OVN_MONITOR_ALL - ovn-controller monitor all data in SB DB
@@ -2228,6 +2230,12 @@ ovn-cluster-manager() {
   fi
   echo "ovn_v6_transit_switch_subnet_opt=${ovn_v6_transit_switch_subnet}"
 
+  ovn_udn_allowed_localnets_opt=
+  if [[ -n ${ovn_udn_allowed_localnets} ]]; then
+      ovn_udn_allowed_localnets_opt="--cluster-manager-udn-allowed-localnets=${ovn_udn_allowed_localnets}"
+  fi
+  echo "ovn_udn_allowed_localnets_opt=${ovn_udn_allowed_localnets_opt}"
+
   multicast_enabled_flag=
   if [[ ${ovn_multicast_enable} == "true" ]]; then
       multicast_enabled_flag="--enable-multicast"
@@ -2325,6 +2333,7 @@ ovn-cluster-manager() {
     ${ovn_v6_masquerade_subnet_opt} \
     ${ovn_v4_transit_switch_subnet_opt} \
     ${ovn_v6_transit_switch_subnet_opt} \
+    ${ovn_udn_allowed_localnets_opt} \
     ${network_qos_enabled_flag} \
     ${ovn_enable_dnsnameresolver_flag} \
     --gateway-mode=${ovn_gateway_mode} \
@@ -20,10 +20,14 @@ func main() {
 
 	p := cni.NewCNIPlugin("")
 	c.Action = func(_ *cli.Context) error {
-		skel.PluginMain(
-			p.CmdAdd,
-			p.CmdCheck,
-			p.CmdDel,
+		skel.PluginMainFuncs(
+			skel.CNIFuncs{
+				Add:    p.CmdAdd,
+				Del:    p.CmdDel,
+				Check:  p.CmdCheck,
+				GC:     p.CmdGC,
+				Status: p.CmdStatus,
+			},
 			version.All,
 			bv.BuildString("ovn-k8s-cni-overlay"))
 		return nil
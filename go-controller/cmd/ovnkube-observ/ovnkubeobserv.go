@@ -4,11 +4,13 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
 
 	observ "github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 )
 
 func main() {
@@ -25,15 +27,59 @@ func main() {
 		cancel()
 	}()
 	enableDecoder := flag.Bool("enable-enrichment", true, "Enrich samples with nbdb data.")
+	decodePacketEvents := flag.Bool("enable-lb-nat-events", true, "Print the service load balancing and the EgressIP or SNAT "+
+		"that apply to the sampled packets. These are inferred from the nbdb, not sampled. Requires -enable-enrichment.")
+	clusterSubnets := flag.String("cluster-subnets", "", "Comma separated list of the pod subnets of all cluster networks, "+
+		"in the same format as the ovnkube --cluster-subnets. The EgressIP or SNAT is only printed for packets sent outside of these subnets, "+
+		"and never printed if unset.")
 	logCookie := flag.Bool("log-cookie", false, "Print raw sample cookie with psample group_id.")
 	printPacket := flag.Bool("print-full-packet", false, "Print full received packet. When false, only src and dst ips are printed with every sample.")
 	addOVSCollector := flag.Bool("add-ovs-collector", false, "Add ovs collector to enable sampling. Use with caution. Make sure no one else is using observability.")
 	outputFile := flag.String("output-file", "", "Output file to write the samples to.")
 	filterSrcIP := flag.String("filter-src-ip", "", "Filter in only packets from a given source ip.")
 	filterDstIP := flag.String("filter-dst-ip", "", "Filter in only packets to a given destination ip.")
+	outputFormat := flag.String("output-format", observ.TextOutputFormat, "Format of the printed samples, text or json. "+
+		"The json format prints one JSON object per sample and line.")
+	ipfixTargets := flag.String("ipfix-targets", "", "Comma separated list of IPFIX collectors to send the samples to, "+
+		"in the form ip:port. The ip defaults to 127.0.0.1.")
+	ipfixCacheActiveTimeout := flag.Uint("ipfix-cache-active-timeout", 60, "Maximum period in seconds for which "+
+		"an IPFIX flow record is cached and aggregated before being sent. If 0, caching is disabled.")
+	ipfixCacheMaxFlows := flag.Uint("ipfix-cache-max-flows", 60, "Maximum number of IPFIX flow records that can be "+
+		"cached at a time. If 0, caching is disabled.")
+	metricsBindAddress := flag.String("metrics-bind-address", "", "The address to serve the prometheus metrics "+
+		"of the decoded samples on, e.g. :9410. Metrics are disabled when empty.")
 	flag.Parse()
 
-	reader := observ.NewSampleReader(*enableDecoder, *logCookie, *printPacket, *addOVSCollector, *filterSrcIP, *filterDstIP, *outputFile)
+	exportConfig := &observ.ExportConfig{
+		OutputFormat: *outputFormat,
+		IPFIX: config.IPFIXConfig{
+			CacheActiveTimeout: *ipfixCacheActiveTimeout,
+			CacheMaxFlows:      *ipfixCacheMaxFlows,
+		},
+		MetricsBindAddress: *metricsBindAddress,
+	}
+	if *ipfixTargets != "" {
+		targets, err := config.ParseFlowCollectors(*ipfixTargets)
+		if err != nil {
+			fmt.Println(err.Error())
+			os.Exit(1)
+		}
+		exportConfig.IPFIXTargets = targets
+	}
+	reader := observ.NewSampleReader(*enableDecoder, *decodePacketEvents, *logCookie, *printPacket, *addOVSCollector, *filterSrcIP, *filterDstIP,
+		*outputFile, exportConfig)
+	if *clusterSubnets != "" {
+		subnets, err := config.ParseClusterSubnetEntries(*clusterSubnets)
+		if err != nil {
+			fmt.Println(err.Error())
+			os.Exit(1)
+		}
+		ipNets := make([]*net.IPNet, 0, len(subnets))
+		for _, subnet := range subnets {
+			ipNets = append(ipNets, subnet.CIDR)
+		}
+		reader.SetClusterSubnets(ipNets)
+	}
 	err := reader.ReadSamples(ctx)
 	if err != nil {
 		fmt.Println(err.Error())
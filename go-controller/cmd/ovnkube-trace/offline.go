@@ -0,0 +1,342 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/klog/v2"
+
+	util "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+const (
+	// File names of the NB and SB database snapshots used in offline mode.
+	nbdbFileName = "ovnnb_db.db"
+	sbdbFileName = "ovnsb_db.db"
+
+	// offlineServerStartTimeout is how long to wait for a local ovsdb-server to serve the snapshots.
+	offlineServerStartTimeout = 10 * time.Second
+)
+
+// offlineEnv is set in offline mode, when ovnkube-trace runs against database snapshots and
+// Kubernetes object dumps instead of a live cluster.
+var offlineEnv *offlineEnvironment
+
+// offlineEnvironment serves the NB and SB database snapshots of a cluster with local ovsdb-server
+// instances, so that the commands built for a live cluster run unmodified on the local host.
+type offlineEnvironment struct {
+	// dbDir contains either the database snapshots of a cluster without interconnect, or one
+	// subdirectory per zone, named after the zone, with the database snapshots of that zone.
+	dbDir string
+	// workDir holds the copies of the snapshots served by the local ovsdb-server instances.
+	workDir      string
+	interconnect bool
+	// zones maps the zone names to their databases, which are started on first use. Without
+	// interconnect, the only entry is keyed by the empty string.
+	zones map[string]*offlineDatabases
+}
+
+// offlineDatabases is a local ovsdb-server serving the NB and SB databases of a zone over a unix socket.
+type offlineDatabases struct {
+	uri    string
+	server *exec.Cmd
+	exited chan error
+}
+
+// newOfflineEnvironment returns the environment serving the database snapshots found in dbDir.
+func newOfflineEnvironment(dbDir string) (*offlineEnvironment, error) {
+	env := &offlineEnvironment{
+		dbDir: dbDir,
+		zones: map[string]*offlineDatabases{},
+	}
+	if _, err := os.Stat(filepath.Join(dbDir, nbdbFileName)); err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		klog.V(5).Infof("No %s in %s, expecting one directory of database snapshots per zone", nbdbFileName, dbDir)
+		env.interconnect = true
+	}
+	workDir, err := os.MkdirTemp("", "ovnkube-trace-")
+	if err != nil {
+		return nil, err
+	}
+	env.workDir = workDir
+	return env, nil
+}
+
+// stop stops the local ovsdb-server instances and removes the copies of the snapshots.
+func (env *offlineEnvironment) stop() {
+	for zone, dbs := range env.zones {
+		if err := dbs.server.Process.Kill(); err != nil {
+			klog.Warningf("Failed to stop the ovsdb-server of zone %q: %v", zone, err)
+			continue
+		}
+		<-dbs.exited
+	}
+	if err := os.RemoveAll(env.workDir); err != nil {
+		klog.Warningf("Failed to remove %s: %v", env.workDir, err)
+	}
+}
+
+// setDatabaseInfo points the commands run for the pod to the local copy of the databases of the zone
+// of the pod's node. This is the offline counterpart of getDatabaseURIs.
+func (env *offlineEnvironment) setDatabaseInfo(coreclient corev1client.CoreV1Interface, podInfo *PodInfo) error {
+	var zone string
+	if env.interconnect {
+		node, err := coreclient.Nodes().Get(context.TODO(), podInfo.NodeName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		zone = util.GetNodeZone(node)
+		podInfo.IsInterConnect = true
+		podInfo.InterConnectZoneName = zone
+	}
+	dbs, err := env.getDatabases(zone)
+	if err != nil {
+		return err
+	}
+	podInfo.NbURI = dbs.uri
+	podInfo.SbURI = dbs.uri
+	podInfo.SslCertKeys = " "
+	podInfo.NbCommand = podInfo.SslCertKeys + "--db " + podInfo.NbURI
+	podInfo.SbCommand = podInfo.SslCertKeys + "--db " + podInfo.SbURI
+	klog.V(5).Infof("The nbcmd and sbcmd of pod %s are %s and %s", podInfo.PodName, podInfo.NbCommand, podInfo.SbCommand)
+	return nil
+}
+
+// getDatabases returns the databases of the given zone, starting a local ovsdb-server for them if needed.
+func (env *offlineEnvironment) getDatabases(zone string) (*offlineDatabases, error) {
+	if dbs, ok := env.zones[zone]; ok {
+		return dbs, nil
+	}
+	srcDir := env.dbDir
+	dstDir := env.workDir
+	if env.interconnect {
+		srcDir = filepath.Join(env.dbDir, zone)
+		dstDir = filepath.Join(env.workDir, zone)
+		if err := os.Mkdir(dstDir, 0o700); err != nil {
+			return nil, err
+		}
+	}
+	for _, fileName := range []string{nbdbFileName, sbdbFileName} {
+		if err := prepareSnapshot(filepath.Join(srcDir, fileName), filepath.Join(dstDir, fileName)); err != nil {
+			return nil, fmt.Errorf("failed to prepare the database snapshots of zone %q: %w", zone, err)
+		}
+	}
+	dbs, err := startOfflineDatabases(dstDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serve the database snapshots of zone %q: %w", zone, err)
+	}
+	env.zones[zone] = dbs
+	return dbs, nil
+}
+
+// prepareSnapshot copies the database snapshot in src to dst. Clustered databases are converted to
+// standalone ones, as ovsdb-server would otherwise try to join their raft cluster.
+func prepareSnapshot(src, dst string) error {
+	if _, err := os.Stat(src); err != nil {
+		return err
+	}
+	if err := exec.Command("ovsdb-tool", "db-is-clustered", src).Run(); err == nil {
+		klog.V(5).Infof("Converting clustered database %s to a standalone one", src)
+		if out, err := exec.Command("ovsdb-tool", "cluster-to-standalone", dst, src).CombinedOutput(); err != nil {
+			return fmt.Errorf("failed to convert clustered database %s: %v: %s", src, err, out)
+		}
+		return nil
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// startOfflineDatabases starts an ovsdb-server serving the NB and SB databases found in dir.
+func startOfflineDatabases(dir string) (*offlineDatabases, error) {
+	sock := filepath.Join(dir, "db.sock")
+	logFile := filepath.Join(dir, "ovsdb-server.log")
+	server := exec.Command("ovsdb-server",
+		"--remote=punix:"+sock,
+		"--unixctl="+filepath.Join(dir, "ovsdb-server.ctl"),
+		"--log-file="+logFile,
+		"--no-chdir",
+		filepath.Join(dir, nbdbFileName),
+		filepath.Join(dir, sbdbFileName),
+	)
+	// ovnkube-trace exits on the first failure without running any deferred cleanup.
+	setParentDeathSignal(server)
+	if err := server.Start(); err != nil {
+		return nil, err
+	}
+	dbs := &offlineDatabases{
+		uri:    sockProtocol + ":" + sock,
+		server: server,
+		exited: make(chan error, 1),
+	}
+	go func() {
+		dbs.exited <- server.Wait()
+	}()
+
+	err := wait.PollUntilContextTimeout(context.TODO(), 100*time.Millisecond, offlineServerStartTimeout, true, func(context.Context) (bool, error) {
+		select {
+		case err := <-dbs.exited:
+			return false, fmt.Errorf("ovsdb-server exited with %v, see %s", err, logFile)
+		default:
+		}
+		_, err := os.Stat(sock)
+		return err == nil, nil
+	})
+	if err != nil {
+		_ = server.Process.Kill()
+		return nil, err
+	}
+	klog.V(5).Infof("Serving the database snapshots in %s on %s", dir, dbs.uri)
+	return dbs, nil
+}
+
+// execLocally runs a command on the local host. Requires bash. Returns Stdout, Stderr, err.
+func execLocally(cmd string, in string) (string, string, error) {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	command := exec.Command("bash", "-c", cmd)
+	command.Stdin = strings.NewReader(in)
+	command.Stdout = &stdout
+	command.Stderr = &stderr
+	err := command.Run()
+	return stdout.String(), stderr.String(), err
+}
+
+// loadKubernetesObjects returns a core/v1 client serving the Kubernetes objects found in the given comma
+// separated list of YAML or JSON files and directories, e.g. the output of
+// `kubectl get nodes,pods,services,endpoints -A -o yaml`. Objects of unknown kinds are skipped.
+func loadKubernetesObjects(paths string) (corev1client.CoreV1Interface, error) {
+	client := fake.NewSimpleClientset()
+	for _, path := range strings.Split(paths, ",") {
+		files, err := listObjectFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			objs, err := decodeObjectFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode %s: %w", file, err)
+			}
+			for _, obj := range objs {
+				if err := client.Tracker().Add(obj); err != nil {
+					return nil, fmt.Errorf("failed to load %s: %w", file, err)
+				}
+			}
+			klog.V(5).Infof("Loaded %d objects from %s", len(objs), file)
+		}
+	}
+	return client.CoreV1(), nil
+}
+
+// listObjectFiles returns the path itself if it is a file, or the YAML and JSON files found under it if it
+// is a directory.
+func listObjectFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	var files []string
+	err = filepath.WalkDir(path, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		switch filepath.Ext(file) {
+		case ".yaml", ".yml", ".json":
+			if !d.IsDir() {
+				files = append(files, file)
+			}
+		}
+		return nil
+	})
+	return files, err
+}
+
+// decodeObjectFile returns the objects found in the documents of a YAML or JSON file, flattening lists.
+func decodeObjectFile(file string) ([]runtime.Object, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var objs []runtime.Object
+	decoder := utilyaml.NewYAMLOrJSONDecoder(f, 4096)
+	for {
+		raw := runtime.RawExtension{}
+		if err := decoder.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objs, nil
+			}
+			return nil, err
+		}
+		objs, err = appendObjects(objs, raw.Raw)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func appendObjects(objs []runtime.Object, data []byte) ([]runtime.Object, error) {
+	if len(data) == 0 {
+		return objs, nil
+	}
+	obj, _, err := scheme.Codecs.UniversalDeserializer().Decode(data, nil, nil)
+	if runtime.IsNotRegisteredError(err) {
+		klog.V(5).Infof("Skipping object: %v", err)
+		return objs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if list, ok := obj.(*corev1.List); ok {
+		for _, item := range list.Items {
+			objs, err = appendObjects(objs, item.Raw)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return objs, nil
+	}
+	if meta.IsListType(obj) {
+		items, err := meta.ExtractList(obj)
+		if err != nil {
+			return nil, err
+		}
+		return append(objs, items...), nil
+	}
+	return append(objs, obj), nil
+}
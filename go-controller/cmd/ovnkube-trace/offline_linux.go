@@ -0,0 +1,14 @@
+//go:build linux
+// +build linux
+
+package main
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setParentDeathSignal makes the command get killed when ovnkube-trace exits.
+func setParentDeathSignal(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Pdeathsig: syscall.SIGKILL}
+}
@@ -0,0 +1,12 @@
+//go:build !linux
+// +build !linux
+
+package main
+
+import (
+	"os/exec"
+)
+
+// setParentDeathSignal is a no-op, the command has to be stopped explicitly on this platform.
+func setParentDeathSignal(_ *exec.Cmd) {
+}
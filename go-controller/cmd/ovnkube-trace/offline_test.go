@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLoadKubernetesObjects(t *testing.T) {
+	dir := t.TempDir()
+	list := `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: client
+    namespace: default
+  spec:
+    nodeName: ovn-worker
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: server
+    namespace: default
+  spec:
+    clusterIP: 10.96.0.10
+`
+	documents := `apiVersion: v1
+kind: Node
+metadata:
+  name: ovn-worker
+  annotations:
+    k8s.ovn.org/zone-name: ovn-worker
+---
+apiVersion: k8s.ovn.org/v1
+kind: EgressIP
+metadata:
+  name: skipped
+`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "list.yaml"), []byte(list), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "documents.yml"), []byte(documents), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("not an object"), 0o600))
+
+	coreclient, err := loadKubernetesObjects(dir)
+	require.NoError(t, err)
+
+	pod, err := coreclient.Pods("default").Get(context.TODO(), "client", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "ovn-worker", pod.Spec.NodeName)
+	svc, err := coreclient.Services("default").Get(context.TODO(), "server", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "10.96.0.10", svc.Spec.ClusterIP)
+	node, err := coreclient.Nodes().Get(context.TODO(), "ovn-worker", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "ovn-worker", node.Annotations["k8s.ovn.org/zone-name"])
+}
@@ -113,6 +113,10 @@ type PodInfo struct {
 	SslCertKeys          string // ssl cert keys string to access ovn nbdb/sbdb
 	NbCommand            string // contains subset of nb command string to execute on ovn nbdb
 	SbCommand            string // contains subset of sb command string to execute on ovn sbdb
+	NADName              string // NAD of the traced secondary network interface, empty for the default network
+	LogicalPort          string // logical switch port of the traced secondary network interface
+	Subnet               string // subnet of the traced secondary network interface
+	Gateway              string // gateway of the traced secondary network interface, empty if it has none
 }
 
 // String returns a JSON representation of the SvcInfo object, or "" on failure.
@@ -151,7 +155,8 @@ func (pi *PodInfo) FullyQualifiedPodName() string {
 }
 
 // execInPod runs a command inside the given container. Requires bash. Returns Stdout, Stderr, err.
-func execInPod(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, namespace string, podName string, containerName string, cmd string, in string) (string, string, error) {
+// In offline mode, the command runs on the local host instead.
+func execInPod(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, namespace string, podName string, containerName string, cmd string, in string) (string, string, error) {
 	klog.V(5).Infof(
 		"Running command inside container: namespace: %s, podName: %s, containerName: %s, cmd: %s, stdin: %s%s%s",
 		namespace,
@@ -161,9 +166,13 @@ func execInPod(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, n
 		italic, in, reset,
 	)
 
+	if offlineEnv != nil {
+		return execLocally(cmd, in)
+	}
+
 	scheme := runtime.NewScheme()
 	if err := corev1.AddToScheme(scheme); err != nil {
-		klog.Exitf("Error adding to scheme: %v", err)
+		exitf("Error adding to scheme: %v", err)
 	}
 	parameterCodec := runtime.NewParameterCodec(scheme)
 
@@ -220,7 +229,7 @@ func execInPod(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, n
 // In order to do so, it looks for annotation 'k8s.ovn.org/l3-gateway-config' on the provided node.
 // That annotation should contain a JSON string like: '{"default":{"mode":"shared", ...}}'.
 // It will then determine the routing mode from that annotation if it is valid or return error otherwise.
-func isRoutingViaHost(coreclient *corev1client.CoreV1Client, nodeName string) (bool, error) {
+func isRoutingViaHost(coreclient corev1client.CoreV1Interface, nodeName string) (bool, error) {
 	node, err := coreclient.Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
 	if err != nil {
 		return false, err
@@ -269,7 +278,7 @@ func getPodMAC(pod *corev1.Pod) (podMAC string, err error) {
 }
 
 // getOvnKubePodOnNode returns the name of the ovnkube-node pod that is running on a given node.
-func getOvnKubePodOnNode(coreclient *corev1client.CoreV1Client, ovnNamespace string, nodeName string) (string, error) {
+func getOvnKubePodOnNode(coreclient corev1client.CoreV1Interface, ovnNamespace string, nodeName string) (string, error) {
 	// Get pods in the openshift-ovn-kubernetes namespace
 	podsOvn, errOvn := coreclient.Pods(ovnNamespace).List(context.TODO(), metav1.ListOptions{})
 	if errOvn != nil {
@@ -299,7 +308,7 @@ func getOvnKubePodOnNode(coreclient *corev1client.CoreV1Client, ovnNamespace str
 // about this pod's OVS interface and returns the name and ofport fields.
 // It will run `ovs-vsctl --columns name,ofport find interface external_ids:iface-id=%s` with the given `$namespace-$pod` tuple and it will then parse the
 // result into a map[string]string that maps the keys to their values.
-func getPodOvsInterfaceNameAndOfport(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, podInfo *PodInfo, ovnNamespace, fullyQualifiedPodName string) (*OvsInterface, error) {
+func getPodOvsInterfaceNameAndOfport(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, podInfo *PodInfo, ovnNamespace, fullyQualifiedPodName string) (*OvsInterface, error) {
 	var interfaceInfo OvsInterface
 
 	findInterfaceCmd := fmt.Sprintf("ovs-vsctl --columns name,ofport find interface external_ids:iface-id=%s", fullyQualifiedPodName)
@@ -343,7 +352,7 @@ func getPodOvsInterfaceNameAndOfport(coreclient *corev1client.CoreV1Client, rest
 }
 
 // getSvcInfo builds the SvcInfo object for this service. PodName/PodNamespace/PodIP are for the first valid endpoint pod that can be found for this service.
-func getSvcInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, svcName string, ovnNamespace string, namespace, addressFamily string) (svcInfo *SvcInfo, err error) {
+func getSvcInfo(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, svcName string, ovnNamespace string, namespace, addressFamily string) (svcInfo *SvcInfo, err error) {
 	// Get service with the name supplied by svcName
 	svc, err := coreclient.Services(namespace).Get(context.TODO(), svcName, metav1.GetOptions{})
 	if err != nil {
@@ -380,7 +389,7 @@ func getSvcInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config,
 
 // extractSubsetInfo copies information from the endpoint subsets into the SvcInfo object.
 // Modifies the svcInfo object the pointer of which is passed to it.
-func extractSubsetInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, subsets []corev1.EndpointSubset, svcInfo *SvcInfo, ovnNamespace, addressFamily string) error {
+func extractSubsetInfo(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, subsets []corev1.EndpointSubset, svcInfo *SvcInfo, ovnNamespace, addressFamily string) error {
 	for _, subset := range subsets {
 		klog.V(5).Infof("==> Trying to extract information for service %s in namespace %s from subset %v",
 			svcInfo.SvcName, svcInfo.SvcNamespace, subset)
@@ -414,9 +423,9 @@ func extractSubsetInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.C
 			}
 
 			// Get info needed for the src Pod
-			svcPodInfo, err := getPodInfo(coreclient, restconfig, epAddress.TargetRef.Name, ovnNamespace, epAddress.TargetRef.Namespace, addressFamily)
+			svcPodInfo, err := getPodInfo(coreclient, restconfig, epAddress.TargetRef.Name, ovnNamespace, epAddress.TargetRef.Namespace, addressFamily, "")
 			if err != nil {
-				klog.Exitf("Failed to get information from pod %s: %v", epAddress.TargetRef.Name, err)
+				exitf("Failed to get information from pod %s: %v", epAddress.TargetRef.Name, err)
 			}
 			klog.V(5).Infof("svcPodInfo is %s\n", svcPodInfo)
 
@@ -433,7 +442,8 @@ func extractSubsetInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.C
 }
 
 // getPodInfo returns a pointer to a fully populated PodInfo struct, or error on failure.
-func getPodInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, podName string, ovnNamespace string, namespace, addressFamily string) (podInfo *PodInfo, err error) {
+// If nadName is set, the information of the pod's interface on that secondary network is added.
+func getPodInfo(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, podName string, ovnNamespace string, namespace, addressFamily, nadName string) (podInfo *PodInfo, err error) {
 	// Create a PodInfo object with the base information already added, such as
 	// IP, PodName, ContainerName, NodeName, HostNetwork, Namespace, PrimaryInterfaceName
 	pod, err := coreclient.Pods(namespace).Get(context.TODO(), podName, metav1.GetOptions{})
@@ -458,27 +468,108 @@ func getPodInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config,
 	}
 	podInfo.NodeName = pod.Spec.NodeName
 
-	// Get the pod's ovnkubePod.
-	podInfo.OvnKubePodName, err = getOvnKubePodOnNode(coreclient, ovnNamespace, podInfo.NodeName)
+	podInfo, err = completePodInfo(coreclient, restconfig, podInfo, pod, ovnNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if nadName != "" {
+		if err = setSecondaryNetworkInfo(podInfo, pod, nadName); err != nil {
+			return nil, err
+		}
+	}
+
+	return podInfo, nil
+}
+
+// getNodeEndpointInfo returns a PodInfo struct describing the node as a host networked endpoint. As a source, the node
+// sends from the address of its management port, as a destination, it is reached on its internal IP address.
+func getNodeEndpointInfo(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, nodeName string, ovnNamespace string, addressFamily string, isSource bool) (*PodInfo, error) {
+	node, err := coreclient.Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+	if err != nil {
+		klog.V(1).Infof("Node %s not found\n", nodeName)
+		return nil, err
+	}
+
+	podInfo := &PodInfo{
+		IPVer:       addressFamily,
+		PodName:     node.Name,
+		HostNetwork: true,
+	}
+	podInfo.NodeName = node.Name
+	for _, address := range node.Status.Addresses {
+		ip := utilnet.ParseIPSloppy(address.Address)
+		if address.Type == corev1.NodeInternalIP && ip != nil && getIPVer(ip) == addressFamily {
+			podInfo.IP = ip.String()
+			break
+		}
+	}
+
+	podInfo, err = completePodInfo(coreclient, restconfig, podInfo, nil, ovnNamespace)
 	if err != nil {
-		klog.V(1).Infof("Problem obtaining ovnkube pod name of Pod %s in namespace %s\n", podName, namespace)
 		return nil, err
 	}
 
+	if isSource {
+		_, mgmtIPs, err := getManagementPortAddresses(coreclient, restconfig, podInfo, ovnNamespace)
+		if err != nil {
+			return nil, err
+		}
+		podInfo.IP = ""
+		for _, ip := range mgmtIPs {
+			if getIPVer(ip) == addressFamily {
+				podInfo.IP = ip.String()
+				break
+			}
+		}
+	}
+	if podInfo.IP == "" {
+		return nil, fmt.Errorf("could not find desired node ip address of node %s for the given address family", nodeName)
+	}
+
+	return podInfo, nil
+}
+
+// completePodInfo adds the information about the node of the pod and the databases of its zone to the given PodInfo
+// struct. pod is nil for host networked endpoints other than pods.
+func completePodInfo(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, podInfo *PodInfo, pod *corev1.Pod, ovnNamespace string) (*PodInfo, error) {
+	podName := podInfo.PodName
+	namespace := podInfo.PodNamespace
+	var err error
+
 	// Get the node's gateway mode
 	podInfo.RoutingViaHost, err = isRoutingViaHost(coreclient, podInfo.NodeName)
 	if err != nil {
 		return nil, err
 	}
 
-	podInfo, err = getDatabaseURIs(coreclient, restconfig, ovnNamespace, podInfo)
-	if err != nil {
-		klog.Exitf("Failed to get database URIs: %v\n", err)
+	if offlineEnv != nil {
+		// There is no ovnkube pod to run the commands in, use the snapshots of the databases of the node's zone.
+		if err = offlineEnv.setDatabaseInfo(coreclient, podInfo); err != nil {
+			exitf("Failed to set up the databases of pod %s in namespace %s: %v\n", podName, namespace, err)
+		}
+	} else {
+		// Get the pod's ovnkubePod.
+		podInfo.OvnKubePodName, err = getOvnKubePodOnNode(coreclient, ovnNamespace, podInfo.NodeName)
+		if err != nil {
+			klog.V(1).Infof("Problem obtaining ovnkube pod name of Pod %s in namespace %s\n", podName, namespace)
+			return nil, err
+		}
+
+		podInfo, err = getDatabaseURIs(coreclient, restconfig, ovnNamespace, podInfo)
+		if err != nil {
+			exitf("Failed to get database URIs: %v\n", err)
+		}
 	}
 
 	// Get the pod's MAC address.
 	// If hostnetwork, use mp0 mac
-	if pod.Spec.HostNetwork {
+	if podInfo.HostNetwork && offlineEnv != nil {
+		podInfo.MAC, _, err = getManagementPortAddresses(coreclient, restconfig, podInfo, ovnNamespace)
+		if err != nil {
+			return nil, err
+		}
+	} else if podInfo.HostNetwork {
 		podInfo.OvnK8sMp0PortName = types.K8sMgmtIntfName
 		portCmd := fmt.Sprintf("ovs-vsctl get Interface %s mac_in_use", podInfo.OvnK8sMp0PortName)
 		localOutput, localError, err := execInPod(coreclient, restconfig, ovnNamespace, podInfo.OvnKubePodName, podInfo.OvnKubeContainerName, portCmd, "")
@@ -512,19 +603,24 @@ func getPodInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config,
 	// Set information specific to ovn-k8s-mp0. This info is required for routingViaHost gateway mode traffic to an external IP
 	// destination.
 	podInfo.OvnK8sMp0PortName = types.K8sMgmtIntfName
-	portCmd := fmt.Sprintf("ovs-vsctl get Interface %s ofport", podInfo.OvnK8sMp0PortName)
-	localOutput, localError, err := execInPod(coreclient, restconfig, ovnNamespace, podInfo.OvnKubePodName, podInfo.OvnKubeContainerName, portCmd, "")
-	if err != nil {
-		return nil, fmt.Errorf("execInPod() failed. err: %s, stderr: %s, stdout: %s, podInfo: %v", err, localError, localOutput, podInfo)
+	if offlineEnv == nil {
+		portCmd := fmt.Sprintf("ovs-vsctl get Interface %s ofport", podInfo.OvnK8sMp0PortName)
+		localOutput, localError, err := execInPod(coreclient, restconfig, ovnNamespace, podInfo.OvnKubePodName, podInfo.OvnKubeContainerName, portCmd, "")
+		if err != nil {
+			return nil, fmt.Errorf("execInPod() failed. err: %s, stderr: %s, stdout: %s, podInfo: %v", err, localError, localOutput, podInfo)
+		}
+		podInfo.OvnK8sMp0OfportNum = strings.Replace(localOutput, "\n", "", -1)
 	}
-	podInfo.OvnK8sMp0OfportNum = strings.Replace(localOutput, "\n", "", -1)
 
 	// Set information specific to host networked pods or non-host networked pods.
+	// The OVS interfaces are only needed by ofproto/trace, which is skipped in offline mode.
 	if podInfo.HostNetwork {
 		podInfo.PrimaryInterfaceName = util.GetLegacyK8sMgmtIntfName(podInfo.NodeName)
 		podInfo.K8sNodeNamePort = types.K8sPrefix + podInfo.NodeName
 		podInfo.VethName = podInfo.OvnK8sMp0PortName
 		podInfo.OfportNum = podInfo.OvnK8sMp0OfportNum
+	} else if offlineEnv != nil {
+		podInfo.PrimaryInterfaceName = "eth0"
 	} else {
 		// Get the pod's interface information
 		ovsInterfaceInformation, err := getPodOvsInterfaceNameAndOfport(coreclient, restconfig, podInfo, ovnNamespace, podInfo.FullyQualifiedPodName())
@@ -544,7 +640,7 @@ func getPodInfo(coreclient *corev1client.CoreV1Client, restconfig *rest.Config,
 	return podInfo, err
 }
 
-func getRouterPortMacAddress(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, podInfo *PodInfo, ovnNamespace, portPrefix string) (string, error) {
+func getRouterPortMacAddress(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, podInfo *PodInfo, ovnNamespace, portPrefix string) (string, error) {
 	tspCmd := "ovn-sbctl --no-leader-only " + podInfo.SbCommand + " --bare --no-heading --column=mac list Port_Binding " + portPrefix + podInfo.NodeName
 	ipOutput, ipError, err := execInPod(coreclient, restconfig, ovnNamespace, podInfo.OvnKubePodName, podInfo.OvnKubeContainerName, tspCmd, "")
 	if err != nil {
@@ -560,8 +656,31 @@ func getRouterPortMacAddress(coreclient *corev1client.CoreV1Client, restconfig *
 	return macIP[0], nil
 }
 
+// getManagementPortAddresses returns the MAC and IP addresses of the management port of the pod's node, as found in the
+// NB database.
+func getManagementPortAddresses(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, podInfo *PodInfo, ovnNamespace string) (string, []net.IP, error) {
+	cmd := "ovn-nbctl --no-leader-only " + podInfo.NbCommand + " lsp-get-addresses " + types.K8sPrefix + podInfo.NodeName
+	stdout, stderr, err := execInPod(coreclient, restconfig, ovnNamespace, podInfo.OvnKubePodName, podInfo.OvnKubeContainerName, cmd, "")
+	if err != nil {
+		return "", nil, fmt.Errorf("execInPod() failed. err: %s, stderr: %s, stdout: %s, podInfo: %v", err, stderr, stdout, podInfo)
+	}
+	// The output has the following format: 0a:58:0a:f4:00:02 10.244.0.2 or
+	// 0a:58:0a:f4:00:02 10.244.0.2 fd00:10:244:1::2 for dual stack cluster.
+	fields := strings.Fields(stdout)
+	if len(fields) < 1 {
+		return "", nil, fmt.Errorf("invalid addresses output %s", stdout)
+	}
+	var ips []net.IP
+	for _, field := range fields[1:] {
+		if ip := utilnet.ParseIPSloppy(field); ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+	return fields[0], ips, nil
+}
+
 // getNodeExternalBridgeName gets the name of the external bridge of this node, e.g. breth0 or br-ex.
-func getNodeExternalBridgeName(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, ovnNamespace string, podInfo *PodInfo) (string, error) {
+func getNodeExternalBridgeName(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, ovnNamespace string, podInfo *PodInfo) (string, error) {
 	cmd := "ovn-sbctl --no-leader-only " + podInfo.SbCommand + " --bare --no-heading --column=logical_port find Port_Binding options:network_name=" + types.PhysicalNetworkName
 	stdout, stderr, err := execInPod(coreclient, restconfig, ovnNamespace, podInfo.OvnKubePodName, podInfo.OvnKubeContainerName, cmd, "")
 	if err != nil {
@@ -582,7 +701,7 @@ func getNodeExternalBridgeName(coreclient *corev1client.CoreV1Client, restconfig
 
 // getOvnNamespace searches all namespaces for pods with the label selector app=ovnkube-node.
 // If it can find such pods, it returns the namespace that they reside in, or error otherwise.
-func getOvnNamespace(coreclient *corev1client.CoreV1Client, override string) (string, error) {
+func getOvnNamespace(coreclient corev1client.CoreV1Interface, override string) (string, error) {
 	if override != "" {
 		return override, nil
 	}
@@ -601,7 +720,7 @@ func getOvnNamespace(coreclient *corev1client.CoreV1Client, override string) (st
 
 // Get the OVN Database URIs from the first container found in any pod in the ovn-kubernetes namespace with name "ovnkube-node"
 // Returns nbAddress, sbAddress, protocol == "ssl", nil
-func getDatabaseURIs(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, ovnNamespace string, podInfo *PodInfo) (*PodInfo, error) {
+func getDatabaseURIs(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, ovnNamespace string, podInfo *PodInfo) (*PodInfo, error) {
 	podName := podInfo.OvnKubePodName
 	var ovnContainerName string
 	pod, err := coreclient.Pods(ovnNamespace).Get(context.TODO(), podName, metav1.GetOptions{})
@@ -686,17 +805,40 @@ func getDatabaseURIs(coreclient *corev1client.CoreV1Client, restconfig *rest.Con
 }
 
 // printSuccessOrFailure will print a success or failure message. If searchString is set, then we expect to find a match for the
-// regexp given in searchString.
+// regexp given in searchString. With JSON output, the result is added to the report, which is printed right away on failure.
 func printSuccessOrFailure(commandDescription, src, dst, commandStdout, commandStderr string, err error, searchString string) {
 	if err != nil {
-		klog.Exitf("%s error %v stdOut: %s\n stdErr: %s", commandDescription, err, commandStdout, commandStderr)
+		exitf("%s error %v stdOut: %s\n stdErr: %s", commandDescription, err, commandStdout, commandStderr)
 	}
 	klog.V(2).Infof("%s Output:\n%s%s%s\n", commandDescription, italic, commandStdout, reset)
 
+	if jsonReport != nil {
+		result := jsonReport.getResult(commandDescription)
+		result.Source = src
+		result.Destination = dst
+		result.Success = true
+		if result.Hops == nil {
+			result.Output = commandStdout
+		}
+		result.completed = true
+		if searchString != "" {
+			match, err := regexp.MatchString(searchString, commandStdout)
+			if err != nil {
+				exitf("Unexpected failure matching regex '%s' to commandStdout '%s', err: %s", searchString, commandStdout, err)
+			}
+			result.Success = match
+		}
+		if !result.Success {
+			jsonReport.print()
+			exit(-1)
+		}
+		return
+	}
+
 	if searchString != "" {
 		match, err := regexp.MatchString(searchString, commandStdout)
 		if err != nil {
-			klog.Exitf("Unexpected failure matching regex '%s' to commandStdout '%s', err: %s", searchString, commandStdout, err)
+			exitf("Unexpected failure matching regex '%s' to commandStdout '%s', err: %s", searchString, commandStdout, err)
 		}
 		if match {
 			// Write the result to stdout.
@@ -708,7 +850,7 @@ func printSuccessOrFailure(commandDescription, src, dst, commandStdout, commandS
 			fmt.Printf("%s%s%s indicates failure from %s to %s%s\n", red, bold, commandDescription, src, dst, reset)
 			// Log further info on log level 1.
 			klog.V(1).Infof("%sSearch string not matched:\n%s%s\n", red, searchString, reset)
-			os.Exit(-1)
+			exit(-1)
 		}
 	} else {
 		// Write the result to stdout.
@@ -717,7 +859,7 @@ func printSuccessOrFailure(commandDescription, src, dst, commandStdout, commandS
 }
 
 // runOvnTraceToService runs an ovntrace from src pod to dst service. If dstSvcInfo == nil, then skip all steps.
-func runOvnTraceToService(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, srcPodInfo *PodInfo, dstSvcInfo *SvcInfo, ovnNamespace, protocol, dstPort string) {
+func runOvnTraceToService(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, srcPodInfo *PodInfo, dstSvcInfo *SvcInfo, ovnNamespace, protocol, dstPort string) {
 	var inport string
 	inport = srcPodInfo.FullyQualifiedPodName()
 	if srcPodInfo.HostNetwork {
@@ -725,7 +867,7 @@ func runOvnTraceToService(coreclient *corev1client.CoreV1Client, restconfig *res
 	}
 	svcL3Ver := dstSvcInfo.getL3Ver()
 	if srcPodInfo.IPVer != svcL3Ver {
-		klog.Exitf("Pod src IP address family (address: %s) and service IP address family (address: %s) do not match",
+		exitf("Pod src IP address family (address: %s) and service IP address family (address: %s) do not match",
 			srcPodInfo.IP, dstSvcInfo.ClusterIP)
 	}
 	cmd := fmt.Sprintf(`ovn-trace --no-leader-only %[1]s %[2]s --ct=new `+
@@ -754,6 +896,7 @@ func runOvnTraceToService(coreclient *corev1client.CoreV1Client, restconfig *res
 		successString = fmt.Sprintf(`output to "tstor-%s"`, dstSvcInfo.PodInfo.NodeName)
 	}
 	direction := "source pod to service clusterIP"
+	reportOvnTrace(coreclient, restconfig, ovnNamespace, srcPodInfo, "ovn-trace "+direction, ovnSrcDstOut, err)
 	printSuccessOrFailure("ovn-trace "+direction, srcPodInfo.PodName, dstSvcInfo.SvcName, ovnSrcDstOut, ovnSrcDstErr, err, successString)
 	runOvnTraceToRemotePod(coreclient, restconfig, direction, srcPodInfo, dstSvcInfo.PodInfo, ovnNamespace, protocol, dstPort)
 
@@ -761,15 +904,15 @@ func runOvnTraceToService(coreclient *corev1client.CoreV1Client, restconfig *res
 
 // runOvnTraceToIP runs an ovntrace from src pod to dst IP address (should be external to the cluster).
 // Returns the node that the trace will exit on.
-func runOvnTraceToIP(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, srcPodInfo *PodInfo, parsedDstIP net.IP, ovnNamespace, protocol, dstPort string) (string, string) {
+func runOvnTraceToIP(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, srcPodInfo *PodInfo, parsedDstIP net.IP, ovnNamespace, protocol, dstPort string) (string, string) {
 	if srcPodInfo.HostNetwork {
-		klog.Exitf("Pod cannot be on Host Network when tracing to an IP address; use ping\n")
+		exitf("Pod cannot be on Host Network when tracing to an IP address; use ping\n")
 	}
 
 	l3ver := getIPVer(parsedDstIP)
 
 	if srcPodInfo.IPVer != l3ver {
-		klog.Exitf("Pod src IP address family (address: %s) and destination IP address family (address: %s) do not match",
+		exitf("Pod src IP address family (address: %s) and destination IP address family (address: %s) do not match",
 			srcPodInfo.IP, parsedDstIP)
 	}
 
@@ -796,6 +939,7 @@ func runOvnTraceToIP(coreclient *corev1client.CoreV1Client, restconfig *rest.Con
 	successString := fmt.Sprintf(`output to "(.*)_(.*)", type "localnet"|output to "k8s-%s"|remote`, srcPodInfo.NodeName)
 	// Run the command and check if succesString was found.
 	ovnSrcDstOut, ovnSrcDstErr, err := execInPod(coreclient, restconfig, ovnNamespace, srcPodInfo.OvnKubePodName, srcPodInfo.OvnKubeContainerName, cmd, "")
+	reportOvnTrace(coreclient, restconfig, ovnNamespace, srcPodInfo, "ovn-trace from pod to IP", ovnSrcDstOut, err)
 	printSuccessOrFailure("ovn-trace from pod to IP", srcPodInfo.PodName, parsedDstIP.String(), ovnSrcDstOut, ovnSrcDstErr, err, successString)
 
 	// Print some additional information about the node where this request leaves from as well
@@ -810,7 +954,7 @@ func runOvnTraceToIP(coreclient *corev1client.CoreV1Client, restconfig *rest.Con
 		subMatches = re.FindSubmatch([]byte(ovnSrcDstOut))
 		// We should never hit this (printSuccessOrFailure checks the same already above).
 		if len(subMatches) < 3 {
-			klog.Exitf("Could not determine the output port for this trace command, subMatches: %q\n", subMatches)
+			exitf("Could not determine the output port for this trace command, subMatches: %q\n", subMatches)
 		}
 		node := subMatches[len(subMatches)-1]
 		bridgeName := subMatches[len(subMatches)-2]
@@ -834,7 +978,7 @@ func runOvnTraceToIP(coreclient *corev1client.CoreV1Client, restconfig *rest.Con
 	re = regexp.MustCompile(nodeNameRegex)
 	subMatches = re.FindSubmatch([]byte(ovnSrcDstOut))
 	if len(subMatches) < 2 {
-		klog.Exitf("Could not determine node name / bridge name of egress node in runOvnTraceToIP()")
+		exitf("Could not determine node name / bridge name of egress node in runOvnTraceToIP()")
 	}
 	node := subMatches[len(subMatches)-1]
 	klog.V(1).Infof("%sout on node %s%s\n", green, node, reset)
@@ -842,7 +986,7 @@ func runOvnTraceToIP(coreclient *corev1client.CoreV1Client, restconfig *rest.Con
 }
 
 // runOvnTraceToPod runs an ovntrace from src pod to dst pod.
-func runOvnTraceToPod(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, direction string, srcPodInfo, dstPodInfo *PodInfo, ovnNamespace, protocol, dstPort string) {
+func runOvnTraceToPod(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, direction string, srcPodInfo, dstPodInfo *PodInfo, ovnNamespace, protocol, dstPort string) {
 	var inport string
 	inport = srcPodInfo.FullyQualifiedPodName()
 	if srcPodInfo.HostNetwork {
@@ -880,11 +1024,12 @@ func runOvnTraceToPod(coreclient *corev1client.CoreV1Client, restconfig *rest.Co
 		successString = fmt.Sprintf(`output to "tstor-%s"`, dstPodInfo.NodeName)
 	}
 	ovnSrcDstOut, ovnSrcDstErr, err := execInPod(coreclient, restconfig, ovnNamespace, srcPodInfo.OvnKubePodName, srcPodInfo.OvnKubeContainerName, cmd, "")
+	reportOvnTrace(coreclient, restconfig, ovnNamespace, srcPodInfo, "ovn-trace "+direction, ovnSrcDstOut, err)
 	printSuccessOrFailure("ovn-trace "+direction, srcPodInfo.PodName, dstPodInfo.PodName, ovnSrcDstOut, ovnSrcDstErr, err, successString)
 	runOvnTraceToRemotePod(coreclient, restconfig, direction, srcPodInfo, dstPodInfo, ovnNamespace, protocol, dstPort)
 }
 
-func runOvnTraceToRemotePod(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, direction string, srcPodInfo, dstPodInfo *PodInfo, ovnNamespace, protocol, dstPort string) {
+func runOvnTraceToRemotePod(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, direction string, srcPodInfo, dstPodInfo *PodInfo, ovnNamespace, protocol, dstPort string) {
 	if dstPodInfo.HostNetwork || !srcPodInfo.IsInterConnect || podsInSameInterconnectZone(srcPodInfo, dstPodInfo) {
 		return
 	}
@@ -904,6 +1049,7 @@ func runOvnTraceToRemotePod(coreclient *corev1client.CoreV1Client, restconfig *r
 	klog.V(4).Infof("ovn-trace command on destination pod node is %s", cmd)
 	successString := fmt.Sprintf(`output to "%s"`, dstPodInfo.FullyQualifiedPodName())
 	ovnSrcDstOut, ovnSrcDstErr, err := execInPod(coreclient, restconfig, ovnNamespace, dstPodInfo.OvnKubePodName, srcPodInfo.OvnKubeContainerName, cmd, "")
+	reportOvnTrace(coreclient, restconfig, ovnNamespace, dstPodInfo, "ovn-trace (remote) "+direction, ovnSrcDstOut, err)
 	printSuccessOrFailure("ovn-trace (remote) "+direction, srcPodInfo.PodName, dstPodInfo.PodName, ovnSrcDstOut, ovnSrcDstErr, err, successString)
 }
 
@@ -913,7 +1059,7 @@ func podsInSameInterconnectZone(srcPodInfo, dstPodInfo *PodInfo) bool {
 }
 
 // runOfprotoTraceToPod runs an ofproto/trace command from the src to the destination pod.
-func runOfprotoTraceToPod(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, direction string, srcPodInfo, dstPodInfo *PodInfo, ovnNamespace, protocol, dstPort string) string {
+func runOfprotoTraceToPod(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, direction string, srcPodInfo, dstPodInfo *PodInfo, ovnNamespace, protocol, dstPort string) string {
 	protocolSelector, nwSrc, nwDst := getOfprotoIPFamilyArgs(protocol, net.ParseIP(dstPodInfo.IP))
 	cmd := fmt.Sprintf(`ovs-appctl ofproto/trace br-int `+
 		`"in_port=%[1]s, %[9]s, dl_src=%[3]s, dl_dst=%[4]s, %[10]s=%[5]s, %[11]s=%[6]s, nw_ttl=64, %[7]s_dst=%[8]s, %[7]s_src=12345"`,
@@ -961,7 +1107,7 @@ func runOfprotoTraceToPod(coreclient *corev1client.CoreV1Client, restconfig *res
 // egressNodeName is the exit node, as determined by an ovn-trace command that was run earlier.
 // egressBridgeName is the name of the exit bridge (for EgressIPs, EgressGW and also for routingViaOVN mode).
 // If egressBridgeName == "", then this is routingViaHost Gateway mode without an EgressIP / EgressGW.
-func runOfprotoTraceToIP(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, srcPodInfo *PodInfo, dstIP net.IP, ovnNamespace, protocol, dstPort, egressNodeName, egressBridgeName string) string {
+func runOfprotoTraceToIP(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, srcPodInfo *PodInfo, dstIP net.IP, ovnNamespace, protocol, dstPort, egressNodeName, egressBridgeName string) string {
 	protocolSelector, nwSrc, nwDst := getOfprotoIPFamilyArgs(protocol, dstIP)
 	cmd := fmt.Sprintf(`ovs-appctl ofproto/trace br-int `+
 		`"in_port=%[1]s, %[8]s, dl_src=%[3]s, dl_dst=%[4]s, %[9]s=%[5]s, %[10]s=%[6]s, nw_ttl=64, %[2]s_dst=%[7]s, %[2]s_src=12345"`,
@@ -1016,7 +1162,7 @@ func getOfprotoIPFamilyArgs(protocol string, ip net.IP) (string, string, string)
 
 // installOvnDetraceDependencies installs dependencies for ovn-detrace with pip3 in case they are missing (for older images).
 // Returns error if dependencies are missing but cannot be installed.
-func installOvnDetraceDependencies(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, podInfo *PodInfo, ovnNamespace string) error {
+func installOvnDetraceDependencies(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, podInfo *PodInfo, ovnNamespace string) error {
 	dependencies := map[string]string{
 		"ovs":       "if type -p ovn-detrace >/dev/null 2>&1; then echo 'true' ; fi",
 		"pyOpenSSL": "if python -c 'import ssl; print(ssl.OPENSSL_VERSION)' > /dev/null; then echo 'true'; fi",
@@ -1048,7 +1194,7 @@ func installOvnDetraceDependencies(coreclient *corev1client.CoreV1Client, restco
 	return nil
 }
 
-func verifyDependency(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, podInfo *PodInfo, ovnNamespace, dependency, depCheckCommand string) (string, string, error) {
+func verifyDependency(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, podInfo *PodInfo, ovnNamespace, dependency, depCheckCommand string) (string, string, error) {
 	depVerifyOut, depVerifyErr, err := execInPod(coreclient, restconfig, ovnNamespace, podInfo.OvnKubePodName, podInfo.OvnKubeContainerName, depCheckCommand, "")
 	if err != nil {
 		return "", "", fmt.Errorf("ovn-detrace error while verifying dependency %s in pod %s, container %s. Error '%v', stdOut: '%s'\n stdErr: %s",
@@ -1061,7 +1207,7 @@ func verifyDependency(coreclient *corev1client.CoreV1Client, restconfig *rest.Co
 
 // runOvnDetrace runs an ovn-detrace command for the given input.
 // Returns error if dependencies are not met (allows for graceful handling of those issues).
-func runOvnDetrace(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, direction string, srcPodInfo *PodInfo,
+func runOvnDetrace(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, direction string, srcPodInfo *PodInfo,
 	dstName string, appSrcDstOut, ovnNamespace string) error {
 	// If NBDB connectivity is not available do not run ovn-detrace.
 	if _, stdErr, err := execInPod(coreclient, restconfig, ovnNamespace, srcPodInfo.OvnKubePodName, srcPodInfo.OvnKubeContainerName, fmt.Sprintf("ovn-nbctl %s get-connection", srcPodInfo.NbCommand), ""); err != nil {
@@ -1086,11 +1232,11 @@ func runOvnDetrace(coreclient *corev1client.CoreV1Client, restconfig *rest.Confi
 }
 
 // displayNodeInfo shows a summary about nodes in this cluster.
-func displayNodeInfo(coreclient *corev1client.CoreV1Client) {
+func displayNodeInfo(coreclient corev1client.CoreV1Interface) {
 	// List all Nodes.
 	nodes, err := coreclient.Nodes().List(context.TODO(), metav1.ListOptions{})
 	if err != nil {
-		klog.Exitf(" Unexpected error: %v", err)
+		exitf(" Unexpected error: %v", err)
 	}
 
 	masters := make(map[string]string)
@@ -1147,13 +1293,45 @@ func getIPVer(ip net.IP) string {
 	return ip6
 }
 
+// exitHooks are run before exiting, as the deferred calls of main don't run when exiting early, e.g. to stop
+// the local databases of the offline mode.
+var exitHooks []func()
+
+// runExitHooks runs the exit hooks in the reverse order of their registration, once.
+func runExitHooks() {
+	for len(exitHooks) > 0 {
+		hook := exitHooks[len(exitHooks)-1]
+		exitHooks = exitHooks[:len(exitHooks)-1]
+		hook()
+	}
+}
+
+// exit runs the exit hooks and exits with the given code.
+func exit(code int) {
+	runExitHooks()
+	klog.Flush()
+	os.Exit(code)
+}
+
+// exitf logs the error like klog.Exitf, and exits with code 1 once the exit hooks ran. With JSON output, the
+// error is reported along with the results of the commands that already ran.
+func exitf(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	klog.ErrorDepth(1, msg)
+	if jsonReport != nil && jsonReport.Error == "" {
+		jsonReport.Error = strings.TrimSpace(msg)
+		jsonReport.print()
+	}
+	exit(1)
+}
+
 // setLogLevel sets the log level for this application.
 func setLogLevel(loglevel string) {
 	klog.InitFlags(nil)
 	klog.SetOutput(os.Stderr)
 	err := level.Set(loglevel)
 	if err != nil {
-		klog.Exitf("fatal: cannot set logging level\n")
+		exitf("fatal: cannot set logging level\n")
 	}
 	klog.V(1).Infof("Log level set to: %s", loglevel)
 }
@@ -1170,6 +1348,10 @@ func main() {
 	dstNamespace := flag.String("dst-namespace", "default", "k8s namespace of dest pod")
 	srcPodName := flag.String("src", "", "src: source pod name")
 	dstPodName := flag.String("dst", "", "dest: destination pod name")
+	srcNodeName := flag.String("src-node", "", "src-node: source node name, to trace from the host network of the node")
+	dstNodeName := flag.String("dst-node", "", "dst-node: destination node name, to trace to the host network of the node")
+	srcNetwork := flag.String("src-network", "", "NAD of the secondary network of the source pod to trace, as <namespace>/<name> or as <name> in the namespace of the pod")
+	dstNetwork := flag.String("dst-network", "", "NAD of the secondary network of the destination pod to trace, as <namespace>/<name> or as <name> in the namespace of the pod")
 	dstSvcName := flag.String("service", "", "service: destination service name")
 	dstIP := flag.String("dst-ip", "", "destination IP address (meant for tests to external targets)")
 	dstPort := flag.String("dst-port", "80", "dst-port: destination port")
@@ -1178,76 +1360,115 @@ func main() {
 	addressFamily := flag.String("addr-family", ip4, "Address family (ip4 or ip6) to be used for tracing")
 	skipOvnDetrace := flag.Bool("skip-detrace", false, "skip ovn-detrace command")
 	dumpVRFTableIDs := flag.Bool("dump-udn-vrf-table-ids", false, "Dump the VRF table ID per node for all the user defined networks")
+	offline := flag.Bool("offline", false, "trace against database snapshots and Kubernetes object dumps instead of a live cluster")
+	dbDir := flag.String("db-dir", "", "offline mode: directory with the "+nbdbFileName+" and "+sbdbFileName+" snapshots, or with one such directory per interconnect zone named after the zone")
+	output := flag.String("output", outputText, "output format, "+outputText+" or "+outputJSON)
+	k8sObjects := flag.String("k8s-objects", "", "offline mode: comma separated list of YAML or JSON files or directories with the dump of the Kubernetes nodes, pods, services and endpoints")
 	loglevel := flag.String("loglevel", "0", "loglevel: klog level")
 	flag.Parse()
 
 	// Set the application's log level.
 	setLogLevel(*loglevel)
 
-	// Get the ClientConfig.
-	// This might work better?  https://godoc.org/sigs.k8s.io/controller-runtime/pkg/client/config
-	// When supplied the kubeconfig supplied via cli takes precedence
+	switch *output {
+	case outputText:
+	case outputJSON:
+		jsonReport = &traceReport{Traces: []*traceResult{}}
+	default:
+		exitf("Usage: -output must be %s or %s", outputText, outputJSON)
+	}
+
 	var restconfig *rest.Config
-	if *cliConfig != "" {
-		// use the current context in kubeconfig
-		restconfig, err = clientcmd.BuildConfigFromFlags("", *cliConfig)
+	var coreclient corev1client.CoreV1Interface
+	if *offline {
+		if *dbDir == "" || *k8sObjects == "" {
+			exitf("Usage: -db-dir and -k8s-objects must be set in offline mode")
+		}
+		// Load the objects that the live mode would get from the API server.
+		coreclient, err = loadKubernetesObjects(*k8sObjects)
+		if err != nil {
+			exitf("Failed to load Kubernetes objects: %v", err)
+		}
+		offlineEnv, err = newOfflineEnvironment(*dbDir)
 		if err != nil {
-			klog.Exitf(" Unexpected error: %v", err)
+			exitf("Failed to set up offline mode: %v", err)
 		}
+		exitHooks = append(exitHooks, offlineEnv.stop)
+		defer runExitHooks()
 	} else {
-		// Instantiate loader for kubeconfig file.
-		kubeconfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
-			clientcmd.NewDefaultClientConfigLoadingRules(),
-			&clientcmd.ConfigOverrides{},
-		)
+		// Get the ClientConfig.
+		// This might work better?  https://godoc.org/sigs.k8s.io/controller-runtime/pkg/client/config
+		// When supplied the kubeconfig supplied via cli takes precedence
+		if *cliConfig != "" {
+			// use the current context in kubeconfig
+			restconfig, err = clientcmd.BuildConfigFromFlags("", *cliConfig)
+			if err != nil {
+				exitf(" Unexpected error: %v", err)
+			}
+		} else {
+			// Instantiate loader for kubeconfig file.
+			kubeconfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+				clientcmd.NewDefaultClientConfigLoadingRules(),
+				&clientcmd.ConfigOverrides{},
+			)
+
+			// Get a rest.Config from the kubeconfig file.  This will be passed into all
+			// the client objects we create.
+			restconfig, err = kubeconfig.ClientConfig()
+			if err != nil {
+				exitf(" Unexpected error: %v", err)
+			}
+		}
 
-		// Get a rest.Config from the kubeconfig file.  This will be passed into all
-		// the client objects we create.
-		restconfig, err = kubeconfig.ClientConfig()
+		// Create a Kubernetes core/v1 client.
+		coreclient, err = corev1client.NewForConfig(restconfig)
 		if err != nil {
-			klog.Exitf(" Unexpected error: %v", err)
+			exitf(" Unexpected error: %v", err)
 		}
 	}
 
-	// Create a Kubernetes core/v1 client.
-	coreclient, err := corev1client.NewForConfig(restconfig)
-	if err != nil {
-		klog.Exitf(" Unexpected error: %v", err)
-	}
-
 	// Get the namespace that OVN pods reside in.
 	ovnNamespace, err := getOvnNamespace(coreclient, *cfgNamespace)
 	if err != nil {
-		klog.Exitf(" Unexpected error: %v", err)
+		exitf(" Unexpected error: %v", err)
 	}
 
 	klog.V(5).Infof("OVN Kubernetes namespace is %s", ovnNamespace)
 
 	if *dumpVRFTableIDs {
+		if offlineEnv != nil {
+			exitf("Usage: -dump-udn-vrf-table-ids is not supported in offline mode")
+		}
 		nodesVRFTableIDs, err := findUserDefinedNetworkVRFTableIDs(coreclient, restconfig, ovnNamespace)
 		if err != nil {
-			klog.Exitf("Failed dumping VRF table IDs: %s", err)
+			exitf("Failed dumping VRF table IDs: %s", err)
 		}
 		fmt.Println(string(nodesVRFTableIDs))
 		return
 	}
 
+	// The report is complete once all the commands ran.
+	defer jsonReport.print()
+
 	// Verify CLI flags.
-	if *srcPodName == "" {
-		klog.Exitf("Usage: source pod must be specified")
+	if *srcPodName == "" && *srcNodeName == "" {
+		exitf("Usage: source pod or source node must be specified")
+	}
+	if *srcPodName != "" && *srcNodeName != "" {
+		exitf("Usage: -src and -src-node cannot be specified at the same time")
 	}
 	if !*tcp && !*udp {
-		klog.Exitf("Usage: either tcp or udp must be specified")
+		exitf("Usage: either tcp or udp must be specified")
 	}
 	if *udp && *tcp {
-		klog.Exitf("Usage: Both tcp and udp cannot be specified at the same time")
+		exitf("Usage: Both tcp and udp cannot be specified at the same time")
 	}
 	if *tcp {
 		protocol = "tcp"
 	}
 	if *udp {
 		if *dstSvcName != "" {
-			klog.Exitf("Usage: udp option is not compatible with destination service trace")
+			exitf("Usage: udp option is not compatible with destination service trace")
 		}
 		protocol = "udp"
 	}
@@ -1255,6 +1476,9 @@ func main() {
 	if *dstPodName != "" {
 		targetOptions++
 	}
+	if *dstNodeName != "" {
+		targetOptions++
+	}
 	if *dstSvcName != "" {
 		targetOptions++
 	}
@@ -1262,11 +1486,22 @@ func main() {
 		targetOptions++
 		parsedDstIP = net.ParseIP(*dstIP)
 		if parsedDstIP == nil {
-			klog.Exitf("Usage: cannot parse IP address provided in -dst-ip")
+			exitf("Usage: cannot parse IP address provided in -dst-ip")
 		}
 	}
 	if targetOptions != 1 {
-		klog.Exitf("Usage: exactly one of -dst, -service or -dst-ip must be set")
+		exitf("Usage: exactly one of -dst, -dst-node, -service or -dst-ip must be set")
+	}
+	var srcNADName, dstNADName string
+	if *srcNetwork != "" || *dstNetwork != "" {
+		if *srcPodName == "" || *dstPodName == "" {
+			exitf("Usage: -src-network and -dst-network are only supported between pods set with -src and -dst")
+		}
+		if *srcNetwork == "" || *dstNetwork == "" {
+			exitf("Usage: -src-network and -dst-network must be specified together")
+		}
+		srcNADName = getNADName(*srcNetwork, *srcNamespace)
+		dstNADName = getNADName(*dstNetwork, *dstNamespace)
 	}
 
 	// Show some information about the nodes in this cluster - only if log level 5 or higher.
@@ -1274,10 +1509,18 @@ func main() {
 		displayNodeInfo(coreclient)
 	}
 
-	// Get info needed for the src Pod
-	srcPodInfo, err := getPodInfo(coreclient, restconfig, *srcPodName, ovnNamespace, *srcNamespace, *addressFamily)
-	if err != nil {
-		klog.Exitf("Failed to get information from pod %s: %v", *srcPodName, err)
+	// Get info needed for the src Pod, or for the src node
+	var srcPodInfo *PodInfo
+	if *srcNodeName != "" {
+		srcPodInfo, err = getNodeEndpointInfo(coreclient, restconfig, *srcNodeName, ovnNamespace, *addressFamily, true)
+		if err != nil {
+			exitf("Failed to get information from node %s: %v", *srcNodeName, err)
+		}
+	} else {
+		srcPodInfo, err = getPodInfo(coreclient, restconfig, *srcPodName, ovnNamespace, *srcNamespace, *addressFamily, srcNADName)
+		if err != nil {
+			exitf("Failed to get information from pod %s: %v", *srcPodName, err)
+		}
 	}
 	klog.V(5).Infof("srcPodInfo is %s\n", srcPodInfo)
 
@@ -1285,6 +1528,10 @@ func main() {
 	if parsedDstIP != nil {
 		klog.V(5).Infof("Running a trace to an IP address")
 		egressNodeName, egressBridgeName := runOvnTraceToIP(coreclient, restconfig, srcPodInfo, parsedDstIP, ovnNamespace, protocol, *dstPort)
+		if offlineEnv != nil {
+			klog.Infof("Skipped ovs-appctl ofproto/trace and ovn-detrace in offline mode")
+			return
+		}
 		appSrcDstOut := runOfprotoTraceToIP(coreclient, restconfig, srcPodInfo, parsedDstIP, ovnNamespace, protocol, *dstPort, egressNodeName, egressBridgeName)
 		if *skipOvnDetrace {
 			return
@@ -1304,7 +1551,7 @@ func main() {
 		// Get dst service
 		dstSvcInfo, err = getSvcInfo(coreclient, restconfig, *dstSvcName, ovnNamespace, *dstNamespace, *addressFamily)
 		if err != nil {
-			klog.Exitf("Failed to get information from service %s: %v", *dstSvcName, err)
+			exitf("Failed to get information from service %s: %v", *dstSvcName, err)
 		}
 		klog.V(5).Infof("dstSvcInfo is %s\n", dstSvcInfo)
 		// Set dst pod name, we'll use this to run through pod-pod tests as if use supplied this pod
@@ -1312,16 +1559,45 @@ func main() {
 		klog.V(1).Infof("Using pod %s in service %s to test against", dstSvcInfo.PodInfo.PodName, *dstSvcName)
 	}
 
-	// Now get info needed for the dst Pod
-	dstPodInfo, err := getPodInfo(coreclient, restconfig, *dstPodName, ovnNamespace, *dstNamespace, *addressFamily)
-	if err != nil {
-		klog.Exitf("Failed to get information from pod %s: %v", *dstPodName, err)
+	// Now get info needed for the dst Pod, or for the dst node
+	var dstPodInfo *PodInfo
+	if *dstNodeName != "" {
+		dstPodInfo, err = getNodeEndpointInfo(coreclient, restconfig, *dstNodeName, ovnNamespace, *addressFamily, false)
+		if err != nil {
+			exitf("Failed to get information from node %s: %v", *dstNodeName, err)
+		}
+	} else {
+		dstPodInfo, err = getPodInfo(coreclient, restconfig, *dstPodName, ovnNamespace, *dstNamespace, *addressFamily, dstNADName)
+		if err != nil {
+			exitf("Failed to get information from pod %s: %v", *dstPodName, err)
+		}
 	}
 	klog.V(5).Infof("dstPodInfo is %s\n", dstPodInfo)
 
 	// At least one pod must not be on the Host Network
 	if srcPodInfo.HostNetwork && dstPodInfo.HostNetwork {
-		klog.Exitf("Both pods cannot be on Host Network; use ping")
+		exitf("Both pods cannot be on Host Network; use ping")
+	}
+
+	// Pods on a secondary network are traced through their logical ports on that network only.
+	if srcNADName != "" {
+		// Pods are only connected through a secondary network when both NADs refer to it.
+		srcNetworkName, err := getSecondaryNetworkName(coreclient, restconfig, srcPodInfo, ovnNamespace)
+		if err != nil {
+			exitf("Failed to get the network of pod %s: %v", *srcPodName, err)
+		}
+		dstNetworkName, err := getSecondaryNetworkName(coreclient, restconfig, dstPodInfo, ovnNamespace)
+		if err != nil {
+			exitf("Failed to get the network of pod %s: %v", *dstPodName, err)
+		}
+		if srcNetworkName != dstNetworkName {
+			exitf("Usage: -src-network %s and -dst-network %s must refer to the same network, found %s and %s",
+				srcNADName, dstNADName, srcNetworkName, dstNetworkName)
+		}
+		runOvnTraceOnSecondaryNetwork(coreclient, restconfig, "source pod to destination pod", srcPodInfo, dstPodInfo, ovnNamespace, protocol, *dstPort)
+		runOvnTraceOnSecondaryNetwork(coreclient, restconfig, "destination pod to source pod", dstPodInfo, srcPodInfo, ovnNamespace, protocol, *dstPort)
+		klog.Infof("Skipped ovs-appctl ofproto/trace and ovn-detrace, they only support the default network")
+		return
 	}
 
 	// ovn-trace commands
@@ -1331,6 +1607,12 @@ func main() {
 	runOvnTraceToPod(coreclient, restconfig, "source pod to destination pod", srcPodInfo, dstPodInfo, ovnNamespace, protocol, *dstPort)
 	runOvnTraceToPod(coreclient, restconfig, "destination pod to source pod", dstPodInfo, srcPodInfo, ovnNamespace, protocol, *dstPort)
 
+	// The next commands need the Open vSwitch database and flows of the nodes, which are not part of the snapshots.
+	if offlineEnv != nil {
+		klog.Infof("Skipped ovs-appctl ofproto/trace and ovn-detrace in offline mode")
+		return
+	}
+
 	// ovs-appctl ofproto/trace commands
 	appSrcDstOut := runOfprotoTraceToPod(coreclient, restconfig, "source pod to destination pod", srcPodInfo, dstPodInfo, ovnNamespace, protocol, *dstPort)
 	appDstSrcOut := runOfprotoTraceToPod(coreclient, restconfig, "destination pod to source pod", dstPodInfo, srcPodInfo, ovnNamespace, protocol, *dstPort)
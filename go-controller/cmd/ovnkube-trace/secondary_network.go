@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	types "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	util "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// getNADName returns the name of the NAD given as <namespace>/<name>, or as <name> in the given namespace.
+func getNADName(network, namespace string) string {
+	if strings.Contains(network, "/") {
+		return network
+	}
+	return util.GetNADName(namespace, network)
+}
+
+// getSecondaryNetworkName returns the network of the logical port of the pod's interface on the secondary network.
+// NADs of different names, like the ones of a network in several namespaces, can refer to the same network.
+func getSecondaryNetworkName(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, podInfo *PodInfo, ovnNamespace string) (string, error) {
+	cmd := fmt.Sprintf(`ovn-nbctl --no-leader-only %s get Logical_Switch_Port %s external_ids:"%s"`,
+		podInfo.NbCommand, podInfo.LogicalPort, types.NetworkExternalID)
+	stdout, stderr, err := execInPod(coreclient, restconfig, ovnNamespace, podInfo.OvnKubePodName, podInfo.OvnKubeContainerName, cmd, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to get the network of logical port %s, stderr: %q: %v", podInfo.LogicalPort, stderr, err)
+	}
+	return strings.Trim(strings.TrimSpace(stdout), `"`), nil
+}
+
+// setSecondaryNetworkInfo adds the information of the pod's interface on the secondary network of the given NAD,
+// parsed from the pod networks annotation.
+func setSecondaryNetworkInfo(podInfo *PodInfo, pod *corev1.Pod, nadName string) error {
+	podAnnotation, err := util.UnmarshalPodAnnotation(pod.Annotations, nadName)
+	if err != nil {
+		return fmt.Errorf("failed to get the annotation of pod %s in namespace %s for network %s: %v", pod.Name, pod.Namespace, nadName, err)
+	}
+	podInfo.NADName = nadName
+	podInfo.LogicalPort = util.GetSecondaryNetworkLogicalPortName(pod.Namespace, pod.Name, nadName)
+	podInfo.MAC = podAnnotation.MAC.String()
+	podInfo.IP = ""
+	for _, ipNet := range podAnnotation.IPs {
+		if getIPVer(ipNet.IP) == podInfo.IPVer {
+			podInfo.IP = ipNet.IP.String()
+			podInfo.Subnet = ipNet.String()
+			break
+		}
+	}
+	if podInfo.IP == "" {
+		return fmt.Errorf("pod %s in namespace %s doesn't have desired ip address configured on network %s", pod.Name, pod.Namespace, nadName)
+	}
+	for _, gateway := range podAnnotation.Gateways {
+		if getIPVer(gateway) == podInfo.IPVer {
+			podInfo.Gateway = gateway.String()
+			break
+		}
+	}
+	return nil
+}
+
+// runOvnTraceOnSecondaryNetwork runs an ovntrace from the src pod to the dst pod over their interfaces on the same
+// secondary network. The first hop is the destination pod itself when it is in the subnet of the source pod, and the
+// router behind the gateway of the source pod otherwise.
+func runOvnTraceOnSecondaryNetwork(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, direction string, srcPodInfo, dstPodInfo *PodInfo, ovnNamespace, protocol, dstPort string) {
+	ethDst := dstPodInfo.MAC
+	_, srcSubnet, err := net.ParseCIDR(srcPodInfo.Subnet)
+	if err != nil {
+		exitf("Failed to parse subnet %s of pod %s on network %s: %v", srcPodInfo.Subnet, srcPodInfo.PodName, srcPodInfo.NADName, err)
+	}
+	if !srcSubnet.Contains(net.ParseIP(dstPodInfo.IP)) {
+		if srcPodInfo.Gateway == "" {
+			exitf("Pod %s has no gateway on network %s to reach %s", srcPodInfo.PodName, srcPodInfo.NADName, dstPodInfo.IP)
+		}
+		// The router ports of secondary networks have a MAC address derived from their IP address.
+		ethDst = util.IPAddrToHWAddr(net.ParseIP(srcPodInfo.Gateway)).String()
+	}
+
+	// The datapath is omitted, ovn-trace finds it from the inport.
+	cmd := fmt.Sprintf(`ovn-trace --no-leader-only %[1]s `+
+		`'inport=="%[2]s" && eth.src==%[3]s && eth.dst==%[4]s && %[5]s.src==%[6]s && %[7]s.dst==%[8]s && ip.ttl==64 && %[9]s.dst==%[10]s && %[9]s.src==52888'`,
+		srcPodInfo.SbCommand,   // 1
+		srcPodInfo.LogicalPort, // 2
+		srcPodInfo.MAC,         // 3
+		ethDst,                 // 4
+		srcPodInfo.IPVer,       // 5
+		srcPodInfo.IP,          // 6
+		dstPodInfo.IPVer,       // 7
+		dstPodInfo.IP,          // 8
+		protocol,               // 9
+		dstPort,                // 10
+	)
+	klog.V(4).Infof("ovn-trace command from %s on network %s is %s", direction, srcPodInfo.NADName, cmd)
+
+	// The packet either reaches the destination pod's port, which can be a remote port with interconnect, or leaves the
+	// zone through the transit switch port of the destination pod's node on a layer3 network.
+	successString := fmt.Sprintf(`output to "%s"|output to "[^"]*%s%s"`,
+		regexp.QuoteMeta(dstPodInfo.LogicalPort), types.TransitSwitchToRouterPrefix, regexp.QuoteMeta(dstPodInfo.NodeName))
+	ovnSrcDstOut, ovnSrcDstErr, err := execInPod(coreclient, restconfig, ovnNamespace, srcPodInfo.OvnKubePodName, srcPodInfo.OvnKubeContainerName, cmd, "")
+	description := fmt.Sprintf("ovn-trace %s on network %s", direction, srcPodInfo.NADName)
+	reportOvnTrace(coreclient, restconfig, ovnNamespace, srcPodInfo, description, ovnSrcDstOut, err)
+	printSuccessOrFailure(description, srcPodInfo.PodName, dstPodInfo.PodName, ovnSrcDstOut, ovnSrcDstErr, err, successString)
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetNADName(t *testing.T) {
+	assert.Equal(t, "ns1/blue", getNADName("blue", "ns1"))
+	assert.Equal(t, "ns2/blue", getNADName("ns2/blue", "ns1"))
+}
+
+func TestSetSecondaryNetworkInfo(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "client",
+			Namespace: "ns1",
+			Annotations: map[string]string{
+				"k8s.ovn.org/pod-networks": `{"default":{"ip_addresses":["10.244.1.5/24"],"mac_address":"0a:58:0a:f4:01:05",` +
+					`"gateway_ips":["10.244.1.1"],"ip_address":"10.244.1.5/24","gateway_ip":"10.244.1.1"},` +
+					`"ns1/blue":{"ip_addresses":["192.168.0.5/24","fd00:192:168::5/64"],"mac_address":"0a:58:c0:a8:00:05",` +
+					`"gateway_ips":["192.168.0.1"],"ip_address":"192.168.0.5/24","gateway_ip":"192.168.0.1"}}`,
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		addressFamily   string
+		nadName         string
+		expectedIP      string
+		expectedSubnet  string
+		expectedGateway string
+		expectErr       bool
+	}{
+		{
+			name:            "IPv4 with gateway",
+			addressFamily:   ip4,
+			nadName:         "ns1/blue",
+			expectedIP:      "192.168.0.5",
+			expectedSubnet:  "192.168.0.5/24",
+			expectedGateway: "192.168.0.1",
+		},
+		{
+			name:           "IPv6 without gateway",
+			addressFamily:  ip6,
+			nadName:        "ns1/blue",
+			expectedIP:     "fd00:192:168::5",
+			expectedSubnet: "fd00:192:168::5/64",
+		},
+		{
+			name:          "pod not attached to the network",
+			addressFamily: ip4,
+			nadName:       "ns1/red",
+			expectErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			podInfo := &PodInfo{IPVer: tt.addressFamily, IP: "10.244.1.5", MAC: "0a:58:0a:f4:01:05"}
+			err := setSecondaryNetworkInfo(podInfo, pod, tt.nadName)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.nadName, podInfo.NADName)
+			assert.Equal(t, "ns1.blue_ns1_client", podInfo.LogicalPort)
+			assert.Equal(t, "0a:58:c0:a8:00:05", podInfo.MAC)
+			assert.Equal(t, tt.expectedIP, podInfo.IP)
+			assert.Equal(t, tt.expectedSubnet, podInfo.Subnet)
+			assert.Equal(t, tt.expectedGateway, podInfo.Gateway)
+		})
+	}
+}
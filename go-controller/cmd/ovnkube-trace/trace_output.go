@@ -0,0 +1,327 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/sampledecoder"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+)
+
+// Output formats of ovnkube-trace.
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+var (
+	ovnTraceHopRegex      = regexp.MustCompile(`^\s*(ingress|egress)\(dp="([^"]*)", inport="([^"]*)"(?:, outport="([^"]*)")?\)$`)
+	ovnTraceFlowRegex     = regexp.MustCompile(`^\s*(\d+)\. (\S+)(?: \([^)]*\))?: (.*), priority (\d+), uuid ([0-9a-f]+)$`)
+	ovnTraceACLStageRegex = regexp.MustCompile(`^ls_(in|out)_acl(_after_lb)?(_eval)?$`)
+)
+
+// jsonReport collects the results of the commands when the output format is JSON, it is nil otherwise.
+var jsonReport *traceReport
+
+// traceReport is the JSON output of ovnkube-trace.
+type traceReport struct {
+	Traces []*traceResult `json:"traces"`
+	// Error is the error that ovnkube-trace exited on, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// traceResult is the result of a command run by ovnkube-trace.
+type traceResult struct {
+	// Command describes the command, e.g. "ovn-trace source pod to destination pod".
+	Command     string `json:"command"`
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	Success     bool   `json:"success"`
+	// Hops are the logical flows matched by the packet, in order. Only set for ovn-trace.
+	Hops []traceFlow `json:"hops,omitempty"`
+	// Verdict is the verdict of the ACLs matched by the packet. Only set for ovn-trace.
+	Verdict *traceVerdict `json:"verdict,omitempty"`
+	// Output is the raw output of the commands other than ovn-trace.
+	Output string `json:"output,omitempty"`
+
+	completed bool
+}
+
+// traceHop is a logical datapath traversed by the packet, as reported by ovn-trace.
+type traceHop struct {
+	datapath string
+	inport   string
+	outport  string
+}
+
+// traceFlow is a logical flow matched by the packet, as reported by ovn-trace.
+type traceFlow struct {
+	Datapath string   `json:"datapath"`
+	Pipeline string   `json:"pipeline"`
+	Table    int      `json:"table"`
+	Stage    string   `json:"stage"`
+	Priority int      `json:"priority"`
+	Match    string   `json:"match"`
+	UUID     string   `json:"uuid"`
+	Actions  []string `json:"actions"`
+	// ACL is the ACL that the flow was generated from. It is only looked up for the flows of the ACL
+	// evaluation stages.
+	ACL *traceACL `json:"acl,omitempty"`
+}
+
+// traceACL is an ACL of the NB database.
+type traceACL struct {
+	UUID        string            `json:"uuid"`
+	Name        string            `json:"name,omitempty"`
+	Action      string            `json:"action"`
+	Direction   string            `json:"direction"`
+	Priority    int               `json:"priority"`
+	Match       string            `json:"match"`
+	ExternalIDs map[string]string `json:"externalIDs,omitempty"`
+}
+
+// traceVerdict is the verdict of the ACL deciding the fate of the packet, along with the Kubernetes object
+// that the ACL was created for.
+type traceVerdict struct {
+	ACL       string `json:"acl,omitempty"`
+	Action    string `json:"action,omitempty"`
+	Actor     string `json:"actor,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Direction string `json:"direction,omitempty"`
+	Message   string `json:"message"`
+}
+
+// getResult returns the result of the command being reported, adding it if needed.
+func (r *traceReport) getResult(commandDescription string) *traceResult {
+	if len(r.Traces) > 0 {
+		last := r.Traces[len(r.Traces)-1]
+		if last.Command == commandDescription && !last.completed {
+			return last
+		}
+	}
+	result := &traceResult{Command: commandDescription}
+	r.Traces = append(r.Traces, result)
+	return result
+}
+
+// print writes the report to stdout.
+func (r *traceReport) print() {
+	if r == nil {
+		return
+	}
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		exitf("Failed to marshal the JSON output: %v", err)
+	}
+	fmt.Println(string(b))
+}
+
+// String returns a description of the ACL verdict.
+func (acl *traceACL) String() string {
+	name := acl.Name
+	if name == "" {
+		name = acl.UUID
+	}
+	description := fmt.Sprintf("%s by ACL %q (%s, priority %d", acl.Action, name, acl.Direction, acl.Priority)
+	if ownerType := acl.ExternalIDs[libovsdbops.OwnerTypeKey.String()]; ownerType != "" {
+		description += fmt.Sprintf(", %s %s", ownerType, acl.ExternalIDs[libovsdbops.ObjectNameKey.String()])
+	}
+	return description + "): " + acl.Match
+}
+
+// parseOvnTrace returns the logical datapaths traversed by the packet and the logical flows that it matched,
+// from the detailed output of ovn-trace.
+func parseOvnTrace(output string) ([]traceHop, []traceFlow) {
+	var hops []traceHop
+	var flows []traceFlow
+	var flow *traceFlow
+	var pipeline string
+	for _, line := range strings.Split(output, "\n") {
+		if m := ovnTraceHopRegex.FindStringSubmatch(line); m != nil {
+			flow = nil
+			pipeline = m[1]
+			if pipeline == "egress" && len(hops) > 0 {
+				last := &hops[len(hops)-1]
+				if last.datapath == m[2] && last.inport == m[3] && last.outport == "" {
+					last.outport = m[4]
+					continue
+				}
+			}
+			hops = append(hops, traceHop{datapath: m[2], inport: m[3], outport: m[4]})
+			continue
+		}
+		if m := ovnTraceFlowRegex.FindStringSubmatch(line); m != nil {
+			table, _ := strconv.Atoi(m[1])
+			priority, _ := strconv.Atoi(m[4])
+			var datapath string
+			if len(hops) > 0 {
+				datapath = hops[len(hops)-1].datapath
+			}
+			flows = append(flows, traceFlow{
+				Datapath: datapath,
+				Pipeline: pipeline,
+				Table:    table,
+				Stage:    m[2],
+				Priority: priority,
+				Match:    m[3],
+				UUID:     m[5],
+			})
+			flow = &flows[len(flows)-1]
+			continue
+		}
+		action := strings.TrimSpace(line)
+		if action == "" || strings.Trim(action, "-") == "" {
+			flow = nil
+			continue
+		}
+		if flow != nil {
+			flow.Actions = append(flow.Actions, action)
+		}
+	}
+	return hops, flows
+}
+
+// isACLStage returns true if the stage evaluates the ACLs of logical switches.
+func isACLStage(stage string) bool {
+	return ovnTraceACLStageRegex.MatchString(stage)
+}
+
+// getVerdict returns the verdict of the last ACL matched by the packet that did not pass the decision on to
+// the next tier, or of the last ACL matched if all of them did. The Kubernetes object responsible for the
+// verdict is decoded from the external IDs of the ACL like for the observability samples.
+func getVerdict(flows []traceFlow) *traceVerdict {
+	var deciding *traceACL
+	for _, flow := range flows {
+		if flow.ACL == nil {
+			continue
+		}
+		if flow.ACL.Action != nbdb.ACLActionPass || deciding == nil || deciding.Action == nbdb.ACLActionPass {
+			deciding = flow.ACL
+		}
+	}
+	if deciding == nil {
+		return &traceVerdict{Message: "No ACL matched"}
+	}
+
+	verdict := &traceVerdict{
+		ACL:    deciding.Name,
+		Action: deciding.Action,
+	}
+	if verdict.ACL == "" {
+		verdict.ACL = deciding.UUID
+	}
+	event, err := sampledecoder.NewACLEvent(&nbdb.ACL{Action: deciding.Action, ExternalIDs: deciding.ExternalIDs})
+	if err != nil || event.Actor == "" {
+		klog.V(5).Infof("Could not decode the owner of ACL %s: %v", verdict.ACL, err)
+		verdict.Message = fmt.Sprintf("Action %s by ACL %s", deciding.Action, verdict.ACL)
+		return verdict
+	}
+	verdict.Actor = event.Actor
+	verdict.Name = event.Name
+	verdict.Namespace = event.Namespace
+	verdict.Direction = event.Direction
+	verdict.Message = event.String()
+	return verdict
+}
+
+// reportOvnTrace reports the logical flows matched by the packet in an ovn-trace run against the databases of
+// podInfo, along with the ACLs that they come from. They are added to the JSON output, or printed in offline
+// mode, and ignored otherwise.
+func reportOvnTrace(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, ovnNamespace string, podInfo *PodInfo, commandDescription, commandStdout string, err error) {
+	if err != nil || (jsonReport == nil && offlineEnv == nil) {
+		return
+	}
+	hops, flows := parseOvnTrace(commandStdout)
+	for i := range flows {
+		if isACLStage(flows[i].Stage) {
+			flows[i].ACL = lookupACL(coreclient, restconfig, ovnNamespace, podInfo, flows[i].UUID)
+		}
+	}
+
+	if jsonReport != nil {
+		result := jsonReport.getResult(commandDescription)
+		result.Hops = flows
+		result.Verdict = getVerdict(flows)
+		return
+	}
+
+	fmt.Printf("Logical path of %s:\n", commandDescription)
+	for _, hop := range hops {
+		if hop.outport == "" {
+			fmt.Printf("  %s: %s\n", hop.datapath, hop.inport)
+			continue
+		}
+		fmt.Printf("  %s: %s -> %s\n", hop.datapath, hop.inport, hop.outport)
+	}
+	fmt.Printf("ACL verdicts of %s:\n", commandDescription)
+	for _, flow := range flows {
+		if !isACLStage(flow.Stage) {
+			continue
+		}
+		if flow.ACL == nil {
+			fmt.Printf("  %s %s: default flow, priority %d: %s\n", flow.Datapath, flow.Stage, flow.Priority, strings.Join(flow.Actions, " "))
+			continue
+		}
+		fmt.Printf("  %s %s: %s\n", flow.Datapath, flow.Stage, flow.ACL)
+	}
+	fmt.Printf("  %s\n", getVerdict(flows).Message)
+}
+
+// lookupACL returns the ACL that the logical flow was generated from, found through the stage hint that northd
+// sets on such flows, or nil if there is none.
+func lookupACL(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, ovnNamespace string, podInfo *PodInfo, flowUUID string) *traceACL {
+	cmd := fmt.Sprintf("ovn-sbctl --no-leader-only %s --if-exists get Logical_Flow %s external_ids:stage-hint", podInfo.SbCommand, flowUUID)
+	stdout, stderr, err := execInPod(coreclient, restconfig, ovnNamespace, podInfo.OvnKubePodName, podInfo.OvnKubeContainerName, cmd, "")
+	if err != nil {
+		klog.V(5).Infof("Failed to get the stage hint of logical flow %s, err: %v, stderr: %s", flowUUID, err, stderr)
+		return nil
+	}
+	stageHint := strings.Trim(strings.TrimSpace(stdout), `"`)
+	if stageHint == "" {
+		return nil
+	}
+
+	cmd = fmt.Sprintf("ovn-nbctl --no-leader-only %s --bare --columns=_uuid,name,action,direction,priority,match,external_ids list ACL %s", podInfo.NbCommand, stageHint)
+	stdout, stderr, err = execInPod(coreclient, restconfig, ovnNamespace, podInfo.OvnKubePodName, podInfo.OvnKubeContainerName, cmd, "")
+	if err != nil {
+		klog.V(5).Infof("Failed to get ACL %s of logical flow %s, err: %v, stderr: %s", stageHint, flowUUID, err, stderr)
+		return nil
+	}
+	return parseACL(stdout)
+}
+
+// parseACL parses the output of
+// `ovn-nbctl --bare --columns=_uuid,name,action,direction,priority,match,external_ids list ACL`.
+func parseACL(output string) *traceACL {
+	columns := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+	for len(columns) < 7 {
+		columns = append(columns, "")
+	}
+	priority, _ := strconv.Atoi(columns[4])
+	acl := &traceACL{
+		UUID:      columns[0],
+		Name:      columns[1],
+		Action:    columns[2],
+		Direction: columns[3],
+		Priority:  priority,
+		Match:     columns[5],
+	}
+	for _, field := range strings.Fields(columns[6]) {
+		if key, value, ok := strings.Cut(field, "="); ok {
+			if acl.ExternalIDs == nil {
+				acl.ExternalIDs = map[string]string{}
+			}
+			acl.ExternalIDs[key] = strings.Trim(value, `"`)
+		}
+	}
+	return acl
+}
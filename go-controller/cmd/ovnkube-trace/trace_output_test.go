@@ -0,0 +1,127 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const ovnTraceOutput = `# udp,reg14=0x3,vlan_tci=0x0000,dl_src=0a:58:0a:f4:02:03,dl_dst=0a:58:0a:f4:02:01,nw_src=10.244.2.3,nw_dst=10.244.1.6,nw_tos=0,nw_ecn=0,nw_ttl=64,nw_frag=no,tp_src=52888,tp_dst=53
+
+ingress(dp="ovn-worker2", inport="default_client")
+--------------------------------------------------
+ 0. ls_in_check_port_sec (northd.c:8583): 1, priority 50, uuid de664d3a
+    reg0[15] = check_in_port_sec();
+    next;
+ 6. ls_in_pre_stateful (northd.c:6201): reg0[2] == 1, priority 110, uuid 82c039a6
+    ct_lb_mark;
+
+ct_lb_mark /* default (use --ct to customize) */
+------------------------------------------------
+ 8. ls_in_acl_eval (northd.c:6681): reg0[7] == 1 && (outport == @a1234 && ip4), priority 2001, uuid 6bc6a2b4
+    reg8[16] = 1;
+    next;
+
+egress(dp="ovn-worker2", inport="default_client", outport="stor-ovn-worker2")
+-----------------------------------------------------------------------------
+ 4. ls_out_acl_eval (northd.c:6762): 1, priority 0, uuid 2c98ee3d
+    reg8[16] = 1;
+    next;
+10. ls_out_apply_port_sec (northd.c:5848): 1, priority 0, uuid 12ba0dbe
+    output;
+    /* output to "stor-ovn-worker2", type "patch" */
+
+ingress(dp="ovn_cluster_router", inport="rtos-ovn-worker2")
+-----------------------------------------------------------
+13. lr_in_ip_routing (northd.c:10603): reg7 == 0 && ip4.dst == 10.244.1.0/24, priority 73, uuid 1ed4e720
+    next;
+`
+
+func TestParseOvnTrace(t *testing.T) {
+	hops, flows := parseOvnTrace(ovnTraceOutput)
+	assert.Equal(t, []traceHop{
+		{datapath: "ovn-worker2", inport: "default_client", outport: "stor-ovn-worker2"},
+		{datapath: "ovn_cluster_router", inport: "rtos-ovn-worker2"},
+	}, hops)
+	assert.Equal(t, []traceFlow{
+		{Datapath: "ovn-worker2", Pipeline: "ingress", Table: 0, Stage: "ls_in_check_port_sec", Priority: 50, Match: "1", UUID: "de664d3a",
+			Actions: []string{"reg0[15] = check_in_port_sec();", "next;"}},
+		{Datapath: "ovn-worker2", Pipeline: "ingress", Table: 6, Stage: "ls_in_pre_stateful", Priority: 110, Match: "reg0[2] == 1", UUID: "82c039a6",
+			Actions: []string{"ct_lb_mark;"}},
+		{Datapath: "ovn-worker2", Pipeline: "ingress", Table: 8, Stage: "ls_in_acl_eval", Priority: 2001, Match: "reg0[7] == 1 && (outport == @a1234 && ip4)", UUID: "6bc6a2b4",
+			Actions: []string{"reg8[16] = 1;", "next;"}},
+		{Datapath: "ovn-worker2", Pipeline: "egress", Table: 4, Stage: "ls_out_acl_eval", Priority: 0, Match: "1", UUID: "2c98ee3d",
+			Actions: []string{"reg8[16] = 1;", "next;"}},
+		{Datapath: "ovn-worker2", Pipeline: "egress", Table: 10, Stage: "ls_out_apply_port_sec", Priority: 0, Match: "1", UUID: "12ba0dbe",
+			Actions: []string{"output;", `/* output to "stor-ovn-worker2", type "patch" */`}},
+		{Datapath: "ovn_cluster_router", Pipeline: "ingress", Table: 13, Stage: "lr_in_ip_routing", Priority: 73, Match: "reg7 == 0 && ip4.dst == 10.244.1.0/24", UUID: "1ed4e720",
+			Actions: []string{"next;"}},
+	}, flows)
+	assert.True(t, isACLStage(flows[2].Stage))
+	assert.False(t, isACLStage(flows[1].Stage))
+}
+
+func TestParseACL(t *testing.T) {
+	acl := parseACL("4d5e6f70-0000-0000-0000-000000000000\nNP:default:allow-dns:Ingress:0\nallow-related\nto-lport\n1001\nip4.src == {$a123}\n" +
+		"direction=Ingress k8s.ovn.org/name=default:allow-dns k8s.ovn.org/owner-type=NetworkPolicy\n")
+	assert.Equal(t, &traceACL{
+		UUID:      "4d5e6f70-0000-0000-0000-000000000000",
+		Name:      "NP:default:allow-dns:Ingress:0",
+		Action:    "allow-related",
+		Direction: "to-lport",
+		Priority:  1001,
+		Match:     "ip4.src == {$a123}",
+		ExternalIDs: map[string]string{
+			"direction":              "Ingress",
+			"k8s.ovn.org/name":       "default:allow-dns",
+			"k8s.ovn.org/owner-type": "NetworkPolicy",
+		},
+	}, acl)
+	assert.Equal(t, `allow-related by ACL "NP:default:allow-dns:Ingress:0" (to-lport, priority 1001, NetworkPolicy default:allow-dns): ip4.src == {$a123}`, acl.String())
+
+	acl = parseACL("4d5e6f70-0000-0000-0000-000000000000\n\ndrop\nfrom-lport\n1000\nip4\n\n")
+	assert.Nil(t, acl.ExternalIDs)
+	assert.Equal(t, `drop by ACL "4d5e6f70-0000-0000-0000-000000000000" (from-lport, priority 1000): ip4`, acl.String())
+}
+
+func TestGetVerdict(t *testing.T) {
+	anpPass := &traceACL{UUID: "1", Name: "ANP:pass", Action: "pass", ExternalIDs: map[string]string{
+		"k8s.ovn.org/owner-type": "AdminNetworkPolicy", "k8s.ovn.org/name": "pass", "direction": "Ingress"}}
+	npDrop := &traceACL{UUID: "2", Name: "NP:default:deny", Action: "drop", ExternalIDs: map[string]string{
+		"k8s.ovn.org/owner-type": "NetworkPolicy", "k8s.ovn.org/name": "default:deny", "direction": "Ingress"}}
+	unowned := &traceACL{UUID: "3", Action: "allow"}
+
+	tests := []struct {
+		name  string
+		flows []traceFlow
+		want  *traceVerdict
+	}{
+		{
+			name:  "no ACL matched",
+			flows: []traceFlow{{Stage: "ls_in_acl_eval"}},
+			want:  &traceVerdict{Message: "No ACL matched"},
+		},
+		{
+			name:  "pass is decided by the next tier",
+			flows: []traceFlow{{ACL: anpPass}, {ACL: npDrop}},
+			want: &traceVerdict{ACL: "NP:default:deny", Action: "drop", Actor: "NetworkPolicy", Name: "deny", Namespace: "default",
+				Direction: "Ingress", Message: "Dropped by network policy deny in namespace default, direction Ingress"},
+		},
+		{
+			name:  "pass only",
+			flows: []traceFlow{{ACL: anpPass}},
+			want: &traceVerdict{ACL: "ANP:pass", Action: "pass", Actor: "AdminNetworkPolicy", Name: "pass", Direction: "Ingress",
+				Message: "Delegated to network policy by admin network policy pass, direction Ingress"},
+		},
+		{
+			name:  "ACL without owner",
+			flows: []traceFlow{{ACL: unowned}},
+			want:  &traceVerdict{ACL: "3", Action: "allow", Message: "Action allow by ACL 3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, getVerdict(tt.flows))
+		})
+	}
+}
@@ -16,7 +16,7 @@ import (
 	util "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
-func findUserDefinedNetworkVRFTableIDs(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, ovnNamespace string) (string, error) {
+func findUserDefinedNetworkVRFTableIDs(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, ovnNamespace string) (string, error) {
 	nodeList, err := coreclient.Nodes().List(context.TODO(), metav1.ListOptions{})
 	if err != nil {
 		return "", err
@@ -46,7 +46,7 @@ func findUserDefinedNetworkVRFTableIDs(coreclient *corev1client.CoreV1Client, re
 	return string(nodesTableIDsJSON), nil
 }
 
-func findUserDefinedNetworkVRFTableID(coreclient *corev1client.CoreV1Client, restconfig *rest.Config, node *corev1.Node, ovnNamespace string, networkID string) (*uint, error) {
+func findUserDefinedNetworkVRFTableID(coreclient corev1client.CoreV1Interface, restconfig *rest.Config, node *corev1.Node, ovnNamespace string, networkID string) (*uint, error) {
 	ovnKubePodName, err := getOvnKubePodOnNode(coreclient, ovnNamespace, node.Name)
 	if err != nil {
 		return nil, err
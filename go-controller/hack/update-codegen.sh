@@ -13,7 +13,7 @@ SCRIPT_ROOT=$(dirname ${BASH_SOURCE})/..
 olddir="${PWD}"
 builddir="$(mktemp -d)"
 cd "${builddir}"
-GO111MODULE=on go install sigs.k8s.io/controller-tools/cmd/controller-gen@v0.16.4
+GO111MODULE=on go install sigs.k8s.io/controller-tools/cmd/controller-gen@v0.17.3
 BINS=(
     deepcopy-gen
     applyconfiguration-gen
@@ -121,6 +121,8 @@ sed -i -e':begin;$!N;s/.*metadata:\n.*type: object/&\n            properties:\n
 echo "Copying the CRDs to dist/templates as j2 files... Add them to your commit..."
 echo "Copying egressFirewall CRD"
 cp _output/crds/k8s.ovn.org_egressfirewalls.yaml ../dist/templates/k8s.ovn.org_egressfirewalls.yaml.j2
+echo "Copying clusterEgressFirewall CRD"
+cp _output/crds/k8s.ovn.org_clusteregressfirewalls.yaml ../dist/templates/k8s.ovn.org_clusteregressfirewalls.yaml.j2
 echo "Copying egressIP CRD"
 cp _output/crds/k8s.ovn.org_egressips.yaml ../dist/templates/k8s.ovn.org_egressips.yaml.j2
 echo "Copying egressQoS CRD"
@@ -131,6 +133,8 @@ echo "Copying egressService CRD"
 cp _output/crds/k8s.ovn.org_egressservices.yaml ../dist/templates/k8s.ovn.org_egressservices.yaml.j2
 echo "Copying networkQoS CRD"
 cp _output/crds/k8s.ovn.org_networkqoses.yaml ../dist/templates/k8s.ovn.org_networkqoses.yaml.j2
+echo "Copying observabilityConfig CRD"
+cp _output/crds/k8s.ovn.org_observabilityconfigs.yaml ../dist/templates/k8s.ovn.org_observabilityconfigs.yaml.j2
 echo "Copying userdefinednetworks CRD"
 cp _output/crds/k8s.ovn.org_userdefinednetworks.yaml ../dist/templates/k8s.ovn.org_userdefinednetworks.yaml.j2
 echo "Copying clusteruserdefinednetworks CRD"
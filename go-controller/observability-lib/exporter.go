@@ -0,0 +1,168 @@
+package observability_lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
+)
+
+// Output formats of the samples.
+const (
+	TextOutputFormat = "text"
+	JSONOutputFormat = "json"
+)
+
+// sampleExporter sends the decoded samples to a consumer.
+type sampleExporter interface {
+	Export(sample *Sample) error
+	Close() error
+}
+
+// textExporter prints the samples as human-readable lines.
+type textExporter struct {
+	logger          *log.Logger
+	logCookie       bool
+	printDecoded    bool
+	printFullPacket bool
+}
+
+func newTextExporter(w io.Writer, logCookie, printDecoded, printFullPacket bool) *textExporter {
+	return &textExporter{
+		logger:          log.New(w, "", log.Ldate|log.Ltime|log.Lmicroseconds),
+		logCookie:       logCookie,
+		printDecoded:    printDecoded,
+		printFullPacket: printFullPacket,
+	}
+}
+
+func (e *textExporter) Export(sample *Sample) error {
+	if e.logCookie {
+		var groupStr, cookieStr string
+		if sample.GroupID != nil {
+			groupStr = fmt.Sprintf("group_id=%v", *sample.GroupID)
+		}
+		if sample.HasCookie {
+			cookieStr = fmt.Sprintf("obs_domain=%v, obs_point=%v", sample.ObsDomainID, sample.ObsPointID)
+		}
+		e.logger.Println(groupStr + ", " + cookieStr)
+	}
+	if e.printDecoded {
+		if sample.DecodeError != nil {
+			e.logger.Println(fmt.Sprintf("decoding failed: %v", sample.DecodeError))
+		} else if sample.Event != nil {
+			e.logger.Println(fmt.Sprintf("OVN-K message: %s", sample.Event.String()))
+		} else {
+			e.logger.Println("")
+		}
+		if sample.PacketEventsError != nil {
+			e.logger.Println(fmt.Sprintf("decoding packet failed: %v", sample.PacketEventsError))
+		}
+		for _, event := range sample.PacketEvents {
+			e.logger.Println(fmt.Sprintf("OVN-K inferred message: %s", event.String()))
+		}
+	}
+	if e.printFullPacket && sample.Packet != nil {
+		e.logger.Println(sample.Packet.String())
+	} else {
+		e.logger.Println(fmt.Sprintf("src=%s, dst=%v\n", sample.SrcIP, sample.DstIP))
+	}
+	return nil
+}
+
+func (e *textExporter) Close() error {
+	return nil
+}
+
+// jsonEvent is the JSON representation of a network event.
+type jsonEvent struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+	// Inferred is set for the events that are not sampled, see model.IsInferred.
+	Inferred bool               `json:"inferred,omitempty"`
+	Details  model.NetworkEvent `json:"details"`
+}
+
+// jsonSample is the JSON representation of a sample, one per line.
+type jsonSample struct {
+	Time         time.Time   `json:"time"`
+	GroupID      *uint32     `json:"groupID,omitempty"`
+	ObsDomainID  *uint32     `json:"obsDomainID,omitempty"`
+	ObsPointID   *uint32     `json:"obsPointID,omitempty"`
+	SrcIP        string      `json:"srcIP,omitempty"`
+	DstIP        string      `json:"dstIP,omitempty"`
+	Protocol     string      `json:"protocol,omitempty"`
+	SrcPort      uint16      `json:"srcPort,omitempty"`
+	DstPort      uint16      `json:"dstPort,omitempty"`
+	Event        *jsonEvent  `json:"event,omitempty"`
+	PacketEvents []jsonEvent `json:"packetEvents,omitempty"`
+	Errors       []string    `json:"errors,omitempty"`
+	Packet       string      `json:"packet,omitempty"`
+}
+
+// jsonExporter writes the samples as JSON lines.
+type jsonExporter struct {
+	encoder         *json.Encoder
+	printFullPacket bool
+}
+
+func newJSONExporter(w io.Writer, printFullPacket bool) *jsonExporter {
+	return &jsonExporter{
+		encoder:         json.NewEncoder(w),
+		printFullPacket: printFullPacket,
+	}
+}
+
+func newJSONEvent(event model.NetworkEvent) jsonEvent {
+	return jsonEvent{
+		Type:     model.EventType(event),
+		Message:  event.String(),
+		Inferred: model.IsInferred(event),
+		Details:  event,
+	}
+}
+
+func (e *jsonExporter) Export(sample *Sample) error {
+	s := jsonSample{
+		Time:    sample.Time,
+		GroupID: sample.GroupID,
+		SrcPort: sample.SrcPort,
+		DstPort: sample.DstPort,
+	}
+	if sample.HasCookie {
+		s.ObsDomainID = &sample.ObsDomainID
+		s.ObsPointID = &sample.ObsPointID
+	}
+	if sample.SrcIP != nil {
+		s.SrcIP = sample.SrcIP.String()
+		s.DstIP = sample.DstIP.String()
+		s.Protocol = sample.ProtocolName
+		if s.Protocol == "" {
+			s.Protocol = fmt.Sprintf("%d", sample.Protocol)
+		}
+	}
+	if sample.Event != nil {
+		event := newJSONEvent(sample.Event)
+		s.Event = &event
+	}
+	for _, event := range sample.PacketEvents {
+		s.PacketEvents = append(s.PacketEvents, newJSONEvent(event))
+	}
+	if sample.DecodeError != nil {
+		s.Errors = append(s.Errors, fmt.Sprintf("decoding failed: %v", sample.DecodeError))
+	}
+	if sample.PacketEventsError != nil {
+		s.Errors = append(s.Errors, fmt.Sprintf("decoding packet failed: %v", sample.PacketEventsError))
+	}
+	if e.printFullPacket && sample.Packet != nil {
+		s.Packet = sample.Packet.String()
+	}
+	return e.encoder.Encode(&s)
+}
+
+func (e *jsonExporter) Close() error {
+	return nil
+}
@@ -0,0 +1,135 @@
+package observability_lib
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
+)
+
+func newTestSample() *Sample {
+	group := uint32(10)
+	return &Sample{
+		Time:         time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC),
+		GroupID:      &group,
+		HasCookie:    true,
+		ObsDomainID:  33554433,
+		ObsPointID:   7,
+		SrcIP:        net.ParseIP("10.128.0.5"),
+		DstIP:        net.ParseIP("10.128.1.6"),
+		Protocol:     6,
+		ProtocolName: "tcp",
+		SrcPort:      34567,
+		DstPort:      8080,
+		Event: &model.ACLEvent{
+			Action:    "drop",
+			Actor:     "NetworkPolicy",
+			Name:      "deny-all",
+			Namespace: "default",
+			Direction: "Ingress",
+		},
+		PacketEvents: []model.NetworkEvent{
+			&model.LBEvent{Service: "default/web", VIP: "172.30.0.10:80", Backend: "10.128.1.6:8080", Protocol: "tcp"},
+		},
+	}
+}
+
+func TestTextExporter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	exporter := newTextExporter(buf, true, true, false)
+	require.NoError(t, exporter.Export(newTestSample()))
+
+	lines := strings.Split(buf.String(), "\n")
+	require.GreaterOrEqual(t, len(lines), 4)
+	assert.True(t, strings.HasSuffix(lines[0], "group_id=10, obs_domain=33554433, obs_point=7"), lines[0])
+	assert.True(t, strings.HasSuffix(lines[1], "OVN-K message: Dropped by network policy deny-all in namespace default, direction Ingress"), lines[1])
+	assert.True(t, strings.HasSuffix(lines[2], "OVN-K inferred message: Load balanced by service default/web from VIP 172.30.0.10:80 to backend 10.128.1.6:8080 (tcp)"), lines[2])
+	assert.True(t, strings.HasSuffix(lines[3], "src=10.128.0.5, dst=10.128.1.6"), lines[3])
+}
+
+func TestJSONExporter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	exporter := newJSONExporter(buf, false)
+	require.NoError(t, exporter.Export(newTestSample()))
+	failed := &Sample{Time: time.Date(2024, 10, 1, 12, 0, 1, 0, time.UTC), DecodeError: errors.New("find sample failed")}
+	require.NoError(t, exporter.Export(failed))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &decoded))
+	assert.Equal(t, map[string]any{
+		"time":        "2024-10-01T12:00:00Z",
+		"groupID":     float64(10),
+		"obsDomainID": float64(33554433),
+		"obsPointID":  float64(7),
+		"srcIP":       "10.128.0.5",
+		"dstIP":       "10.128.1.6",
+		"protocol":    "tcp",
+		"srcPort":     float64(34567),
+		"dstPort":     float64(8080),
+		"event": map[string]any{
+			"type":    model.ACLEventType,
+			"message": "Dropped by network policy deny-all in namespace default, direction Ingress",
+			"details": map[string]any{
+				"action":    "drop",
+				"actor":     "NetworkPolicy",
+				"name":      "deny-all",
+				"namespace": "default",
+				"direction": "Ingress",
+			},
+		},
+		"packetEvents": []any{
+			map[string]any{
+				"type":    model.LoadBalancerEventType,
+				"message": "Load balanced by service default/web from VIP 172.30.0.10:80 to backend 10.128.1.6:8080 (tcp)",
+				"inferred": true,
+				"details": map[string]any{
+					"service":  "default/web",
+					"vip":      "172.30.0.10:80",
+					"backend":  "10.128.1.6:8080",
+					"protocol": "tcp",
+				},
+			},
+		},
+	}, decoded)
+	assert.JSONEq(t, `{"time":"2024-10-01T12:00:01Z","errors":["decoding failed: find sample failed"]}`, lines[1])
+}
+
+func TestSampleMetrics(t *testing.T) {
+	metrics := newSampleMetrics()
+	sample := newTestSample()
+	require.NoError(t, metrics.Export(sample))
+	require.NoError(t, metrics.Export(sample))
+	allowed := newTestSample()
+	allowed.Event = &model.ACLEvent{Action: "allow-related", Actor: "AdminNetworkPolicy", Name: "allow-dns", Direction: "Egress"}
+	require.NoError(t, metrics.Export(allowed))
+	require.NoError(t, metrics.Export(&Sample{DecodeError: errors.New("find sample failed")}))
+
+	families, err := metrics.registry.Gather()
+	require.NoError(t, err)
+	values := map[string]float64{}
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			labels := []string{}
+			for _, label := range metric.GetLabel() {
+				labels = append(labels, label.GetName()+"="+label.GetValue())
+			}
+			values[family.GetName()+"{"+strings.Join(labels, ",")+"}"] = metric.GetCounter().GetValue()
+		}
+	}
+	assert.Equal(t, map[string]float64{
+		"ovnkube_observ_acl_samples_total{action=drop,actor=NetworkPolicy,direction=Ingress,name=deny-all,namespace=default}":             2,
+		"ovnkube_observ_acl_samples_total{action=allow-related,actor=AdminNetworkPolicy,direction=Egress,name=allow-dns,namespace=}":      1,
+		"ovnkube_observ_acl_drops_total{actor=NetworkPolicy,direction=Ingress,name=deny-all,namespace=default}":                           2,
+		"ovnkube_observ_decode_errors_total{}":                                                                                           1,
+	}, values)
+}
@@ -0,0 +1,371 @@
+package observability_lib
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+)
+
+// IPFIX (RFC 7011) constants used by the exporter.
+const (
+	ipfixVersion         = 10
+	ipfixTemplateSetID   = 2
+	ipfixIPv4TemplateID  = 256
+	ipfixIPv6TemplateID  = 257
+	ipfixVariableLength  = 0xffff
+	ipfixMaxMessageSize  = 1400
+	ipfixMessageHdrSize  = 16
+	ipfixSetHdrSize      = 4
+	ipfixTemplateRefresh = 10 * time.Minute
+
+	// Information elements, see https://www.iana.org/assignments/ipfix/ipfix.xhtml
+	ieProtocolIdentifier       = 4
+	ieSourceTransportPort      = 7
+	ieSourceIPv4Address        = 8
+	ieDestinationTransportPort = 11
+	ieDestinationIPv4Address   = 12
+	ieSourceIPv6Address        = 27
+	ieDestinationIPv6Address   = 28
+	iePacketDeltaCount         = 2
+	ieApplicationDescription   = 94
+	ieObservationPointID       = 138
+	ieObservationDomainID      = 149
+	ieFlowStartMilliseconds    = 152
+	ieFlowEndMilliseconds      = 153
+	ieFirewallEvent            = 233
+)
+
+// firewallEvent values: the ACL verdict of a flow.
+const (
+	firewallEventIgnored uint8 = 0
+	firewallEventCreated uint8 = 1
+	firewallEventDenied  uint8 = 3
+)
+
+type ipfixField struct {
+	id     uint16
+	length uint16
+}
+
+var ipfixTemplates = map[uint16][]ipfixField{
+	ipfixIPv4TemplateID: ipfixTemplate(ieSourceIPv4Address, ieDestinationIPv4Address, net.IPv4len),
+	ipfixIPv6TemplateID: ipfixTemplate(ieSourceIPv6Address, ieDestinationIPv6Address, net.IPv6len),
+}
+
+// ipfixTemplate returns the fields of a flow record, in the order they are encoded by encodeDataSet.
+func ipfixTemplate(srcIPField, dstIPField, ipLen uint16) []ipfixField {
+	return []ipfixField{
+		{ieFlowStartMilliseconds, 8},
+		{ieFlowEndMilliseconds, 8},
+		{srcIPField, ipLen},
+		{dstIPField, ipLen},
+		{ieSourceTransportPort, 2},
+		{ieDestinationTransportPort, 2},
+		{ieProtocolIdentifier, 1},
+		{iePacketDeltaCount, 8},
+		{ieObservationDomainID, 4},
+		{ieObservationPointID, 4},
+		{ieFirewallEvent, 1},
+		{ieApplicationDescription, ipfixVariableLength},
+	}
+}
+
+// flowKey identifies the samples aggregated in the same flow record.
+type flowKey struct {
+	srcIP, dstIP     string
+	srcPort, dstPort uint16
+	protocol         uint8
+	obsDomainID      uint32
+	obsPointID       uint32
+}
+
+type flowRecord struct {
+	srcIP, dstIP  net.IP
+	key           flowKey
+	start, end    time.Time
+	packets       uint64
+	firewallEvent uint8
+	message       string
+}
+
+// ipfixExporter sends the samples as IPFIX flow records over UDP. Samples of the same flow and OVN cookie are
+// aggregated in the same record for up to CacheActiveTimeout seconds, and all the records are sent when
+// CacheMaxFlows flows are cached, same as OVS does for its own IPFIX export. Caching is disabled when either
+// value is 0, every sample is then sent as its own record.
+type ipfixExporter struct {
+	conns         []net.Conn
+	activeTimeout time.Duration
+	maxFlows      int
+
+	lock sync.Mutex
+	// flows are the cached flow records, and order their keys in the order they were created.
+	flows         map[flowKey]*flowRecord
+	order         []flowKey
+	sequence      uint32
+	templatesSent time.Time
+
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+func newIPFIXExporter(targets []config.HostPort, ipfixConfig *config.IPFIXConfig) (*ipfixExporter, error) {
+	e := &ipfixExporter{
+		activeTimeout: time.Duration(ipfixConfig.CacheActiveTimeout) * time.Second,
+		maxFlows:      int(ipfixConfig.CacheMaxFlows),
+		flows:         map[flowKey]*flowRecord{},
+		stopChan:      make(chan struct{}),
+	}
+	for _, target := range targets {
+		host := "127.0.0.1"
+		if target.Host != nil {
+			host = target.Host.String()
+		}
+		conn, err := net.Dial("udp", net.JoinHostPort(host, fmt.Sprintf("%d", target.Port)))
+		if err != nil {
+			e.closeConns()
+			return nil, fmt.Errorf("failed to connect to IPFIX collector %s: %w", target.String(), err)
+		}
+		e.conns = append(e.conns, conn)
+	}
+	if e.cachingEnabled() {
+		e.wg.Add(1)
+		go e.expireFlows()
+	}
+	return e, nil
+}
+
+func (e *ipfixExporter) cachingEnabled() bool {
+	return e.activeTimeout > 0 && e.maxFlows > 0
+}
+
+func (e *ipfixExporter) Export(sample *Sample) error {
+	if sample.SrcIP == nil {
+		// only IP flows are exported
+		return nil
+	}
+	key := flowKey{
+		srcIP:       string(sample.SrcIP.To16()),
+		dstIP:       string(sample.DstIP.To16()),
+		srcPort:     sample.SrcPort,
+		dstPort:     sample.DstPort,
+		protocol:    sample.Protocol,
+		obsDomainID: sample.ObsDomainID,
+		obsPointID:  sample.ObsPointID,
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if record, ok := e.flows[key]; ok {
+		record.packets++
+		record.end = sample.Time
+		return nil
+	}
+	record := &flowRecord{
+		srcIP:         sample.SrcIP,
+		dstIP:         sample.DstIP,
+		key:           key,
+		start:         sample.Time,
+		end:           sample.Time,
+		packets:       1,
+		firewallEvent: firewallEventIgnored,
+	}
+	if sample.Event != nil {
+		record.message = sample.Event.String()
+	}
+	if event := sample.aclEvent(); event != nil {
+		if event.IsDrop() {
+			record.firewallEvent = firewallEventDenied
+		} else {
+			record.firewallEvent = firewallEventCreated
+		}
+	}
+	if !e.cachingEnabled() {
+		return e.send([]*flowRecord{record})
+	}
+	e.flows[key] = record
+	e.order = append(e.order, key)
+	if len(e.order) >= e.maxFlows {
+		return e.flush(func(*flowRecord) bool { return true })
+	}
+	return nil
+}
+
+// expireFlows sends the flow records that were cached for longer than the active timeout.
+func (e *ipfixExporter) expireFlows() {
+	defer e.wg.Done()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.stopChan:
+			return
+		case now := <-ticker.C:
+			e.lock.Lock()
+			// errors are reported by the next sample export or by Close
+			_ = e.flush(func(record *flowRecord) bool { return now.Sub(record.start) >= e.activeTimeout })
+			e.lock.Unlock()
+		}
+	}
+}
+
+// flush sends and removes the cached flow records selected by expired. Must be called with the lock held.
+func (e *ipfixExporter) flush(expired func(*flowRecord) bool) error {
+	var records []*flowRecord
+	order := e.order[:0]
+	for _, key := range e.order {
+		record := e.flows[key]
+		if expired(record) {
+			records = append(records, record)
+			delete(e.flows, key)
+		} else {
+			order = append(order, key)
+		}
+	}
+	e.order = order
+	if len(records) == 0 {
+		return nil
+	}
+	return e.send(records)
+}
+
+// send encodes the records in as many IPFIX messages as needed and sends them to every collector.
+// Must be called with the lock held.
+func (e *ipfixExporter) send(records []*flowRecord) error {
+	now := time.Now()
+	var sets [][]byte
+	if e.templatesSent.IsZero() || now.Sub(e.templatesSent) >= ipfixTemplateRefresh {
+		sets = append(sets, encodeTemplateSet())
+		e.templatesSent = now
+	}
+	var messages [][]byte
+	size := ipfixMessageHdrSize
+	var dataRecords uint32
+	addMessage := func() {
+		if len(sets) > 0 {
+			messages = append(messages, encodeMessage(now, e.sequence, sets))
+			e.sequence += dataRecords
+		}
+		sets, size, dataRecords = nil, ipfixMessageHdrSize, 0
+	}
+	for _, set := range sets {
+		size += len(set)
+	}
+	for _, record := range records {
+		set := encodeDataSet(record)
+		if size+len(set) > ipfixMaxMessageSize {
+			addMessage()
+		}
+		sets = append(sets, set)
+		size += len(set)
+		dataRecords++
+	}
+	addMessage()
+
+	var errs []error
+	for _, conn := range e.conns {
+		for _, message := range messages {
+			if _, err := conn.Write(message); err != nil {
+				errs = append(errs, fmt.Errorf("failed to send IPFIX message to %s: %w", conn.RemoteAddr(), err))
+				break
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%v", errs)
+	}
+	return nil
+}
+
+func (e *ipfixExporter) Close() error {
+	close(e.stopChan)
+	e.wg.Wait()
+	e.lock.Lock()
+	err := e.flush(func(*flowRecord) bool { return true })
+	e.lock.Unlock()
+	e.closeConns()
+	return err
+}
+
+func (e *ipfixExporter) closeConns() {
+	for _, conn := range e.conns {
+		_ = conn.Close()
+	}
+}
+
+func encodeMessage(exportTime time.Time, sequence uint32, sets [][]byte) []byte {
+	length := ipfixMessageHdrSize
+	for _, set := range sets {
+		length += len(set)
+	}
+	buf := bytes.NewBuffer(make([]byte, 0, length))
+	_ = binary.Write(buf, binary.BigEndian, uint16(ipfixVersion))
+	_ = binary.Write(buf, binary.BigEndian, uint16(length))
+	_ = binary.Write(buf, binary.BigEndian, uint32(exportTime.Unix()))
+	_ = binary.Write(buf, binary.BigEndian, sequence)
+	// the OVN observation domain is sent in every record, the message one is not used
+	_ = binary.Write(buf, binary.BigEndian, uint32(0))
+	for _, set := range sets {
+		buf.Write(set)
+	}
+	return buf.Bytes()
+}
+
+func encodeSet(setID uint16, content []byte) []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, ipfixSetHdrSize+len(content)))
+	_ = binary.Write(buf, binary.BigEndian, setID)
+	_ = binary.Write(buf, binary.BigEndian, uint16(ipfixSetHdrSize+len(content)))
+	buf.Write(content)
+	return buf.Bytes()
+}
+
+func encodeTemplateSet() []byte {
+	buf := &bytes.Buffer{}
+	for _, templateID := range []uint16{ipfixIPv4TemplateID, ipfixIPv6TemplateID} {
+		fields := ipfixTemplates[templateID]
+		_ = binary.Write(buf, binary.BigEndian, templateID)
+		_ = binary.Write(buf, binary.BigEndian, uint16(len(fields)))
+		for _, field := range fields {
+			_ = binary.Write(buf, binary.BigEndian, field.id)
+			_ = binary.Write(buf, binary.BigEndian, field.length)
+		}
+	}
+	return encodeSet(ipfixTemplateSetID, buf.Bytes())
+}
+
+// encodeDataSet returns a data set with a single record, using the IPv4 or IPv6 template.
+func encodeDataSet(record *flowRecord) []byte {
+	templateID := uint16(ipfixIPv6TemplateID)
+	srcIP, dstIP := record.srcIP.To16(), record.dstIP.To16()
+	if src4, dst4 := record.srcIP.To4(), record.dstIP.To4(); src4 != nil && dst4 != nil {
+		templateID = ipfixIPv4TemplateID
+		srcIP, dstIP = src4, dst4
+	}
+	buf := &bytes.Buffer{}
+	_ = binary.Write(buf, binary.BigEndian, uint64(record.start.UnixMilli()))
+	_ = binary.Write(buf, binary.BigEndian, uint64(record.end.UnixMilli()))
+	buf.Write(srcIP)
+	buf.Write(dstIP)
+	_ = binary.Write(buf, binary.BigEndian, record.key.srcPort)
+	_ = binary.Write(buf, binary.BigEndian, record.key.dstPort)
+	buf.WriteByte(record.key.protocol)
+	_ = binary.Write(buf, binary.BigEndian, record.packets)
+	_ = binary.Write(buf, binary.BigEndian, record.key.obsDomainID)
+	_ = binary.Write(buf, binary.BigEndian, record.key.obsPointID)
+	buf.WriteByte(record.firewallEvent)
+	message := record.message
+	if len(message) > ipfixMaxMessageSize/2 {
+		message = message[:ipfixMaxMessageSize/2]
+	}
+	if len(message) < 255 {
+		buf.WriteByte(uint8(len(message)))
+	} else {
+		buf.WriteByte(255)
+		_ = binary.Write(buf, binary.BigEndian, uint16(len(message)))
+	}
+	buf.WriteString(message)
+	return encodeSet(templateID, buf.Bytes())
+}
@@ -0,0 +1,144 @@
+package observability_lib
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+)
+
+// ipfixTestRecord is a data record decoded from an IPFIX message.
+type ipfixTestRecord struct {
+	templateID    uint16
+	srcIP, dstIP  net.IP
+	srcPort       uint16
+	dstPort       uint16
+	protocol      uint8
+	packets       uint64
+	obsDomainID   uint32
+	obsPointID    uint32
+	firewallEvent uint8
+	message       string
+}
+
+func newTestCollector(t *testing.T) (*net.UDPConn, []config.HostPort) {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.ParseIP("127.0.0.1")})
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	// a nil host is the local host
+	return conn, []config.HostPort{{Port: int32(conn.LocalAddr().(*net.UDPAddr).Port)}}
+}
+
+// receiveIPFIX reads an IPFIX message and returns whether it has a template set, and its data records.
+func receiveIPFIX(t *testing.T, conn *net.UDPConn) (bool, []ipfixTestRecord) {
+	buf := make([]byte, 65535)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, err := conn.Read(buf)
+	require.NoError(t, err)
+	msg := buf[:n]
+	require.Equal(t, uint16(ipfixVersion), binary.BigEndian.Uint16(msg[0:2]))
+	require.Equal(t, uint16(n), binary.BigEndian.Uint16(msg[2:4]))
+
+	var hasTemplates bool
+	var records []ipfixTestRecord
+	for offset := ipfixMessageHdrSize; offset < n; {
+		setID := binary.BigEndian.Uint16(msg[offset:])
+		setLen := int(binary.BigEndian.Uint16(msg[offset+2:]))
+		set := msg[offset+ipfixSetHdrSize : offset+setLen]
+		offset += setLen
+		if setID == ipfixTemplateSetID {
+			hasTemplates = true
+			continue
+		}
+		ipLen := net.IPv4len
+		if setID == ipfixIPv6TemplateID {
+			ipLen = net.IPv6len
+		}
+		record := ipfixTestRecord{templateID: setID}
+		pos := 16
+		record.srcIP = net.IP(set[pos : pos+ipLen])
+		record.dstIP = net.IP(set[pos+ipLen : pos+2*ipLen])
+		pos += 2 * ipLen
+		record.srcPort = binary.BigEndian.Uint16(set[pos:])
+		record.dstPort = binary.BigEndian.Uint16(set[pos+2:])
+		record.protocol = set[pos+4]
+		record.packets = binary.BigEndian.Uint64(set[pos+5:])
+		record.obsDomainID = binary.BigEndian.Uint32(set[pos+13:])
+		record.obsPointID = binary.BigEndian.Uint32(set[pos+17:])
+		record.firewallEvent = set[pos+21]
+		msgLen := int(set[pos+22])
+		record.message = string(set[pos+23 : pos+23+msgLen])
+		records = append(records, record)
+	}
+	return hasTemplates, records
+}
+
+func TestIPFIXExporterWithoutCache(t *testing.T) {
+	conn, targets := newTestCollector(t)
+	exporter, err := newIPFIXExporter(targets, &config.IPFIXConfig{CacheActiveTimeout: 0, CacheMaxFlows: 60})
+	require.NoError(t, err)
+	defer exporter.Close()
+
+	require.NoError(t, exporter.Export(newTestSample()))
+	hasTemplates, records := receiveIPFIX(t, conn)
+	assert.True(t, hasTemplates)
+	assert.Equal(t, []ipfixTestRecord{{
+		templateID:    ipfixIPv4TemplateID,
+		srcIP:         net.ParseIP("10.128.0.5").To4(),
+		dstIP:         net.ParseIP("10.128.1.6").To4(),
+		srcPort:       34567,
+		dstPort:       8080,
+		protocol:      6,
+		packets:       1,
+		obsDomainID:   33554433,
+		obsPointID:    7,
+		firewallEvent: firewallEventDenied,
+		message:       "Dropped by network policy deny-all in namespace default, direction Ingress",
+	}}, records)
+
+	// templates are only sent again after the refresh interval
+	ipv6 := newTestSample()
+	ipv6.SrcIP, ipv6.DstIP = net.ParseIP("fd00:10:244::5"), net.ParseIP("fd00:10:244:1::6")
+	ipv6.Event = nil
+	require.NoError(t, exporter.Export(ipv6))
+	hasTemplates, records = receiveIPFIX(t, conn)
+	assert.False(t, hasTemplates)
+	require.Len(t, records, 1)
+	assert.Equal(t, uint16(ipfixIPv6TemplateID), records[0].templateID)
+	assert.Equal(t, net.ParseIP("fd00:10:244::5"), records[0].srcIP)
+	assert.Equal(t, firewallEventIgnored, records[0].firewallEvent)
+	assert.Empty(t, records[0].message)
+}
+
+func TestIPFIXExporterAggregatesFlows(t *testing.T) {
+	conn, targets := newTestCollector(t)
+	exporter, err := newIPFIXExporter(targets, &config.IPFIXConfig{CacheActiveTimeout: 60, CacheMaxFlows: 2})
+	require.NoError(t, err)
+
+	sample := newTestSample()
+	require.NoError(t, exporter.Export(sample))
+	require.NoError(t, exporter.Export(sample))
+	other := newTestSample()
+	other.SrcPort = 34568
+	// the second flow fills the cache, and both records are sent
+	require.NoError(t, exporter.Export(other))
+
+	_, records := receiveIPFIX(t, conn)
+	require.Len(t, records, 2)
+	assert.Equal(t, uint16(34567), records[0].srcPort)
+	assert.Equal(t, uint64(2), records[0].packets)
+	assert.Equal(t, uint16(34568), records[1].srcPort)
+	assert.Equal(t, uint64(1), records[1].packets)
+
+	// the remaining records are sent on close
+	require.NoError(t, exporter.Export(sample))
+	require.NoError(t, exporter.Close())
+	_, records = receiveIPFIX(t, conn)
+	require.Len(t, records, 1)
+	assert.Equal(t, uint64(1), records[0].packets)
+}
@@ -0,0 +1,92 @@
+package observability_lib
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	metricNamespace = "ovnkube"
+	metricSubsystem = "observ"
+)
+
+// sampleMetrics counts the decoded samples, so that the drops of a policy can be alerted on without
+// parsing the samples.
+type sampleMetrics struct {
+	registry     *prometheus.Registry
+	aclSamples   *prometheus.CounterVec
+	aclDrops     *prometheus.CounterVec
+	decodeErrors prometheus.Counter
+}
+
+func newSampleMetrics() *sampleMetrics {
+	m := &sampleMetrics{
+		registry: prometheus.NewRegistry(),
+		aclSamples: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: metricSubsystem,
+			Name:      "acl_samples_total",
+			Help:      "The number of sampled packets per ACL actor, namespace, name, direction and action",
+		}, []string{"actor", "namespace", "name", "direction", "action"}),
+		aclDrops: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: metricSubsystem,
+			Name:      "acl_drops_total",
+			Help:      "The number of sampled packets dropped or rejected per ACL actor, namespace, name and direction",
+		}, []string{"actor", "namespace", "name", "direction"}),
+		decodeErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricNamespace,
+			Subsystem: metricSubsystem,
+			Name:      "decode_errors_total",
+			Help:      "The number of samples that could not be decoded",
+		}),
+	}
+	m.registry.MustRegister(m.aclSamples, m.aclDrops, m.decodeErrors)
+	return m
+}
+
+func (m *sampleMetrics) Export(sample *Sample) error {
+	if sample.DecodeError != nil || sample.PacketEventsError != nil {
+		m.decodeErrors.Inc()
+	}
+	event := sample.aclEvent()
+	if event == nil {
+		return nil
+	}
+	m.aclSamples.WithLabelValues(event.Actor, event.Namespace, event.Name, event.Direction, event.Action).Inc()
+	if event.IsDrop() {
+		m.aclDrops.WithLabelValues(event.Actor, event.Namespace, event.Name, event.Direction).Inc()
+	}
+	return nil
+}
+
+func (m *sampleMetrics) Close() error {
+	return nil
+}
+
+// serve serves the metrics on bindAddress until ctx is done.
+func (m *sampleMetrics) serve(ctx context.Context, bindAddress string, printlnFunc func(a ...any)) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+	server := &http.Server{
+		Addr:              bindAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			printlnFunc("ERROR: metrics server failed:", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"strings"
 )
 
 const (
@@ -18,6 +19,7 @@ const (
 	netpolNodeOwnerType                 = "NetpolNode"
 	netpolNamespaceOwnerType            = "NetpolNamespace"
 	udnIsolationOwnerType               = "UDNIsolation"
+	egressIPOwnerType                   = "EgressIP"
 
 	// nbdb constants: see also github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb
 	aclActionAllow          = "allow"
@@ -32,13 +34,49 @@ type NetworkEvent interface {
 	String() string
 }
 
+// Event types returned by EventType.
+const (
+	ACLEventType          = "ACL"
+	LoadBalancerEventType = "LoadBalancer"
+	NATEventType          = "NAT"
+)
+
+// EventType returns the type of the given event, used to tell the events apart in structured outputs.
+func EventType(e NetworkEvent) string {
+	switch e.(type) {
+	case *ACLEvent:
+		return ACLEventType
+	case *LBEvent:
+		return LoadBalancerEventType
+	case *NATEvent:
+		return NATEventType
+	}
+	return ""
+}
+
+// IsInferred returns true if the event is not sampled by OVN, but inferred from the packet addresses and the
+// nbdb state: these are the load balancing and NAT decisions of a sampled packet, that might not have applied
+// to the packet, e.g. if the nbdb changed since the packet was sampled.
+func IsInferred(e NetworkEvent) bool {
+	switch e.(type) {
+	case *LBEvent, *NATEvent:
+		return true
+	}
+	return false
+}
+
 type ACLEvent struct {
-	NetworkEvent
-	Action    string
-	Actor     string
-	Name      string
-	Namespace string
-	Direction string
+	NetworkEvent `json:"-"`
+	Action       string `json:"action"`
+	Actor        string `json:"actor"`
+	Name         string `json:"name,omitempty"`
+	Namespace    string `json:"namespace,omitempty"`
+	Direction    string `json:"direction,omitempty"`
+}
+
+// IsDrop returns true if the ACL drops or rejects the packets.
+func (e *ACLEvent) IsDrop() bool {
+	return e.Action == aclActionDrop || e.Action == aclActionReject
 }
 
 func (e *ACLEvent) String() string {
@@ -80,3 +118,62 @@ func (e *ACLEvent) String() string {
 	}
 	return fmt.Sprintf("%s by %s", action, msg)
 }
+
+// LBEvent tells that the destination of a sampled packet is a service VIP or one of its backends.
+// Packets sampled before load balancing, e.g. by from-lport ACLs, are sent to the VIP and the backend is not
+// known yet: Backend is empty and Backends lists the backends the VIP can be load balanced to. Packets sampled
+// after load balancing are sent to Backend, and the VIP is the one the packet was likely sent to.
+// It is an inferred event, see IsInferred.
+type LBEvent struct {
+	NetworkEvent `json:"-"`
+	// Service is the namespaced name of the service, formatted as <namespace>/<name>.
+	Service string `json:"service"`
+	// VIP, Backend and Backends are formatted as <ip>:<port>.
+	VIP      string   `json:"vip"`
+	Backend  string   `json:"backend,omitempty"`
+	Backends []string `json:"backends,omitempty"`
+	Protocol string   `json:"protocol"`
+}
+
+func (e *LBEvent) String() string {
+	if e.Backend == "" {
+		return fmt.Sprintf("Sent to VIP %s of service %s, load balanced to one of the backends [%s] (%s)",
+			e.VIP, e.Service, strings.Join(e.Backends, ", "), e.Protocol)
+	}
+	return fmt.Sprintf("Load balanced by service %s from VIP %s to backend %s (%s)", e.Service, e.VIP, e.Backend, e.Protocol)
+}
+
+// NATEvent tells how the source of a sampled packet is translated when the packet leaves the cluster,
+// either by an EgressIP or by the SNAT of the node. It is an inferred event, see IsInferred.
+type NATEvent struct {
+	NetworkEvent `json:"-"`
+	// Actor is EgressIP when the traffic is rerouted to an egress node, and empty for the SNAT of the node.
+	Actor string `json:"actor,omitempty"`
+	// Name is the name of the EgressIP.
+	Name string `json:"name,omitempty"`
+	// Pod is the namespaced name of the pod using the EgressIP, formatted as <namespace>/<name>.
+	Pod       string `json:"pod,omitempty"`
+	LogicalIP string `json:"logicalIP"`
+	// ExternalIP is the translated source IP. It is only known when the SNAT is done in the local zone.
+	ExternalIP string `json:"externalIP,omitempty"`
+	// Router is the router doing the SNAT, or the router rerouting the traffic to the egress node.
+	Router string `json:"router,omitempty"`
+	// NextHops are the next hops the traffic is rerouted to for an EgressIP.
+	NextHops []string `json:"nextHops,omitempty"`
+}
+
+func (e *NATEvent) String() string {
+	switch e.Actor {
+	case egressIPOwnerType:
+		msg := fmt.Sprintf("Egress traffic of pod %s rerouted by egress IP %s", e.Pod, e.Name)
+		if len(e.NextHops) > 0 {
+			msg += fmt.Sprintf(" to %s", strings.Join(e.NextHops, ","))
+		}
+		if e.ExternalIP != "" {
+			msg += fmt.Sprintf(", SNATed to %s on router %s", e.ExternalIP, e.Router)
+		}
+		return msg
+	default:
+		return fmt.Sprintf("Egress traffic from %s SNATed to %s on router %s", e.LogicalIP, e.ExternalIP, e.Router)
+	}
+}
@@ -17,6 +17,7 @@ var mapping = map[string]string{
 	netpolNodeOwnerType:                 libovsdbops.NetpolNodeOwnerType,
 	netpolNamespaceOwnerType:            libovsdbops.NetpolNamespaceOwnerType,
 	udnIsolationOwnerType:               libovsdbops.UDNIsolationOwnerType,
+	egressIPOwnerType:                   libovsdbops.EgressIPOwnerType,
 	aclActionAllow:                      nbdb.ACLActionAllow,
 	aclActionAllowRelated:               nbdb.ACLActionAllowRelated,
 	aclActionAllowStateless:             nbdb.ACLActionAllowStateless,
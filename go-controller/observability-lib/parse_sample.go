@@ -8,9 +8,10 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net"
 	"os"
-	"strings"
 	"syscall"
+	"time"
 	"unsafe"
 
 	"github.com/google/gopacket"
@@ -20,6 +21,7 @@ import (
 	"golang.org/x/sys/unix"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/sampledecoder"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 )
 
 const (
@@ -48,33 +50,62 @@ const (
 )
 
 type SampleReader struct {
-	enableDecoder   bool
-	logCookie       bool
-	printFullPacket bool
-	addOVSCollector bool
-	srcIP, dstIP    string
-	outputFile      string
+	enableDecoder bool
+	// decodePacketEvents enables printing the load balancing and NAT decisions of the sampled packets.
+	decodePacketEvents bool
+	logCookie          bool
+	printFullPacket    bool
+	addOVSCollector    bool
+	srcIP, dstIP       string
+	outputFile         string
+	exportConfig       ExportConfig
+
+	// clusterSubnets are passed to the decoder to tell the packets leaving the cluster.
+	clusterSubnets []*net.IPNet
 
 	decoder   *sampledecoder.SampleDecoder
-	cookieStr []string
+	exporters []sampleExporter
+	metrics   *sampleMetrics
+}
+
+// ExportConfig configures where the samples are exported, in addition to the output file.
+type ExportConfig struct {
+	// OutputFormat is the format of the output file, TextOutputFormat or JSONOutputFormat.
+	OutputFormat string
+	// IPFIXTargets are the IPFIX collectors that receive the samples as flow records. IPFIX export is
+	// disabled when empty.
+	IPFIXTargets []config.HostPort
+	// IPFIX configures the aggregation of the samples into flow records.
+	IPFIX config.IPFIXConfig
+	// MetricsBindAddress is the address serving the prometheus metrics of the decoded samples, e.g. :9410.
+	// Metrics are disabled when empty.
+	MetricsBindAddress string
 }
 
-func NewSampleReader(enableDecoder, logCookie, printFullPacket, addOVSCollector bool, srcIP, dstIP, outputFile string) *SampleReader {
+func NewSampleReader(enableDecoder, decodePacketEvents, logCookie, printFullPacket, addOVSCollector bool, srcIP, dstIP, outputFile string,
+	exportConfig *ExportConfig) *SampleReader {
 	r := &SampleReader{
-		enableDecoder:   enableDecoder,
-		logCookie:       logCookie,
-		printFullPacket: printFullPacket,
-		addOVSCollector: addOVSCollector,
-		srcIP:           srcIP,
-		dstIP:           dstIP,
-		outputFile:      outputFile,
+		enableDecoder:      enableDecoder,
+		decodePacketEvents: decodePacketEvents,
+		logCookie:          logCookie,
+		printFullPacket:    printFullPacket,
+		addOVSCollector:    addOVSCollector,
+		srcIP:              srcIP,
+		dstIP:              dstIP,
+		outputFile:         outputFile,
 	}
-	if logCookie {
-		r.cookieStr = make([]string, 2)
+	if exportConfig != nil {
+		r.exportConfig = *exportConfig
 	}
 	return r
 }
 
+// SetClusterSubnets sets the pod subnets of the cluster networks. The NAT decisions of the sampled packets are
+// only decoded for the packets sent outside of these subnets.
+func (r *SampleReader) SetClusterSubnets(subnets []*net.IPNet) {
+	r.clusterSubnets = subnets
+}
+
 func (r *SampleReader) ReadSamples(ctx context.Context) error {
 	if r.enableDecoder {
 		var err error
@@ -92,6 +123,7 @@ func (r *SampleReader) ReadSamples(ctx context.Context) error {
 				return fmt.Errorf("error creating decoder: %w", err)
 			}
 		}
+		r.decoder.SetClusterSubnets(r.clusterSubnets)
 	}
 	var writer io.Writer
 	if r.outputFile != "" {
@@ -100,14 +132,46 @@ func (r *SampleReader) ReadSamples(ctx context.Context) error {
 			return fmt.Errorf("error creating output file: %w", err)
 		}
 		defer file.Close()
-		writer = bufio.NewWriter(file)
+		bufWriter := bufio.NewWriter(file)
+		defer bufWriter.Flush()
+		writer = bufWriter
 	} else {
 		writer = os.Stdout
 	}
-	l := log.New(writer, "", log.Ldate|log.Ltime|log.Lmicroseconds)
+	// errors are printed with the text samples, and are kept out of the JSON stream
+	errWriter := writer
+	switch r.exportConfig.OutputFormat {
+	case "", TextOutputFormat:
+		r.exporters = append(r.exporters, newTextExporter(writer, r.logCookie, r.decoder != nil, r.printFullPacket))
+	case JSONOutputFormat:
+		r.exporters = append(r.exporters, newJSONExporter(writer, r.printFullPacket))
+		errWriter = os.Stderr
+	default:
+		return fmt.Errorf("unknown output format %q", r.exportConfig.OutputFormat)
+	}
+	l := log.New(errWriter, "", log.Ldate|log.Ltime|log.Lmicroseconds)
 	printlnFunc := func(a ...any) {
 		l.Println(a...)
 	}
+	if len(r.exportConfig.IPFIXTargets) > 0 {
+		ipfix, err := newIPFIXExporter(r.exportConfig.IPFIXTargets, &r.exportConfig.IPFIX)
+		if err != nil {
+			return fmt.Errorf("error creating IPFIX exporter: %w", err)
+		}
+		r.exporters = append(r.exporters, ipfix)
+	}
+	if r.exportConfig.MetricsBindAddress != "" {
+		r.metrics = newSampleMetrics()
+		r.exporters = append(r.exporters, r.metrics)
+		r.metrics.serve(ctx, r.exportConfig.MetricsBindAddress, printlnFunc)
+	}
+	defer func() {
+		for _, exporter := range r.exporters {
+			if err := exporter.Close(); err != nil {
+				printlnFunc("ERROR: closing exporter failed:", err)
+			}
+		}
+	}()
 
 	fam, err := netlink.GenlFamilyGet(PSAMPLE_GENL_NAME)
 	if err != nil {
@@ -125,7 +189,7 @@ func (r *SampleReader) ReadSamples(ctx context.Context) error {
 	if ovsGroupID == 0 {
 		return fmt.Errorf("no mcast group found for %s", PSAMPLE_NL_MCGRP_SAMPLE_NAME)
 	} else {
-		fmt.Printf("Found group %s, id %d\n", PSAMPLE_NL_MCGRP_SAMPLE_NAME, ovsGroupID)
+		fmt.Fprintf(errWriter, "Found group %s, id %d\n", PSAMPLE_NL_MCGRP_SAMPLE_NAME, ovsGroupID)
 	}
 	sock, err := nl.Subscribe(nl.GENL_ID_CTRL, uint(ovsGroupID))
 	if err != nil {
@@ -179,65 +243,75 @@ var hostEndian = getHostEndian()
 
 func (r *SampleReader) parseMsg(msgs []syscall.NetlinkMessage, printlnFunc func(a ...any)) error {
 	for _, msg := range msgs {
-		var packetStr, sampleStr string
-		data := msg.Data[nl.SizeofGenlmsg:]
-		for attr := range nl.ParseAttributes(data) {
-			if r.logCookie && attr.Type == PSAMPLE_ATTR_SAMPLE_GROUP {
-				if uint64(len(attr.Value)) == 4 {
-					g := uint32(0)
-					// group is encoded using host endian
-					err := binary.Read(bytes.NewReader(attr.Value), hostEndian, &g)
-					if err != nil {
-						return err
-					}
-					r.cookieStr[0] = fmt.Sprintf("group_id=%v", g)
-				}
+		sample, err := r.parseSample(msg)
+		if err != nil {
+			return err
+		}
+		if sample == nil {
+			continue
+		}
+		for _, exporter := range r.exporters {
+			if err = exporter.Export(sample); err != nil {
+				printlnFunc("ERROR: export failed:", err)
 			}
-			if attr.Type == PSAMPLE_ATTR_USER_COOKIE && (r.logCookie || r.decoder != nil) {
-				if uint64(len(attr.Value)) == sampledecoder.CookieSize {
-					c := sampledecoder.Cookie{}
-					err := binary.Read(bytes.NewReader(attr.Value), sampledecoder.SampleEndian, &c)
-					if err != nil {
-						return err
-					}
-					if r.logCookie {
-						r.cookieStr[1] = fmt.Sprintf("obs_domain=%v, obs_point=%v",
-							c.ObsDomainID, c.ObsPointID)
-					}
-					if r.decoder != nil {
-						decoded, err := r.decoder.DecodeCookieIDs(c.ObsDomainID, c.ObsPointID)
-						if err != nil {
-							sampleStr = fmt.Sprintf("decoding failed: %v", err)
-						} else {
-							sampleStr = fmt.Sprintf("OVN-K message: %s", decoded.String())
-						}
-					}
+		}
+	}
+	return nil
+}
+
+// parseSample returns the sample of a psample message, or nil if it is filtered out.
+func (r *SampleReader) parseSample(msg syscall.NetlinkMessage) (*Sample, error) {
+	sample := &Sample{Time: time.Now()}
+	data := msg.Data[nl.SizeofGenlmsg:]
+	for attr := range nl.ParseAttributes(data) {
+		switch attr.Type {
+		case PSAMPLE_ATTR_SAMPLE_GROUP:
+			if uint64(len(attr.Value)) == 4 {
+				g := uint32(0)
+				// group is encoded using host endian
+				err := binary.Read(bytes.NewReader(attr.Value), hostEndian, &g)
+				if err != nil {
+					return nil, err
 				}
+				sample.GroupID = &g
 			}
-			if attr.Type == PSAMPLE_ATTR_DATA {
-				packet := gopacket.NewPacket(attr.Value, layers.LayerTypeEthernet, gopacket.Lazy)
-				networkLayer := packet.NetworkLayer().NetworkFlow()
-				if r.printFullPacket {
-					packetStr = packet.String()
-				} else {
-					packetStr = fmt.Sprintf("src=%s, dst=%v\n",
-						networkLayer.Src().String(), networkLayer.Dst().String())
-				}
-				if r.srcIP != "" && r.srcIP != networkLayer.Src().String() {
-					return nil
-				}
-				if r.dstIP != "" && r.dstIP != networkLayer.Dst().String() {
-					return nil
+		case PSAMPLE_ATTR_USER_COOKIE:
+			if uint64(len(attr.Value)) == sampledecoder.CookieSize {
+				c := sampledecoder.Cookie{}
+				err := binary.Read(bytes.NewReader(attr.Value), sampledecoder.SampleEndian, &c)
+				if err != nil {
+					return nil, err
 				}
+				sample.HasCookie = true
+				sample.ObsDomainID, sample.ObsPointID = c.ObsDomainID, c.ObsPointID
 			}
+		case PSAMPLE_ATTR_DATA:
+			sample.setPacket(gopacket.NewPacket(attr.Value, layers.LayerTypeEthernet, gopacket.Lazy))
 		}
-		if r.logCookie {
-			printlnFunc(strings.Join(r.cookieStr, ", "))
+	}
+	if r.srcIP != "" && r.srcIP != sample.SrcIP.String() {
+		return nil, nil
+	}
+	if r.dstIP != "" && r.dstIP != sample.DstIP.String() {
+		return nil, nil
+	}
+	if r.decoder != nil {
+		if sample.HasCookie {
+			event, err := r.decoder.DecodeCookieIDs(sample.ObsDomainID, sample.ObsPointID)
+			if err != nil {
+				sample.DecodeError = err
+			} else {
+				sample.Event = event
+			}
 		}
-		if r.decoder != nil {
-			printlnFunc(sampleStr)
+		if r.decodePacketEvents && sample.SrcIP != nil {
+			sample.PacketEvents, sample.PacketEventsError = r.decoder.DecodePacketEvents(&sampledecoder.PacketInfo{
+				SrcIP:    sample.SrcIP,
+				DstIP:    sample.DstIP,
+				Protocol: sample.ProtocolName,
+				DstPort:  int(sample.DstPort),
+			})
 		}
-		printlnFunc(packetStr)
 	}
-	return nil
+	return sample, nil
 }
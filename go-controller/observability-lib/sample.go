@@ -0,0 +1,65 @@
+package observability_lib
+
+import (
+	"net"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
+)
+
+// Sample is a packet sampled by OVS, with the OVN-Kubernetes events decoded from its cookie and headers.
+type Sample struct {
+	Time time.Time
+	// GroupID is the psample group of the sample, nil if not reported.
+	GroupID *uint32
+	// HasCookie tells if the sample has an OVN cookie, made of ObsDomainID and ObsPointID.
+	HasCookie   bool
+	ObsDomainID uint32
+	ObsPointID  uint32
+
+	SrcIP net.IP
+	DstIP net.IP
+	// Protocol is the IP protocol number, and ProtocolName its name when known, e.g. tcp.
+	Protocol     uint8
+	ProtocolName string
+	SrcPort      uint16
+	DstPort      uint16
+	Packet       gopacket.Packet
+
+	// Event is the event decoded from the cookie, it is nil when decoding is disabled or failed.
+	Event       model.NetworkEvent
+	DecodeError error
+	// PacketEvents are the load balancing and NAT decisions that apply to the packet.
+	PacketEvents      []model.NetworkEvent
+	PacketEventsError error
+}
+
+// setPacket sets the addresses of the sample from the packet headers.
+func (s *Sample) setPacket(packet gopacket.Packet) {
+	s.Packet = packet
+	switch l := packet.NetworkLayer().(type) {
+	case *layers.IPv4:
+		s.SrcIP, s.DstIP, s.Protocol = l.SrcIP, l.DstIP, uint8(l.Protocol)
+	case *layers.IPv6:
+		s.SrcIP, s.DstIP, s.Protocol = l.SrcIP, l.DstIP, uint8(l.NextHeader)
+	default:
+		return
+	}
+	switch l := packet.TransportLayer().(type) {
+	case *layers.TCP:
+		s.ProtocolName, s.SrcPort, s.DstPort = "tcp", uint16(l.SrcPort), uint16(l.DstPort)
+	case *layers.UDP:
+		s.ProtocolName, s.SrcPort, s.DstPort = "udp", uint16(l.SrcPort), uint16(l.DstPort)
+	case *layers.SCTP:
+		s.ProtocolName, s.SrcPort, s.DstPort = "sctp", uint16(l.SrcPort), uint16(l.DstPort)
+	}
+}
+
+// aclEvent returns the decoded ACL event of the sample, or nil.
+func (s *Sample) aclEvent() *model.ACLEvent {
+	event, _ := s.Event.(*model.ACLEvent)
+	return event
+}
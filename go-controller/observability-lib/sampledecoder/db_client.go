@@ -26,11 +26,18 @@ func NewNBClientWithConfig(ctx context.Context, cfg dbConfig) (client.Client, er
 	}
 
 	// define client indexes for ACLs to quickly find them by sample_new or sample_est column.
+	// Router policies and NATs are indexed by match and logical_ip to find the EgressIP and SNAT of sampled packets.
 	dbModel.SetIndexes(map[string][]model.ClientIndex{
 		nbdb.ACLTable: {
 			{Columns: []model.ColumnKey{{Column: "sample_new"}}},
 			{Columns: []model.ColumnKey{{Column: "sample_est"}}},
 		},
+		nbdb.LogicalRouterPolicyTable: {
+			{Columns: []model.ColumnKey{{Column: "match"}}},
+		},
+		nbdb.NATTable: {
+			{Columns: []model.ColumnKey{{Column: "logical_ip"}}},
+		},
 	})
 
 	c, err := newClient(cfg, dbModel)
@@ -42,6 +49,11 @@ func NewNBClientWithConfig(ctx context.Context, cfg dbConfig) (client.Client, er
 		c.NewMonitor(
 			client.WithTable(&nbdb.ACL{}),
 			client.WithTable(&nbdb.Sample{}),
+			// used to find the load balancing and NAT decisions of the sampled packets
+			client.WithTable(&nbdb.LoadBalancer{}),
+			client.WithTable(&nbdb.LogicalRouter{}),
+			client.WithTable(&nbdb.LogicalRouterPolicy{}),
+			client.WithTable(&nbdb.NAT{}),
 		),
 	)
 
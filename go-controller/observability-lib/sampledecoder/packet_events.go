@@ -0,0 +1,262 @@
+package sampledecoder
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	libovsdbmodel "github.com/ovn-org/libovsdb/model"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+)
+
+// PacketInfo is the part of a sampled packet used to find the load balancing and NAT decisions that apply to it.
+type PacketInfo struct {
+	SrcIP net.IP
+	DstIP net.IP
+	// Protocol is tcp, udp or sctp, and empty for the other protocols.
+	Protocol string
+	DstPort  int
+}
+
+// DecodePacketEvents returns the load balancing and NAT decisions that may apply to a sampled packet.
+// OVN only samples ACLs, so these decisions are not sampled themselves: they are inferred on a best-effort
+// basis from the packet addresses and the load balancers, router policies and NATs of the local nbdb, which
+// might have changed since the packet was sampled.
+// Depending on the ACL, the packet is sampled before load balancing, with a VIP as destination, or after it,
+// with a backend as destination. The backend is not known in the first case.
+// NAT decisions are only reported for packets leaving the cluster, that is when the destination is neither a
+// VIP nor in the cluster subnets set with SetClusterSubnets. No NAT decision is reported if the cluster
+// subnets are not set.
+func (d *SampleDecoder) DecodePacketEvents(packet *PacketInfo) ([]model.NetworkEvent, error) {
+	lbEvents, err := d.getLBEvents(packet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find load balancer events: %w", err)
+	}
+	var natEvents []*model.NATEvent
+	if !slices.ContainsFunc(lbEvents, func(e *model.LBEvent) bool { return e.Backend == "" }) {
+		natEvents, err = d.getNATEvents(packet)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find NAT events: %w", err)
+		}
+	}
+	events := make([]model.NetworkEvent, 0, len(lbEvents)+len(natEvents))
+	for _, event := range lbEvents {
+		events = append(events, event)
+	}
+	for _, event := range natEvents {
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// SetClusterSubnets sets the pod subnets of all the networks of the cluster, used to tell if a sampled packet
+// leaves the cluster.
+func (d *SampleDecoder) SetClusterSubnets(subnets []*net.IPNet) {
+	d.clusterSubnets = subnets
+}
+
+func (d *SampleDecoder) isClusterIP(ip net.IP) bool {
+	return slices.ContainsFunc(d.clusterSubnets, func(subnet *net.IPNet) bool {
+		return subnet.Contains(ip)
+	})
+}
+
+// getLBEvents returns an event for every service VIP that is the packet destination, or that has the packet
+// destination as a backend. The same VIP is usually configured in several load balancers, e.g. one per node,
+// it is reported once.
+func (d *SampleDecoder) getLBEvents(packet *PacketInfo) ([]*model.LBEvent, error) {
+	if packet.Protocol == "" || packet.DstIP == nil {
+		return nil, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), OVSDBTimeout)
+	defer cancel()
+	lbs := []*nbdb.LoadBalancer{}
+	if err := d.nbClient.List(ctx, &lbs); err != nil {
+		return nil, err
+	}
+	dst := net.JoinHostPort(packet.DstIP.String(), strconv.Itoa(packet.DstPort))
+	var events []*model.LBEvent
+	for _, lb := range lbs {
+		if lb.ExternalIDs[types.LoadBalancerKindExternalID] != "Service" || getLBProtocol(lb) != packet.Protocol {
+			continue
+		}
+		for vip, backends := range lb.Vips {
+			event := &model.LBEvent{
+				Service:  lb.ExternalIDs[types.LoadBalancerOwnerExternalID],
+				VIP:      vip,
+				Protocol: packet.Protocol,
+			}
+			var vipBackends []string
+			if backends != "" {
+				vipBackends = strings.Split(backends, ",")
+			}
+			switch {
+			case vip == dst:
+				// sampled before load balancing
+				event.Backends = vipBackends
+			case slices.Contains(vipBackends, dst):
+				event.Backend = dst
+			default:
+				continue
+			}
+			i := slices.IndexFunc(events, func(e *model.LBEvent) bool {
+				return e.Service == event.Service && e.VIP == event.VIP && e.Backend == event.Backend
+			})
+			if i == -1 {
+				events = append(events, event)
+				continue
+			}
+			// the backends of a VIP can differ between nodes, e.g. for services with a local traffic policy
+			for _, backend := range event.Backends {
+				if !slices.Contains(events[i].Backends, backend) {
+					events[i].Backends = append(events[i].Backends, backend)
+				}
+			}
+		}
+	}
+	for _, event := range events {
+		slices.Sort(event.Backends)
+	}
+	slices.SortFunc(events, func(a, b *model.LBEvent) int {
+		return strings.Compare(a.Service+a.VIP, b.Service+b.VIP)
+	})
+	return events, nil
+}
+
+func getLBProtocol(lb *nbdb.LoadBalancer) string {
+	if lb.Protocol == nil {
+		// OVN default
+		return nbdb.LoadBalancerProtocolTCP
+	}
+	return *lb.Protocol
+}
+
+// getNATEvents returns the EgressIP event when the packet source is a pod rerouted by an EgressIP, and
+// the node SNAT events otherwise. Only packets leaving the cluster are translated.
+func (d *SampleDecoder) getNATEvents(packet *PacketInfo) ([]*model.NATEvent, error) {
+	if packet.SrcIP == nil || packet.DstIP == nil || len(d.clusterSubnets) == 0 || d.isClusterIP(packet.DstIP) {
+		return nil, nil
+	}
+	routers, err := d.getRouterNames()
+	if err != nil {
+		return nil, err
+	}
+	events, err := d.getEgressIPEvents(packet.SrcIP, routers)
+	if err != nil || len(events) > 0 {
+		return events, err
+	}
+	return d.getSNATEvents(packet.SrcIP, routers)
+}
+
+func (d *SampleDecoder) getEgressIPEvents(srcIP net.IP, routers map[string]string) ([]*model.NATEvent, error) {
+	ipFamily := "ip4"
+	if srcIP.To4() == nil {
+		ipFamily = "ip6"
+	}
+	// uses the client index on the match column
+	policies := []*nbdb.LogicalRouterPolicy{}
+	err := d.nbClient.Where(&nbdb.LogicalRouterPolicy{Match: fmt.Sprintf("%s.src == %s", ipFamily, srcIP.String())}).
+		List(context.Background(), &policies)
+	if err != nil {
+		return nil, err
+	}
+	nats, err := d.findNATsByLogicalIP(srcIP)
+	if err != nil {
+		return nil, err
+	}
+	var events []*model.NATEvent
+	for _, lrp := range policies {
+		if lrp.ExternalIDs[libovsdbops.OwnerTypeKey.String()] != libovsdbops.EgressIPOwnerType ||
+			lrp.Priority != types.EgressIPReroutePriority {
+			continue
+		}
+		objectName := lrp.ExternalIDs[libovsdbops.ObjectNameKey.String()]
+		// the object name of the EgressIP policies is <egressIP name>_<pod namespace>/<pod name>
+		eipName, pod, _ := strings.Cut(objectName, "_")
+		event := &model.NATEvent{
+			Actor:     libovsdbops.EgressIPOwnerType,
+			Name:      eipName,
+			Pod:       pod,
+			LogicalIP: srcIP.String(),
+			NextHops:  lrp.Nexthops,
+			Router:    routers[lrp.UUID],
+		}
+		// the EgressIP SNAT is only found when the egress node is in the local zone
+		for _, nat := range nats {
+			if nat.ExternalIDs[libovsdbops.OwnerTypeKey.String()] == libovsdbops.EgressIPOwnerType &&
+				nat.ExternalIDs[libovsdbops.ObjectNameKey.String()] == objectName {
+				event.ExternalIP = nat.ExternalIP
+				event.Router = routers[nat.UUID]
+				break
+			}
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (d *SampleDecoder) getSNATEvents(srcIP net.IP, routers map[string]string) ([]*model.NATEvent, error) {
+	nats, err := d.findNATsByLogicalIP(srcIP)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*model.NATEvent, 0, len(nats))
+	for _, nat := range nats {
+		if nat.Type != nbdb.NATTypeSNAT || nat.ExternalIDs[libovsdbops.OwnerTypeKey.String()] == libovsdbops.EgressIPOwnerType {
+			continue
+		}
+		events = append(events, &model.NATEvent{
+			LogicalIP:  nat.LogicalIP,
+			ExternalIP: nat.ExternalIP,
+			Router:     routers[nat.UUID],
+		})
+	}
+	slices.SortFunc(events, func(a, b *model.NATEvent) int {
+		return strings.Compare(a.Router+a.LogicalIP, b.Router+b.LogicalIP)
+	})
+	return events, nil
+}
+
+// findNATsByLogicalIP returns the NATs with a logical IP, either an IP or a subnet, that contains ip.
+// It uses the client index on the logical_ip column to look up the IP and every subnet containing it.
+func (d *SampleDecoder) findNATsByLogicalIP(ip net.IP) ([]*nbdb.NAT, error) {
+	bits := 8 * net.IPv6len
+	if ip.To4() != nil {
+		ip = ip.To4()
+		bits = 8 * net.IPv4len
+	}
+	lookups := []libovsdbmodel.Model{&nbdb.NAT{LogicalIP: ip.String()}}
+	for ones := 0; ones <= bits; ones++ {
+		mask := net.CIDRMask(ones, bits)
+		lookups = append(lookups, &nbdb.NAT{LogicalIP: (&net.IPNet{IP: ip.Mask(mask), Mask: mask}).String()})
+	}
+	nats := []*nbdb.NAT{}
+	if err := d.nbClient.Where(lookups...).List(context.Background(), &nats); err != nil {
+		return nil, err
+	}
+	return nats, nil
+}
+
+// getRouterNames returns the names of the routers by the UUID of their NATs and policies.
+func (d *SampleDecoder) getRouterNames() (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), OVSDBTimeout)
+	defer cancel()
+	routers := []*nbdb.LogicalRouter{}
+	if err := d.nbClient.List(ctx, &routers); err != nil {
+		return nil, err
+	}
+	names := map[string]string{}
+	for _, router := range routers {
+		for _, uuid := range slices.Concat(router.Nat, router.Policies) {
+			names[uuid] = router.Name
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,185 @@
+package sampledecoder
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/observability-lib/model"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+)
+
+func TestDecodePacketEvents(t *testing.T) {
+	udp := nbdb.LoadBalancerProtocolUDP
+	serviceIDs := map[string]string{
+		types.LoadBalancerKindExternalID:  "Service",
+		types.LoadBalancerOwnerExternalID: "default/web",
+	}
+	eipNATIDs := map[string]string{
+		libovsdbops.OwnerTypeKey.String():  libovsdbops.EgressIPOwnerType,
+		libovsdbops.ObjectNameKey.String(): "eip1_default/client",
+	}
+	nbData := []libovsdbtest.TestData{
+		// the same VIP on two nodes is reported once
+		&nbdb.LoadBalancer{
+			UUID:        "lb-node1-uuid",
+			Name:        "Service_default/web_TCP_node_switch_node1",
+			Vips:        map[string]string{"10.96.0.10:80": "10.244.1.5:8080,10.244.2.5:8080"},
+			ExternalIDs: serviceIDs,
+		},
+		&nbdb.LoadBalancer{
+			UUID:        "lb-node2-uuid",
+			Name:        "Service_default/web_TCP_node_switch_node2",
+			Vips:        map[string]string{"10.96.0.10:80": "10.244.1.5:8080,10.244.2.5:8080"},
+			ExternalIDs: serviceIDs,
+		},
+		&nbdb.LoadBalancer{
+			UUID:        "lb-udp-uuid",
+			Name:        "Service_default/web_UDP_cluster",
+			Protocol:    &udp,
+			Vips:        map[string]string{"10.96.0.10:80": "10.244.1.5:8080"},
+			ExternalIDs: serviceIDs,
+		},
+		&nbdb.LogicalRouterPolicy{
+			UUID:     "eip-reroute-uuid",
+			Priority: types.EgressIPReroutePriority,
+			Match:    "ip4.src == 10.244.1.3",
+			Action:   nbdb.LogicalRouterPolicyActionReroute,
+			Nexthops: []string{"100.64.0.2"},
+			ExternalIDs: map[string]string{
+				libovsdbops.OwnerTypeKey.String():  libovsdbops.EgressIPOwnerType,
+				libovsdbops.ObjectNameKey.String(): "eip1_default/client",
+				libovsdbops.PriorityKey.String():   "100",
+			},
+		},
+		&nbdb.NAT{
+			UUID:        "eip-nat-uuid",
+			Type:        nbdb.NATTypeSNAT,
+			LogicalIP:   "10.244.1.3",
+			ExternalIP:  "172.18.0.100",
+			ExternalIDs: eipNATIDs,
+		},
+		&nbdb.NAT{
+			UUID:       "node-nat-uuid",
+			Type:       nbdb.NATTypeSNAT,
+			LogicalIP:  "10.244.1.0/24",
+			ExternalIP: "172.18.0.2",
+		},
+		&nbdb.LogicalRouter{
+			UUID:     "cluster-router-uuid",
+			Name:     types.OVNClusterRouter,
+			Policies: []string{"eip-reroute-uuid"},
+		},
+		&nbdb.LogicalRouter{
+			UUID: "gr-uuid",
+			Name: types.GWRouterPrefix + "node1",
+			Nat:  []string{"eip-nat-uuid", "node-nat-uuid"},
+		},
+	}
+	_, testCtx, err := libovsdbtest.NewNBTestHarness(libovsdbtest.TestSetup{NBData: nbData}, nil)
+	require.NoError(t, err)
+	t.Cleanup(testCtx.Cleanup)
+	// use the decoder client to get its indexes
+	nbClient, err := NewNBClientWithConfig(context.Background(), dbConfig{address: testCtx.NBServer.Address, scheme: "unix"})
+	require.NoError(t, err)
+	t.Cleanup(nbClient.Close)
+	decoder := &SampleDecoder{nbClient: nbClient}
+	_, clusterSubnet, err := net.ParseCIDR("10.244.0.0/16")
+	require.NoError(t, err)
+	decoder.SetClusterSubnets([]*net.IPNet{clusterSubnet})
+
+	tests := []struct {
+		name     string
+		packet   *PacketInfo
+		expected []model.NetworkEvent
+	}{
+		{
+			name: "load balanced packet to a pod is not NATed",
+			packet: &PacketInfo{
+				SrcIP:    net.ParseIP("10.244.1.3"),
+				DstIP:    net.ParseIP("10.244.2.5"),
+				Protocol: "tcp",
+				DstPort:  8080,
+			},
+			expected: []model.NetworkEvent{
+				&model.LBEvent{Service: "default/web", VIP: "10.96.0.10:80", Backend: "10.244.2.5:8080", Protocol: "tcp"},
+			},
+		},
+		{
+			name: "packet sampled before load balancing is sent to the VIP and not NATed",
+			packet: &PacketInfo{
+				SrcIP:    net.ParseIP("10.244.1.3"),
+				DstIP:    net.ParseIP("10.96.0.10"),
+				Protocol: "tcp",
+				DstPort:  80,
+			},
+			expected: []model.NetworkEvent{
+				&model.LBEvent{Service: "default/web", VIP: "10.96.0.10:80", Backends: []string{"10.244.1.5:8080", "10.244.2.5:8080"}, Protocol: "tcp"},
+			},
+		},
+		{
+			name: "packet from a pod using an EgressIP",
+			packet: &PacketInfo{
+				SrcIP:    net.ParseIP("10.244.1.3"),
+				DstIP:    net.ParseIP("172.18.0.50"),
+				Protocol: "tcp",
+				DstPort:  443,
+			},
+			expected: []model.NetworkEvent{
+				&model.NATEvent{Actor: libovsdbops.EgressIPOwnerType, Name: "eip1", Pod: "default/client", LogicalIP: "10.244.1.3",
+					ExternalIP: "172.18.0.100", Router: types.GWRouterPrefix + "node1", NextHops: []string{"100.64.0.2"}},
+			},
+		},
+		{
+			name: "packet from a pod using the node SNAT",
+			packet: &PacketInfo{
+				SrcIP:    net.ParseIP("10.244.1.4"),
+				DstIP:    net.ParseIP("8.8.8.8"),
+				Protocol: "udp",
+				DstPort:  53,
+			},
+			expected: []model.NetworkEvent{
+				&model.NATEvent{LogicalIP: "10.244.1.0/24", ExternalIP: "172.18.0.2", Router: types.GWRouterPrefix + "node1"},
+			},
+		},
+		{
+			name: "packet not matching any backend port",
+			packet: &PacketInfo{
+				SrcIP:    net.ParseIP("10.245.0.4"),
+				DstIP:    net.ParseIP("10.244.2.5"),
+				Protocol: "udp",
+				DstPort:  8080,
+			},
+			expected: []model.NetworkEvent{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := decoder.DecodePacketEvents(tt.packet)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, events)
+		})
+	}
+
+	// without the cluster subnets, packets leaving the cluster can't be told apart and NAT is not reported
+	decoder.SetClusterSubnets(nil)
+	events, err := decoder.DecodePacketEvents(&PacketInfo{SrcIP: net.ParseIP("10.244.1.4"), DstIP: net.ParseIP("8.8.8.8"), Protocol: "udp", DstPort: 53})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	assert.Equal(t, "Load balanced by service default/web from VIP 10.96.0.10:80 to backend 10.244.2.5:8080 (tcp)",
+		(&model.LBEvent{Service: "default/web", VIP: "10.96.0.10:80", Backend: "10.244.2.5:8080", Protocol: "tcp"}).String())
+	assert.Equal(t, "Sent to VIP 10.96.0.10:80 of service default/web, load balanced to one of the backends [10.244.1.5:8080, 10.244.2.5:8080] (tcp)",
+		(&model.LBEvent{Service: "default/web", VIP: "10.96.0.10:80", Backends: []string{"10.244.1.5:8080", "10.244.2.5:8080"}, Protocol: "tcp"}).String())
+	assert.Equal(t, "Egress traffic of pod default/client rerouted by egress IP eip1 to 100.64.0.2, SNATed to 172.18.0.100 on router GR_node1",
+		(&model.NATEvent{Actor: libovsdbops.EgressIPOwnerType, Name: "eip1", Pod: "default/client", ExternalIP: "172.18.0.100",
+			Router: "GR_node1", NextHops: []string{"100.64.0.2"}}).String())
+	assert.Equal(t, "Egress traffic from 10.244.1.0/24 SNATed to 172.18.0.2 on router GR_node1",
+		(&model.NATEvent{LogicalIP: "10.244.1.0/24", ExternalIP: "172.18.0.2", Router: "GR_node1"}).String())
+}
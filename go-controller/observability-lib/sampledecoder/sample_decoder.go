@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"net"
 	"strings"
 
 	"github.com/ovn-org/libovsdb/client"
@@ -21,6 +22,8 @@ type SampleDecoder struct {
 	nbClient          client.Client
 	ovsdbClient       client.Client
 	cleanupCollectors []int
+	// clusterSubnets are used to tell if a sampled packet leaves the cluster
+	clusterSubnets []*net.IPNet
 }
 
 type dbConfig struct {
@@ -158,7 +161,7 @@ func (d *SampleDecoder) DecodeCookieIDs(obsDomainID, obsPointID uint32) (model.N
 	var event model.NetworkEvent
 	switch o := dbObj.(type) {
 	case *nbdb.ACL:
-		event, err = newACLEvent(o)
+		event, err = NewACLEvent(o)
 		if err != nil {
 			return nil, fmt.Errorf("failed to build ACL network event: %w", err)
 		}
@@ -169,7 +172,8 @@ func (d *SampleDecoder) DecodeCookieIDs(obsDomainID, obsPointID uint32) (model.N
 	return event, nil
 }
 
-func newACLEvent(o *nbdb.ACL) (*model.ACLEvent, error) {
+// NewACLEvent builds the network event of an ACL from its action and the owner information in its external IDs.
+func NewACLEvent(o *nbdb.ACL) (*model.ACLEvent, error) {
 	actor := o.ExternalIDs[libovsdbops.OwnerTypeKey.String()]
 	event := model.ACLEvent{
 		Action: o.Action,
@@ -12,7 +12,7 @@ import (
 )
 
 func TestCreateOrUpdateACL(t *testing.T) {
-	event, err := newACLEvent(&nbdb.ACL{
+	event, err := NewACLEvent(&nbdb.ACL{
 		Action: nbdb.ACLActionAllow,
 		ExternalIDs: map[string]string{
 			libovsdbops.OwnerTypeKey.String():       libovsdbops.NetworkPolicyOwnerType,
@@ -23,7 +23,7 @@ func TestCreateOrUpdateACL(t *testing.T) {
 	require.ErrorContains(t, err, "expected format namespace:name for Object Name, but found: foo")
 	assert.Nil(t, event)
 
-	event, err = newACLEvent(&nbdb.ACL{
+	event, err = NewACLEvent(&nbdb.ACL{
 		Action: nbdb.ACLActionAllow,
 		ExternalIDs: map[string]string{
 			libovsdbops.OwnerTypeKey.String():       libovsdbops.NetworkPolicyOwnerType,
@@ -34,7 +34,7 @@ func TestCreateOrUpdateACL(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "Allowed by network policy foo in namespace bar, direction Ingress", event.String())
 
-	event, err = newACLEvent(&nbdb.ACL{
+	event, err = NewACLEvent(&nbdb.ACL{
 		Action: nbdb.ACLActionAllow,
 		ExternalIDs: map[string]string{
 			libovsdbops.OwnerTypeKey.String():       libovsdbops.AdminNetworkPolicyOwnerType,
@@ -45,7 +45,7 @@ func TestCreateOrUpdateACL(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "Allowed by admin network policy foo, direction Ingress", event.String())
 
-	event, err = newACLEvent(&nbdb.ACL{
+	event, err = NewACLEvent(&nbdb.ACL{
 		Action: nbdb.ACLActionAllow,
 		ExternalIDs: map[string]string{
 			libovsdbops.OwnerTypeKey.String():  libovsdbops.EgressFirewallOwnerType,
@@ -56,7 +56,7 @@ func TestCreateOrUpdateACL(t *testing.T) {
 	assert.Equal(t, "Allowed by egress firewall in namespace foo", event.String())
 	assert.Equal(t, "Egress", event.Direction)
 
-	event, err = newACLEvent(&nbdb.ACL{
+	event, err = NewACLEvent(&nbdb.ACL{
 		Action: nbdb.ACLActionAllow,
 		ExternalIDs: map[string]string{
 			libovsdbops.OwnerTypeKey.String(): libovsdbops.NetpolNodeOwnerType,
@@ -3,13 +3,14 @@ package subnet
 import (
 	"errors"
 	"fmt"
+	"math/big"
 	"net"
 	"reflect"
 	"sync"
 
-	iputils "github.com/containernetworking/plugins/pkg/ip"
-
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
 
 	bitmapallocator "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/bitmap"
 	ipallocator "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip"
@@ -20,6 +21,7 @@ import (
 // identified by a name. Allocator should be threadsafe.
 type Allocator interface {
 	AddOrUpdateSubnet(name string, subnets []*net.IPNet, excludeSubnets ...*net.IPNet) error
+	ReserveSubnets(name string, reservedSubnets ...*net.IPNet) error
 	DeleteSubnet(name string)
 	GetSubnets(name string) ([]*net.IPNet, error)
 	AllocateUntilFull(name string) error
@@ -29,6 +31,7 @@ type Allocator interface {
 	ConditionalIPRelease(name string, ips []*net.IPNet, predicate func() (bool, error)) (bool, error)
 	ForSubnet(name string) NamedAllocator
 	GetSubnetName(subnets []*net.IPNet) (string, bool)
+	GetSubnetsUsage(name string) ([]SubnetUsage, error)
 }
 
 // NamedAllocator manages the allocation of IPs within a specific subnet
@@ -41,12 +44,103 @@ type NamedAllocator interface {
 // ErrSubnetNotFound is used to inform the subnet is not being managed
 var ErrSubnetNotFound = errors.New("subnet not found")
 
+// SubnetUsage reports how many of the IPs of a subnet that can be allocated
+// dynamically are in use. Excluded and reserved IPs are not accounted for.
+type SubnetUsage struct {
+	Subnet *net.IPNet
+	Total  uint64
+	Used   uint64
+}
+
 // subnetInfo contains information corresponding to the subnet. It holds the
 // allocations (v4 and v6) as well as the IPAM allocator instances for each
 // of the managed subnets
 type subnetInfo struct {
+	subnets  []*net.IPNet
+	ipams    []ipallocator.Interface
+	reserved *reservedIPs
+	// preallocated holds, for each of the IPAM instances, the number of IPs
+	// allocated upfront because they are excluded or reserved
+	preallocated []int
+}
+
+// reservedIPs keeps track of the IPs of the reserved subnets that are in use.
+// Reserved IPs are allocated in the IPAM instances upfront so that they are
+// never handed out by AllocateNextIPs, but they can still be allocated
+// explicitly, one owner at a time.
+type reservedIPs struct {
+	sync.Mutex
 	subnets []*net.IPNet
-	ipams   []ipallocator.Interface
+	inUse   sets.Set[string]
+}
+
+func newReservedIPs() *reservedIPs {
+	return &reservedIPs{inUse: sets.New[string]()}
+}
+
+func (r *reservedIPs) contains(ip net.IP) bool {
+	for _, subnet := range r.subnets {
+		if subnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *reservedIPs) allocate(ip net.IP) error {
+	r.Lock()
+	defer r.Unlock()
+	if r.inUse.Has(ip.String()) {
+		return ipallocator.ErrAllocated
+	}
+	r.inUse.Insert(ip.String())
+	return nil
+}
+
+func (r *reservedIPs) release(ip net.IP) {
+	r.Lock()
+	defer r.Unlock()
+	r.inUse.Delete(ip.String())
+}
+
+func (r *reservedIPs) has(ip net.IP) bool {
+	r.Lock()
+	defer r.Unlock()
+	return r.inUse.Has(ip.String())
+}
+
+// isReserved returns whether the IP belongs to the reserved IPs rather than to
+// the given IPAM instance
+func (subnetInfo subnetInfo) isReserved(ipam ipallocator.Interface, ip net.IP) bool {
+	return subnetInfo.reserved.contains(ip) && !ipam.Reserved(ip)
+}
+
+// allocate allocates the IP in the given IPAM instance, or in the reserved
+// IPs if it is a reserved one
+func (subnetInfo subnetInfo) allocate(ipam ipallocator.Interface, ip net.IP) error {
+	if subnetInfo.isReserved(ipam, ip) {
+		return subnetInfo.reserved.allocate(ip)
+	}
+	return ipam.Allocate(ip)
+}
+
+// release releases the IP from the given IPAM instance, or from the reserved
+// IPs if it is a reserved one
+func (subnetInfo subnetInfo) release(ipam ipallocator.Interface, ip net.IP) {
+	if subnetInfo.isReserved(ipam, ip) {
+		subnetInfo.reserved.release(ip)
+		return
+	}
+	ipam.Release(ip)
+}
+
+// has returns whether the IP is allocated in the given IPAM instance, or in
+// the reserved IPs if it is a reserved one
+func (subnetInfo subnetInfo) has(ipam ipallocator.Interface, ip net.IP) bool {
+	if subnetInfo.isReserved(ipam, ip) {
+		return subnetInfo.reserved.has(ip)
+	}
+	return ipam.Has(ip)
 }
 
 type ipamFactoryFunc func(*net.IPNet) (ipallocator.Interface, error)
@@ -94,21 +188,25 @@ func (allocator *allocator) AddOrUpdateSubnet(name string, subnets []*net.IPNet,
 		}
 		ipams = append(ipams, ipam)
 	}
+	preallocated := make([]int, len(ipams))
 	allocator.cache[name] = subnetInfo{
-		subnets: subnets,
-		ipams:   ipams,
+		subnets:      subnets,
+		ipams:        ipams,
+		reserved:     newReservedIPs(),
+		preallocated: preallocated,
 	}
 
 	for _, excludeSubnet := range excludeSubnets {
 		var excluded bool
 		for i, subnet := range subnets {
 			if util.ContainsCIDR(subnet, excludeSubnet) {
-				err := reserveSubnets(excludeSubnet, ipams[i])
+				count, err := reserveSubnets(excludeSubnet, ipams[i])
+				preallocated[i] += count
 				if err != nil {
 					return fmt.Errorf("failed to exclude subnet %s for %s: %w", excludeSubnet, name, err)
 				}
+				excluded = true
 			}
-			excluded = true
 		}
 		if !excluded {
 			return fmt.Errorf("failed to exclude subnet %s for %s: not contained in any of the subnets", excludeSubnet, name)
@@ -117,6 +215,57 @@ func (allocator *allocator) AddOrUpdateSubnet(name string, subnets []*net.IPNet,
 	return nil
 }
 
+// ReserveSubnets reserves the IPs of the provided subnets, which must be
+// contained in the subnets already managed for name. Reserved IPs are not
+// allocated by AllocateNextIPs but can be allocated with AllocateIPPerSubnet.
+// Reserved IPs that were already allocated are considered in use.
+// Only the IPs within the IPAM range are reserved, the IPv6 range being
+// limited to the first 65535 IPs of the subnet.
+func (allocator *allocator) ReserveSubnets(name string, reservedSubnets ...*net.IPNet) error {
+	allocator.Lock()
+	defer allocator.Unlock()
+	subnetInfo, ok := allocator.cache[name]
+	if !ok {
+		return fmt.Errorf("failed to reserve subnets %v for %s: %w", util.StringSlice(reservedSubnets), name, ErrSubnetNotFound)
+	}
+	subnetInfo.reserved.Lock()
+	defer subnetInfo.reserved.Unlock()
+	for _, reservedSubnet := range reservedSubnets {
+		var reserved bool
+		for i, subnet := range subnetInfo.subnets {
+			if !util.ContainsCIDR(subnet, reservedSubnet) {
+				continue
+			}
+			ipam := subnetInfo.ipams[i]
+			err := forEachAllocatableIP(reservedSubnet, ipam, func(ip net.IP) error {
+				if subnetInfo.reserved.contains(ip) {
+					// already reserved by an overlapping subnet
+					return nil
+				}
+				if ipam.Has(ip) {
+					subnetInfo.reserved.inUse.Insert(ip.String())
+					subnetInfo.preallocated[i]++
+					return nil
+				}
+				if err := ipam.Allocate(ip); err != nil {
+					return fmt.Errorf("failed to reserve IP %s for %s: %w", ip, name, err)
+				}
+				subnetInfo.preallocated[i]++
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			reserved = true
+		}
+		if !reserved {
+			return fmt.Errorf("failed to reserve subnet %s for %s: not contained in any of the subnets", reservedSubnet, name)
+		}
+		subnetInfo.reserved.subnets = append(subnetInfo.reserved.subnets, reservedSubnet)
+	}
+	return nil
+}
+
 // DeleteSubnet from the allocator
 func (allocator *allocator) DeleteSubnet(name string) {
 	allocator.Lock()
@@ -142,6 +291,28 @@ func (allocator *allocator) GetSubnets(name string) ([]*net.IPNet, error) {
 	return nil, ErrSubnetNotFound
 }
 
+// GetSubnetsUsage returns the usage of each of the subnets of the given subnet
+// set
+func (allocator *allocator) GetSubnetsUsage(name string) ([]SubnetUsage, error) {
+	allocator.RLock()
+	defer allocator.RUnlock()
+	subnetInfo, ok := allocator.cache[name]
+	if !ok {
+		return nil, fmt.Errorf("failed to get usage of %s: %w", name, ErrSubnetNotFound)
+	}
+	usage := make([]SubnetUsage, 0, len(subnetInfo.ipams))
+	for i, ipam := range subnetInfo.ipams {
+		used := ipam.Used() - subnetInfo.preallocated[i]
+		subnet := *subnetInfo.subnets[i]
+		usage = append(usage, SubnetUsage{
+			Subnet: &subnet,
+			Total:  uint64(used + ipam.Free()),
+			Used:   uint64(used),
+		})
+	}
+	return usage, nil
+}
+
 // AllocateUntilFull used for unit testing only, allocates the rest of the subnet
 func (allocator *allocator) AllocateUntilFull(name string) error {
 	allocator.RLock()
@@ -184,7 +355,7 @@ func (allocator *allocator) AllocateIPPerSubnet(name string, ips []*net.IPNet) e
 			// iterate over range of already allocated indices and release
 			// ips allocated before the error occurred.
 			for relIdx, relIPNet := range allocated {
-				subnetInfo.ipams[relIdx].Release(relIPNet.IP)
+				subnetInfo.release(subnetInfo.ipams[relIdx], relIPNet.IP)
 				if relIPNet.IP != nil {
 					klog.Warningf("Reserved IP %s was released for %s", relIPNet.IP, name)
 				}
@@ -200,7 +371,7 @@ func (allocator *allocator) AllocateIPPerSubnet(name string, ips []*net.IPNet) e
 					err = fmt.Errorf("failed to allocate IP %s for %s: attempted to reserve multiple IPs in the same IPAM instance", ipnet.IP, name)
 					return err
 				}
-				if err = ipam.Allocate(ipnet.IP); err != nil {
+				if err = subnetInfo.allocate(ipam, ipnet.IP); err != nil {
 					return err
 				}
 				allocated[idx] = ipnet
@@ -211,17 +382,53 @@ func (allocator *allocator) AllocateIPPerSubnet(name string, ips []*net.IPNet) e
 	return nil
 }
 
-// reserveSubnets reserves subnet IPs
-func reserveSubnets(subnet *net.IPNet, ipam ipallocator.Interface) error {
+// reserveSubnets reserves subnet IPs, skipping those already reserved, and
+// returns the number of IPs it reserved
+func reserveSubnets(subnet *net.IPNet, ipam ipallocator.Interface) (int, error) {
+	var count int
 	// FIXME: allocate IP ranges when https://github.com/ovn-org/ovn-kubernetes/issues/3369 is fixed
-	for ip := subnet.IP; subnet.Contains(ip); ip = iputils.NextIP(ip) {
-		if ipam.Reserved(ip) {
-			continue
+	err := forEachAllocatableIP(subnet, ipam, func(ip net.IP) error {
+		if ipam.Has(ip) {
+			return nil
 		}
-		err := ipam.Allocate(ip)
-		if err != nil {
+		if err := ipam.Allocate(ip); err != nil {
 			return fmt.Errorf("failed to reserve IP %s: %w", ip, err)
 		}
+		count++
+		return nil
+	})
+	return count, err
+}
+
+// forEachAllocatableIP calls fn for each IP of subnet that the IPAM instance
+// can allocate. The IPs are walked by their offset in the IPAM range rather
+// than over the whole subnet: the IPAM range of IPv6 subnets is capped to
+// 65536 IPs, so big IPv6 subnets are never walked in full.
+func forEachAllocatableIP(subnet *net.IPNet, ipam ipallocator.Interface, fn func(net.IP) error) error {
+	cidr := ipam.CIDR()
+	base := utilnet.BigForIP(cidr.IP)
+	// offsets of the first and last IPs of subnet from the IPAM CIDR IP
+	ones, bits := subnet.Mask.Size()
+	first := new(big.Int).Sub(utilnet.BigForIP(subnet.IP.Mask(subnet.Mask)), base)
+	last := new(big.Int).Add(first, new(big.Int).Lsh(big.NewInt(1), uint(bits-ones)))
+	last.Sub(last, big.NewInt(1))
+	// the IPAM range goes from the offset 1, skipping the CIDR IP, to the
+	// offset of its size, skipping the IPv4 broadcast IP
+	start := first
+	if start.Cmp(big.NewInt(1)) < 0 {
+		start = big.NewInt(1)
+	}
+	end := big.NewInt(int64(ipam.Used() + ipam.Free()))
+	if last.Cmp(end) < 0 {
+		end = last
+	}
+	if start.Cmp(end) > 0 {
+		return nil
+	}
+	for offset := start.Int64(); offset <= end.Int64(); offset++ {
+		if err := fn(utilnet.AddIPOffset(base, int(offset))); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -253,7 +460,7 @@ func (allocator *allocator) AllocateNextIPs(name string) ([]*net.IPNet, error) {
 			// iterate over range of already allocated indices and release
 			// ips allocated before the error occurred.
 			for relIdx, relIPNet := range ipnets {
-				subnetInfo.ipams[relIdx].Release(relIPNet.IP)
+				subnetInfo.release(subnetInfo.ipams[relIdx], relIPNet.IP)
 				if relIPNet.IP != nil {
 					klog.Warningf("Reserved IP %s was released for %s", relIPNet.IP, name)
 				}
@@ -296,7 +503,7 @@ func (allocator *allocator) ReleaseIPs(name string, ips []*net.IPNet) error {
 		for _, ipam := range subnetInfo.ipams {
 			cidr := ipam.CIDR()
 			if cidr.Contains(ipnet.IP) {
-				ipam.Release(ipnet.IP)
+				subnetInfo.release(ipam, ipnet.IP)
 				break
 			}
 		}
@@ -327,7 +534,7 @@ func (allocator *allocator) ConditionalIPRelease(name string, ips []*net.IPNet,
 		for _, ipam := range subnetInfo.ipams {
 			cidr := ipam.CIDR()
 			if cidr.Contains(ipnet.IP) {
-				if ipam.Has(ipnet.IP) {
+				if subnetInfo.has(ipam, ipnet.IP) {
 					return predicate()
 				}
 			}
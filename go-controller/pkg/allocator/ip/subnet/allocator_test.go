@@ -88,6 +88,121 @@ var _ = ginkgo.Describe("Subnet IP allocator operations", func() {
 			}
 		})
 
+		ginkgo.It("fails to exclude subnets not contained in the subnets", func() {
+			subnets := []string{
+				"10.1.1.0/24",
+			}
+			excludes := []string{
+				"10.1.2.0/29",
+			}
+
+			err := allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets(subnets...), ovntest.MustParseIPNets(excludes...)...)
+			gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("not contained in any of the subnets")))
+		})
+
+	})
+
+	ginkgo.Context("when reserving subnets", func() {
+		ginkgo.BeforeEach(func() {
+			err := allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.0/24", "2000::/64"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = allocator.ReserveSubnets(subnetName, ovntest.MustParseIPNets("10.1.1.0/29", "2000::/125")...)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("does not allocate reserved IPs dynamically", func() {
+			ips, err := allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(util.StringSlice(ips)).To(gomega.Equal([]string{"10.1.1.8/24", "2000::8/64"}))
+		})
+
+		ginkgo.It("allocates, releases, and reallocates reserved IPs on request", func() {
+			reservedIPs := ovntest.MustParseIPNets("10.1.1.5/24", "2000::5/64")
+			err := allocator.AllocateIPPerSubnet(subnetName, reservedIPs)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = allocator.AllocateIPPerSubnet(subnetName, reservedIPs)
+			gomega.Expect(err).To(gomega.MatchError(ipam.ErrAllocated))
+
+			released, err := allocator.ConditionalIPRelease(subnetName, reservedIPs, func() (bool, error) { return true, nil })
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(released).To(gomega.BeTrue())
+
+			err = allocator.ReleaseIPs(subnetName, reservedIPs)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			released, err = allocator.ConditionalIPRelease(subnetName, reservedIPs, func() (bool, error) { return true, nil })
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(released).To(gomega.BeFalse())
+
+			err = allocator.AllocateIPPerSubnet(subnetName, reservedIPs)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("keeps reserved IPs out of the dynamic pool after being released", func() {
+			reservedIPs := ovntest.MustParseIPNets("10.1.1.1/24", "2000::1/64")
+			err := allocator.AllocateIPPerSubnet(subnetName, reservedIPs)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = allocator.ReleaseIPs(subnetName, reservedIPs)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			ips, err := allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(util.StringSlice(ips)).To(gomega.Equal([]string{"10.1.1.8/24", "2000::8/64"}))
+		})
+
+		ginkgo.It("considers already allocated IPs as in use", func() {
+			err := allocator.AllocateIPPerSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.20/24"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = allocator.ReserveSubnets(subnetName, ovntest.MustParseIPNets("10.1.1.16/29")...)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			err = allocator.AllocateIPPerSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.20/24"))
+			gomega.Expect(err).To(gomega.MatchError(ipam.ErrAllocated))
+			err = allocator.AllocateIPPerSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.21/24"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("reserves big IPv6 subnets within the IPAM range only", func() {
+			// the IPAM range of the /64 is the first 65535 usable IPs, the /65 is out of it
+			err := allocator.ReserveSubnets(subnetName, ovntest.MustParseIPNets("2000::/72", "2000::8000:0:0:0/65")...)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			usage, err := allocator.GetSubnetsUsage(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(usage[1]).To(gomega.Equal(SubnetUsage{Subnet: ovntest.MustParseIPNet("2000::/64"), Total: 0, Used: 0}))
+		})
+
+		ginkgo.It("fails to reserve subnets not contained in the subnets", func() {
+			err := allocator.ReserveSubnets(subnetName, ovntest.MustParseIPNets("10.1.2.0/29")...)
+			gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring("not contained in any of the subnets")))
+		})
+	})
+
+	ginkgo.Context("when reporting usage", func() {
+		ginkgo.It("accounts for the dynamically allocated IPs only", func() {
+			err := allocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.0/24", "2000::/64"), ovntest.MustParseIPNets("10.1.1.240/28")...)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = allocator.ReserveSubnets(subnetName, ovntest.MustParseIPNets("10.1.1.0/29", "2000::/125")...)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			_, err = allocator.AllocateNextIPs(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = allocator.AllocateIPPerSubnet(subnetName, ovntest.MustParseIPNets("10.1.1.5/24"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			usage, err := allocator.GetSubnetsUsage(subnetName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(usage).To(gomega.Equal([]SubnetUsage{
+				// 254 usable IPs minus 7 reserved and 15 excluded ones
+				{Subnet: ovntest.MustParseIPNet("10.1.1.0/24"), Total: 232, Used: 1},
+				// the IPv6 range is limited to 65535 usable IPs, minus 7 reserved ones
+				{Subnet: ovntest.MustParseIPNet("2000::/64"), Total: 65528, Used: 1},
+			}))
+		})
+
+		ginkgo.It("fails for unknown subnets", func() {
+			_, err := allocator.GetSubnetsUsage(subnetName)
+			gomega.Expect(err).To(gomega.MatchError(ErrSubnetNotFound))
+		})
 	})
 
 	ginkgo.Context("when allocating IP addresses", func() {
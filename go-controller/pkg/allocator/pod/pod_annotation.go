@@ -3,11 +3,13 @@ package pod
 import (
 	"fmt"
 	"net"
+	"slices"
 
 	ipamclaimsapi "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1"
 	nadapi "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
 
@@ -324,13 +326,20 @@ func allocatePodAnnotationWithRollback(
 	}
 	if hasIPAMClaim {
 		ipamClaim, err = claimsReconciler.FindIPAMClaim(network.IPAMClaimReference, network.Namespace)
+		if apierrors.IsNotFound(err) && persistentips.IsStatefulSetIPAMClaimReference(pod, network) {
+			// IPAMClaims of StatefulSet pods are created on their behalf
+			ipamClaim, err = claimsReconciler.CreateStatefulSetIPAMClaim(pod, network)
+		}
 		if err != nil {
 			err = fmt.Errorf("error retrieving IPAMClaim for pod %s/%s: %w", pod.GetNamespace(), pod.GetName(), err)
 			return
 		}
 		hasIPAMClaim = ipamClaim != nil && len(ipamClaim.Status.IPs) > 0
 	}
-	if hasIPAM && hasStaticIPRequest {
+	// reserved IPs are tracked apart from excluded ones so static IP requests
+	// for them can be honored even if there is IPAM
+	hasReservedIPRequest := hasStaticIPRequest && isReservedIPRequest(netInfo, network.IPRequest)
+	if hasIPAM && hasStaticIPRequest && !hasReservedIPRequest {
 		// for now we can't tell apart already allocated IPs from IPs excluded
 		// from allocation so we can't really honor static IP requests when
 		// there is IPAM as we don't really know if the requested IP should not
@@ -338,6 +347,9 @@ func allocatePodAnnotationWithRollback(
 		err = fmt.Errorf("cannot allocate a static IP request with IPAM for pod %s", podDesc)
 		return
 	}
+	// requested reserved IPs not yet annotated on the pod must not be in use
+	// by anyone else
+	allocatesReservedIPs := len(tentative.IPs) == 0 && hasReservedIPRequest
 
 	// we need to update the annotation if it is missing IPs or MAC
 	needsIPOrMAC := len(tentative.IPs) == 0 && (hasIPAM || hasIPRequest)
@@ -360,7 +372,7 @@ func allocatePodAnnotationWithRollback(
 
 	if hasIPAM {
 		if len(tentative.IPs) > 0 {
-			if err = ipAllocator.AllocateIPs(tentative.IPs); err != nil && !ip.IsErrAllocated(err) {
+			if err = ipAllocator.AllocateIPs(tentative.IPs); err != nil && (!ip.IsErrAllocated(err) || allocatesReservedIPs) {
 				err = fmt.Errorf("failed to ensure requested or annotated IPs %v for %s: %w",
 					util.StringSlice(tentative.IPs), podDesc, err)
 				if !reallocateOnNonStaticIPRequest {
@@ -412,7 +424,21 @@ func allocatePodAnnotationWithRollback(
 		}
 	}
 
-	needsAnnotationUpdate := needsIPOrMAC || needsID
+	// pods annotated before subnets were appended to the network lack the
+	// routes towards them
+	var needsRoutes bool
+	if !needsIPOrMAC {
+		withRoutes := *podAnnotation
+		withRoutes.Routes = slices.Clone(podAnnotation.Routes)
+		if util.AddMissingClusterSubnetRoutes(netInfo, &withRoutes) {
+			needsRoutes = true
+			tentative.Gateways = withRoutes.Gateways
+			tentative.GatewayIPv6LLA = withRoutes.GatewayIPv6LLA
+			tentative.Routes = withRoutes.Routes
+		}
+	}
+
+	needsAnnotationUpdate := needsIPOrMAC || needsID || needsRoutes
 
 	if needsAnnotationUpdate {
 		updatedPod = pod
@@ -428,3 +454,22 @@ func allocatePodAnnotationWithRollback(
 
 	return
 }
+
+// isReservedIPRequest returns whether all the requested IPs belong to the
+// reserved subnets of the network
+func isReservedIPRequest(netInfo util.NetInfo, ipRequest []string) bool {
+	reservedSubnets := netInfo.ReservedSubnets()
+	if len(reservedSubnets) == 0 {
+		return false
+	}
+	ips, err := util.ParseIPNets(ipRequest)
+	if err != nil {
+		return false
+	}
+	for _, ip := range ips {
+		if !util.IsContainedInAnyCIDR(&net.IPNet{IP: ip.IP, Mask: util.GetIPFullMask(ip.IP)}, reservedSubnets...) {
+			return false
+		}
+	}
+	return true
+}
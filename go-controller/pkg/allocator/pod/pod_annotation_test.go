@@ -85,6 +85,18 @@ func (c *persistentIPsStub) FindIPAMClaim(claimName string, namespace string) (*
 	return &ipamClaim, nil
 }
 
+func (c *persistentIPsStub) CreateStatefulSetIPAMClaim(pod *corev1.Pod, network *nadapi.NetworkSelectionElement) (*ipamclaimsapi.IPAMClaim, error) {
+	ipamClaim := ipamclaimsapi.IPAMClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: network.IPAMClaimReference, Namespace: pod.Namespace},
+	}
+	c.datastore[ipamClaimKey(ipamClaim.Namespace, ipamClaim.Name)] = ipamClaim
+	return &ipamClaim, nil
+}
+
+func (c *persistentIPsStub) ReleaseStatefulSetIPAMClaim(_ *corev1.Pod, _ *nadapi.NetworkSelectionElement) error {
+	return nil
+}
+
 func ipamClaimKey(namespace string, claimName string) string {
 	return fmt.Sprintf("%s/%s", namespace, claimName)
 }
@@ -114,6 +126,7 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 		ipam                      bool
 		idAllocation              bool
 		persistentIPAllocation    bool
+		reservedSubnets           string
 		role                      string
 		podAnnotation             *util.PodAnnotation
 		invalidNetworkAnnotation  bool
@@ -128,6 +141,7 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 		isSingleStackIPv4         bool
 		isSingleStackIPv6         bool
 		multiNetworkDisabled      bool
+		clusterSubnets            []config.CIDRNetworkEntry
 	}{
 		{
 			// on secondary L2 networks with no IPAM, we expect to generate a
@@ -205,6 +219,55 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 			wantUpdatedPod: true,
 			wantErr:        true,
 		},
+		{
+			// on networks with IPAM, expect a static IP request to be honored
+			// if the requested IP is reserved
+			name:            "expect requested static reserved IP, IPAM",
+			ipam:            true,
+			reservedSubnets: "192.168.0.0/28",
+			args: args{
+				network: &nadapi.NetworkSelectionElement{
+					IPRequest: []string{"192.168.0.4/24"},
+				},
+				ipAllocator: &ipAllocatorStub{},
+			},
+			wantUpdatedPod: true,
+			wantPodAnnotation: &util.PodAnnotation{
+				IPs: ovntest.MustParseIPNets("192.168.0.4/24"),
+				MAC: util.IPAddrToHWAddr(ovntest.MustParseIPNets("192.168.0.4/24")[0].IP),
+			},
+			wantReleasedIPsOnRollback: ovntest.MustParseIPNets("192.168.0.4/24"),
+		},
+		{
+			// on networks with IPAM, expect error if the requested reserved IP
+			// is already in use
+			name:            "expect error, static reserved IP request already allocated, IPAM",
+			ipam:            true,
+			reservedSubnets: "192.168.0.0/28",
+			args: args{
+				network: &nadapi.NetworkSelectionElement{
+					IPRequest: []string{"192.168.0.4/24"},
+				},
+				ipAllocator: &ipAllocatorStub{
+					allocateIPsError: ipam.ErrAllocated,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			// on networks with IPAM, expect error if the requested IP is not
+			// reserved
+			name:            "expect error, static ip request not reserved, IPAM",
+			ipam:            true,
+			reservedSubnets: "192.168.0.0/28",
+			args: args{
+				network: &nadapi.NetworkSelectionElement{
+					IPRequest: []string{"192.168.0.20/24"},
+				},
+			},
+			wantUpdatedPod: true,
+			wantErr:        true,
+		},
 		{
 			// on networks with IPAM, expect a normal IP, MAC and gateway
 			// allocation
@@ -363,6 +426,47 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 			},
 			wantReleasedIPsOnRollback: ovntest.MustParseIPNets("192.168.0.3/24"),
 		},
+		{
+			// on networks with IPAM, if pod is already annotated, expect the
+			// routes towards the cluster subnets appended to the network
+			name: "expect appended cluster subnet routes, annotated, IPAM",
+			ipam: true,
+			clusterSubnets: []config.CIDRNetworkEntry{
+				{CIDR: ovntest.MustParseIPNet("10.128.0.0/16"), HostSubnetLength: 24},
+				{CIDR: ovntest.MustParseIPNet("10.130.0.0/16"), HostSubnetLength: 24},
+			},
+			podAnnotation: &util.PodAnnotation{
+				IPs:      ovntest.MustParseIPNets("10.128.1.3/24"),
+				MAC:      util.IPAddrToHWAddr(ovntest.MustParseIPNets("10.128.1.3/24")[0].IP),
+				Gateways: []net.IP{ovntest.MustParseIP("10.128.1.1").To4()},
+				Routes: []util.PodRoute{
+					{
+						Dest:    ovntest.MustParseIPNet("10.128.0.0/16"),
+						NextHop: ovntest.MustParseIP("10.128.1.1").To4(),
+					},
+				},
+			},
+			args: args{
+				ipAllocator: &ipAllocatorStub{},
+			},
+			wantUpdatedPod: true,
+			wantPodAnnotation: &util.PodAnnotation{
+				IPs:      ovntest.MustParseIPNets("10.128.1.3/24"),
+				MAC:      util.IPAddrToHWAddr(ovntest.MustParseIPNets("10.128.1.3/24")[0].IP),
+				Gateways: []net.IP{ovntest.MustParseIP("10.128.1.1")},
+				Routes: []util.PodRoute{
+					{
+						Dest:    ovntest.MustParseIPNet("10.128.0.0/16"),
+						NextHop: ovntest.MustParseIP("10.128.1.1"),
+					},
+					{
+						Dest:    ovntest.MustParseIPNet("10.130.0.0/16"),
+						NextHop: ovntest.MustParseIP("10.128.1.1").To4(),
+					},
+				},
+			},
+			wantReleasedIPsOnRollback: ovntest.MustParseIPNets("10.128.1.3/24"),
+		},
 		{
 			// on networks with IPAM, if pod is already annotated, expect no
 			// further updates and no error if the IP is already allocated
@@ -777,6 +881,7 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 			config.OVNKubernetesFeature.EnableInterconnect = tt.idAllocation
 			config.OVNKubernetesFeature.EnableMultiNetwork = !tt.multiNetworkDisabled
 			config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+			config.Default.ClusterSubnets = tt.clusterSubnets
 			config.IPv4Mode = true
 			if tt.isSingleStackIPv6 {
 				config.IPv4Mode = false
@@ -788,7 +893,7 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 			var netInfo util.NetInfo
 			netInfo = &util.DefaultNetInfo{}
 			nadName := types.DefaultNetworkName
-			if !tt.ipam || tt.idAllocation || tt.persistentIPAllocation || tt.args.ipamClaim != nil {
+			if !tt.ipam || tt.idAllocation || tt.persistentIPAllocation || tt.args.ipamClaim != nil || tt.reservedSubnets != "" {
 				nadName = util.GetNADName(network.Namespace, network.Name)
 				var subnets string
 				if tt.ipam {
@@ -807,6 +912,7 @@ func Test_allocatePodAnnotationWithRollback(t *testing.T) {
 					NADName:            nadName,
 					Subnets:            subnets,
 					AllowPersistentIPs: tt.persistentIPAllocation,
+					ReservedSubnets:    tt.reservedSubnets,
 					Role:               tt.role,
 				})
 				if err != nil {
@@ -153,6 +153,7 @@ func NewClusterManager(
 		cm.userDefinedNetworkController = udnController
 		if cm.secondaryNetClusterManager != nil {
 			cm.secondaryNetClusterManager.SetNetworkStatusReporter(udnController.UpdateSubsystemCondition)
+			cm.secondaryNetClusterManager.SetNetworkCapacityReporter(udnController.UpdateNetworkCapacity)
 		}
 	}
 
@@ -19,6 +19,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
@@ -607,7 +608,7 @@ func checkEgressNodesReachabilityIterate(eIPC *egressIPClusterController) {
 		if shouldDelete {
 			metrics.RecordEgressIPUnreachableNode()
 			klog.Warningf("Node: %s is detected as unreachable, deleting it from egress assignment", nodeName)
-			if err := eIPC.deleteEgressNode(nodeName); err != nil {
+			if err := eIPC.deleteEgressNode(nodeName, egressNodeUnreachableReason); err != nil {
 				klog.Errorf("Node: %s is detected as unreachable, but could not re-assign egress IPs, err: %v", nodeName, err)
 			}
 		} else {
@@ -764,7 +765,18 @@ func (eIPC *egressIPClusterController) deleteNodeForEgress(node *corev1.Node) {
 	eIPC.nodeAllocator.Unlock()
 }
 
-func (eIPC *egressIPClusterController) deleteEgressNode(nodeName string) error {
+// Reasons of the events recorded on EgressIPs whose egress IPs are reassigned
+// because the node they were assigned to failed its health checks.
+const (
+	egressNodeUnreachableReason = "EgressNodeUnreachable"
+	egressNodeNotReadyReason    = "EgressNodeNotReady"
+)
+
+// deleteEgressNode removes the node from egress IP assignment and reassigns
+// the egress IPs assigned to it. healthFailureReason is set when the node is
+// removed because it failed its health checks, in which case an event is
+// recorded on every EgressIP having egress IPs reassigned.
+func (eIPC *egressIPClusterController) deleteEgressNode(nodeName, healthFailureReason string) error {
 	var errorAggregate []error
 	klog.V(5).Infof("Egress node: %s about to be removed", nodeName)
 	// Since the node has been labelled as "not usable" for egress IP
@@ -778,6 +790,9 @@ func (eIPC *egressIPClusterController) deleteEgressNode(nodeName string) error {
 		egressIP := *egressIP
 		for _, status := range egressIP.Status.Items {
 			if status.Node == nodeName {
+				if healthFailureReason != "" {
+					eIPC.recordEgressNodeHealthFailure(&egressIP, nodeName, healthFailureReason)
+				}
 				// Send a "synthetic update" on all egress IPs which have an
 				// assignment to this node. The reconciliation loop for
 				// WatchEgressIP will see that the current assignment status to
@@ -866,6 +881,27 @@ func (eIPC *egressIPClusterController) addAllocatorEgressIPAssignments(name stri
 	}
 }
 
+// recordEgressNodeHealthFailure records an event on egressIP for the egress IPs
+// that are about to be reassigned away from the unhealthy node.
+func (eIPC *egressIPClusterController) recordEgressNodeHealthFailure(egressIP *egressipv1.EgressIP, nodeName, reason string) {
+	var egressIPs []string
+	for _, status := range egressIP.Status.Items {
+		if status.Node == nodeName {
+			egressIPs = append(egressIPs, status.EgressIP)
+		}
+	}
+	state := "unreachable"
+	if reason == egressNodeNotReadyReason {
+		state = "not ready"
+	}
+	eIPRef := corev1.ObjectReference{
+		Kind: "EgressIP",
+		Name: egressIP.Name,
+	}
+	eIPC.recorder.Eventf(&eIPRef, corev1.EventTypeWarning, reason, "Reassigning egress IP(s) %s of EgressIP: %s because node %s is %s",
+		strings.Join(egressIPs, ", "), egressIP.Name, nodeName, state)
+}
+
 func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.EgressIP) (err error) {
 	// Lock the assignment, this is needed because this function can end up
 	// being called from WatchEgressNodes and WatchEgressIP, i.e: two different
@@ -941,6 +977,7 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 	ipsToRemove := sets.New[string]()
 	statusToAdd := make([]egressipv1.EgressIPStatusItem, 0, len(ipsToAssign))
 	statusToKeep := make([]egressipv1.EgressIPStatusItem, 0, len(validStatus))
+	var assignmentFailures map[string]egressIPAssignmentFailure
 	for status := range validStatus {
 		statusToKeep = append(statusToKeep, status)
 		ipsToAssign.Delete(status.EgressIP)
@@ -987,6 +1024,20 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 		ipsToAssign = ipsToAssign.Intersection(ipsToRemove)
 	}
 
+	// Assign the egress IPs in order of preference, so that the most
+	// preferred ones are assigned first when nodes are scarce, each towards
+	// the nodes it prefers.
+	preferredNodeSelectors, err := util.GetEgressIPPreferredNodeSelectors(&newEIP.Spec)
+	if err != nil {
+		return fmt.Errorf("invalid EgressIP strategy, err: %v", err)
+	}
+	egressIPsToAssign := make([]string, 0, ipsToAssign.Len())
+	for _, egressIP := range util.GetEgressIPsByPreference(&newEIP.Spec) {
+		if ipsToAssign.Has(egressIP) {
+			egressIPsToAssign = append(egressIPsToAssign, egressIP)
+		}
+	}
+
 	if !util.PlatformTypeIsEgressIPCloudProvider() {
 		if len(statusToRemove) > 0 {
 			// Delete the statusToRemove from the allocator cache. If we don't
@@ -995,7 +1046,7 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 			eIPC.deleteAllocatorEgressIPAssignments(statusToRemove)
 		}
 		if len(ipsToAssign) > 0 {
-			statusToAdd = eIPC.assignEgressIPs(name, ipsToAssign.UnsortedList())
+			statusToAdd, assignmentFailures = eIPC.assignEgressIPs(name, egressIPsToAssign, preferredNodeSelectors)
 			statusToKeep = append(statusToKeep, statusToAdd...)
 		}
 		// Add all assignments which are to be kept to the allocator cache,
@@ -1003,9 +1054,13 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 		// avoid incorrect future assignments due to a de-synchronized cache.
 		eIPC.addAllocatorEgressIPAssignments(name, statusToKeep)
 		// Update the object only on an ADD/UPDATE. If we are processing a
-		// DELETE, new will be nil and we should not update the object.
-		if len(statusToAdd) > 0 || (len(statusToRemove) > 0 && new != nil) {
-			if err := eIPC.patchEgressIP(name, eIPC.generateEgressIPPatches(name, new.Annotations, statusToKeep)...); err != nil {
+		// DELETE, new will be nil and we should not update the object. The
+		// object is also updated when only the conditions of the requested
+		// egress IPs changed, so that users can tell why an egress IP is not
+		// assigned.
+		if new != nil && (len(statusToAdd) > 0 || len(statusToRemove) > 0 ||
+			!egressIPConditionsEqual(new.Status.Conditions, generateEgressIPConditions(new, statusToKeep, assignmentFailures))) {
+			if err := eIPC.patchEgressIP(name, eIPC.generateEgressIPPatches(new, statusToKeep, assignmentFailures)...); err != nil {
 				return err
 			}
 		}
@@ -1018,6 +1073,7 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 		// unattach operation. Some clouds such as Azure will remove the IP address nearly
 		// immediately, but then they will take a long time (seconds to minutes) to actually report
 		// success of the removal operation.
+		currentStatus := newEIP.Status.Items
 		if len(statusToRemove) > 0 {
 			// Delete all assignments that are to be removed from the allocator
 			// cache. If we don't do this we will occupy assignment positions for
@@ -1033,9 +1089,10 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 			// Update the object only on an ADD/UPDATE. If we are processing a
 			// DELETE, new will be nil and we should not update the object.
 			if new != nil {
-				if err := eIPC.patchEgressIP(name, eIPC.generateEgressIPPatches(name, new.Annotations, statusToKeep)...); err != nil {
+				if err := eIPC.patchEgressIP(name, eIPC.generateEgressIPPatches(new, statusToKeep, nil)...); err != nil {
 					return err
 				}
+				currentStatus = statusToKeep
 			}
 		}
 		// When egress IP is not fully assigned to a node, then statusToRemove may not
@@ -1059,7 +1116,7 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 		// processing the answer from the requests we make here, and update OVN
 		// accordingly when we know what the outcome is.
 		if len(ipsToAssign) > 0 {
-			statusToAdd = eIPC.assignEgressIPs(name, ipsToAssign.UnsortedList())
+			statusToAdd, assignmentFailures = eIPC.assignEgressIPs(name, egressIPsToAssign, preferredNodeSelectors)
 			statusToKeep = append(statusToKeep, statusToAdd...)
 		}
 		// Same as above: Add all assignments which are to be kept to the
@@ -1068,6 +1125,25 @@ func (eIPC *egressIPClusterController) reconcileEgressIP(old, new *egressipv1.Eg
 		// de-synchronized cache.
 		eIPC.addAllocatorEgressIPAssignments(name, statusToKeep)
 
+		// The status items are only updated once the cloud confirms the
+		// assignments, but the conditions of the requested egress IPs are
+		// updated right away. This has to happen before requesting the
+		// assignments from the cloud so that this update never overwrites the
+		// status items set on confirmation.
+		if new != nil {
+			for _, status := range statusToAdd {
+				assignmentFailures[status.EgressIP] = egressIPAssignmentFailure{
+					reason:  egressipv1.EgressIPReasonPending,
+					message: fmt.Sprintf("waiting for the cloud to assign the egress IP to node %s", status.Node),
+				}
+			}
+			if !egressIPConditionsEqual(new.Status.Conditions, generateEgressIPConditions(new, currentStatus, assignmentFailures)) {
+				if err := eIPC.patchEgressIP(name, eIPC.generateEgressIPPatches(new, currentStatus, assignmentFailures)...); err != nil {
+					return err
+				}
+			}
+		}
+
 		// Execute CloudPrivateIPConfig changes for assignments which need to be
 		// added/removed, assignments which don't change do not require any
 		// further setup.
@@ -1117,7 +1193,7 @@ func (eIPC *egressIPClusterController) syncCloudPrivateIPConfigs(objs []interfac
 		if cloudPrivateIPNotFound {
 			// There could be one or more stale entry found in egress ip object, remove it by patching egressip
 			// object with updated status.
-			err = eIPC.patchEgressIP(egressIP.Name, eIPC.generateEgressIPPatches(egressIP.Name, egressIP.Annotations, updatedStatus)...)
+			err = eIPC.patchEgressIP(egressIP.Name, eIPC.generateEgressIPPatches(egressIP, updatedStatus, nil)...)
 			if err != nil {
 				return fmt.Errorf("syncCloudPrivateIPConfigs unable to update EgressIP status: %w", err)
 			}
@@ -1156,10 +1232,12 @@ func (eIPC *egressIPClusterController) getCloudPrivateIPConfigMap(objs []interfa
 // time, this does not guarantee complete balance, but mostly complete.
 // For Egress IPs that are hosted by secondary host networks, there must be at least
 // one node that hosts the network and exposed via the nodes host-cidrs annotation.
-func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []string) []egressipv1.EgressIPStatusItem {
+func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []string,
+	preferredNodeSelectors map[string]labels.Selector) ([]egressipv1.EgressIPStatusItem, map[string]egressIPAssignmentFailure) {
 	eIPC.nodeAllocator.Lock()
 	defer eIPC.nodeAllocator.Unlock()
 	assignments := []egressipv1.EgressIPStatusItem{}
+	failures := map[string]egressIPAssignmentFailure{}
 	assignableNodes, existingAllocations := eIPC.getSortedEgressData()
 	if len(assignableNodes) == 0 {
 		eIPRef := corev1.ObjectReference{
@@ -1168,7 +1246,13 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 		}
 		eIPC.recorder.Eventf(&eIPRef, corev1.EventTypeWarning, "NoMatchingNodeFound", "no assignable nodes for EgressIP: %s, please tag at least one node with label: %s", name, util.GetNodeEgressLabel())
 		klog.Errorf("No assignable nodes found for EgressIP: %s and requested IPs: %v", name, egressIPs)
-		return assignments
+		for _, egressIP := range egressIPs {
+			failures[util.NormalizeEgressIP(egressIP)] = egressIPAssignmentFailure{
+				reason:  egressipv1.EgressIPReasonNoAssignableNodes,
+				message: fmt.Sprintf("no node is ready, reachable and labeled with %s", util.GetNodeEgressLabel()),
+			}
+		}
+		return assignments, failures
 	}
 	klog.V(5).Infof("Current assignments are: %+v", existingAllocations)
 	for _, egressIP := range egressIPs {
@@ -1179,7 +1263,7 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 		// cluster, therefore there maybe still conflicts when we attempt to assign an egress IP with a different scope.
 		if isIPConflict, conflictedHost, err := eIPC.isEgressIPAddrConflict(eIP); err != nil {
 			klog.Errorf("Egress IP: %v failed to check if EgressIP already is assigned on any interface throughout the cluster: %v", eIP, err)
-			return assignments
+			return assignments, failures
 		} else if isIPConflict {
 			eIPRef := corev1.ObjectReference{
 				Kind: "EgressIP",
@@ -1188,7 +1272,11 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 			eIPC.recorder.Eventf(&eIPRef, corev1.EventTypeWarning, "EgressIPConflict", "Egress IP %s with IP "+
 				"%v is conflicting with a host (%s) IP address and will not be assigned", name, eIP, conflictedHost)
 			klog.Errorf("Egress IP: %v address is already assigned on an interface on node %s", eIP, conflictedHost)
-			return assignments
+			failures[eIP.String()] = egressIPAssignmentFailure{
+				reason:  egressipv1.EgressIPReasonConflict,
+				message: fmt.Sprintf("IP address is already assigned to an interface of host %s", conflictedHost),
+			}
+			return assignments, failures
 		}
 		if status, exists := existingAllocations[eIP.String()]; exists {
 			// On public clouds we will re-process assignments for the same IP
@@ -1240,7 +1328,11 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 					"IP: %q for EgressIP: %s is already allocated for EgressIP: %s on %s", egressIP, name, status.Name, status.Node,
 				)
 				klog.Errorf("IP: %q for EgressIP: %s is already allocated for EgressIP: %s on %s", egressIP, name, status.Name, status.Node)
-				return assignments
+				failures[eIP.String()] = egressIPAssignmentFailure{
+					reason:  egressipv1.EgressIPReasonConflict,
+					message: fmt.Sprintf("IP address is already allocated for EgressIP %s", status.Name),
+				}
+				return assignments, failures
 			}
 		}
 		// Egress IP for secondary host networks is only available on baremetal environments
@@ -1277,12 +1369,18 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 			}
 		}
 
-		var assignmentSuccessful bool
-		for i := 0; i < len(assignableNodes) && !assignmentSuccessful; i++ {
-			eNode := assignableNodes[i]
+		candidateNodes := assignableNodes
+		if selector, ok := preferredNodeSelectors[eIP.String()]; ok {
+			candidateNodes = eIPC.sortNodesByPreference(assignableNodes, selector)
+		}
+
+		var assignmentSuccessful, nodesInUse, capacityExhausted bool
+		for i := 0; i < len(candidateNodes) && !assignmentSuccessful; i++ {
+			eNode := candidateNodes[i]
 			klog.V(5).Infof("Attempting assignment on egress node: %+v", eNode)
 			if eNode.getAllocationCountForEgressIP(name) > 0 {
 				klog.V(5).Infof("Node: %s is already in use by another egress IP for this EgressIP: %s, trying another node", eNode.name, name)
+				nodesInUse = nodesInUse || eIPC.canNodeHostEgressIP(eNode, eIP)
 				continue
 			}
 			node, err := eIPC.watchFactory.GetNode(eNode.name)
@@ -1302,18 +1400,21 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 			if eNode.egressIPConfig.Capacity.IP < util.UnlimitedNodeCapacity {
 				if eNode.egressIPConfig.Capacity.IP-len(eNode.allocations) <= 0 {
 					klog.V(5).Infof("Additional allocation on Node: %s exhausts it's IP capacity, trying another node", eNode.name)
+					capacityExhausted = true
 					continue
 				}
 			}
 			if eNode.egressIPConfig.Capacity.IPv4 < util.UnlimitedNodeCapacity && utilnet.IsIPv4(eIP) {
 				if eNode.egressIPConfig.Capacity.IPv4-getIPFamilyAllocationCount(eNode.allocations, false) <= 0 {
 					klog.V(5).Infof("Additional allocation on Node: %s exhausts it's IPv4 capacity, trying another node", eNode.name)
+					capacityExhausted = true
 					continue
 				}
 			}
 			if eNode.egressIPConfig.Capacity.IPv6 < util.UnlimitedNodeCapacity && utilnet.IsIPv6(eIP) {
 				if eNode.egressIPConfig.Capacity.IPv6-getIPFamilyAllocationCount(eNode.allocations, true) <= 0 {
 					klog.V(5).Infof("Additional allocation on Node: %s exhausts it's IPv6 capacity, trying another node", eNode.name)
+					capacityExhausted = true
 					continue
 				}
 			}
@@ -1326,6 +1427,9 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 			klog.Infof("Successful assignment of egress IP: %s to network %s on node: %+v", egressIP, egressIPNetwork, eNode)
 			break
 		}
+		if !assignmentSuccessful {
+			failures[eIP.String()] = newEgressIPAssignmentFailure(capacityExhausted, nodesInUse)
+		}
 	}
 	if len(assignments) == 0 {
 		eIPRef := corev1.ObjectReference{
@@ -1334,7 +1438,7 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 		}
 		eIPC.recorder.Eventf(&eIPRef, corev1.EventTypeWarning, "NoMatchingNodeFound", "No matching nodes found, which can host any of the egress IPs: %v for object EgressIP: %s", egressIPs, name)
 		klog.Errorf("No matching host found for EgressIP: %s", name)
-		return assignments
+		return assignments, failures
 	}
 	if len(assignments) < len(egressIPs) {
 		eIPRef := corev1.ObjectReference{
@@ -1343,7 +1447,134 @@ func (eIPC *egressIPClusterController) assignEgressIPs(name string, egressIPs []
 		}
 		eIPC.recorder.Eventf(&eIPRef, corev1.EventTypeWarning, "UnassignedRequest", "Not all egress IPs for EgressIP: %s could be assigned, please tag more nodes", name)
 	}
-	return assignments
+	return assignments, failures
+}
+
+// canNodeHostEgressIP returns whether the egress node has a network the egress
+// IP can be hosted on.
+func (eIPC *egressIPClusterController) canNodeHostEgressIP(eNode *egressNode, eIP net.IP) bool {
+	node, err := eIPC.watchFactory.GetNode(eNode.name)
+	if err != nil {
+		return false
+	}
+	egressIPNetwork, err := util.GetEgressIPNetwork(node, eNode.egressIPConfig, eIP)
+	return err == nil && egressIPNetwork != ""
+}
+
+// sortNodesByPreference returns the egress nodes with the ones matching the
+// preferred node selector first, keeping the relative order of both groups.
+func (eIPC *egressIPClusterController) sortNodesByPreference(eNodes []*egressNode, selector labels.Selector) []*egressNode {
+	preferred := make([]*egressNode, 0, len(eNodes))
+	others := make([]*egressNode, 0, len(eNodes))
+	for _, eNode := range eNodes {
+		node, err := eIPC.watchFactory.GetNode(eNode.name)
+		if err != nil {
+			klog.Warningf("Failed to determine if node %s is preferred to host egress IPs because unable to get node obj: %v",
+				eNode.name, err)
+			others = append(others, eNode)
+			continue
+		}
+		if selector.Matches(labels.Set(node.Labels)) {
+			preferred = append(preferred, eNode)
+		} else {
+			others = append(others, eNode)
+		}
+	}
+	return append(preferred, others...)
+}
+
+// egressIPAssignmentFailure holds the reason and message reported in the
+// EgressIP status conditions for an egress IP which could not be assigned.
+type egressIPAssignmentFailure struct {
+	reason  string
+	message string
+}
+
+// newEgressIPAssignmentFailure returns the failure for an egress IP which no
+// assignable node could host. Capacity exhaustion takes precedence over nodes
+// hosting another egress IP of the same object, since it is the one which
+// requires action from the cluster administrator.
+func newEgressIPAssignmentFailure(capacityExhausted, nodesInUse bool) egressIPAssignmentFailure {
+	switch {
+	case capacityExhausted:
+		return egressIPAssignmentFailure{
+			reason:  egressipv1.EgressIPReasonCapacityExhausted,
+			message: "all nodes that can host the egress IP have exhausted their egress IP capacity",
+		}
+	case nodesInUse:
+		return egressIPAssignmentFailure{
+			reason:  egressipv1.EgressIPReasonNodesInUse,
+			message: "all nodes that can host the egress IP already host another egress IP of this EgressIP, please tag more nodes",
+		}
+	default:
+		return egressIPAssignmentFailure{
+			reason:  egressipv1.EgressIPReasonNoHostingNetwork,
+			message: "no assignable node has a network containing the egress IP",
+		}
+	}
+}
+
+// generateEgressIPConditions computes the conditions of every egress IP
+// requested by egressIP. Egress IPs found in statusItems are reported as
+// assigned, those found in failures as unassigned with the corresponding
+// reason. Egress IPs not found in either keep their previous unassigned
+// condition, if any, or are reported as pending.
+func generateEgressIPConditions(egressIP *egressipv1.EgressIP, statusItems []egressipv1.EgressIPStatusItem,
+	failures map[string]egressIPAssignmentFailure) []egressipv1.EgressIPCondition {
+	existing := make(map[string]egressipv1.EgressIPCondition, len(egressIP.Status.Conditions))
+	for _, condition := range egressIP.Status.Conditions {
+		existing[condition.EgressIP] = condition
+	}
+	assigned := make(map[string]string, len(statusItems))
+	for _, item := range statusItems {
+		assigned[item.EgressIP] = item.Node
+	}
+	now := metav1.Now()
+	conditions := make([]egressipv1.EgressIPCondition, 0, len(egressIP.Spec.EgressIPs))
+	for _, ip := range egressIP.Spec.EgressIPs {
+		ip = util.NormalizeEgressIP(ip)
+		condition := egressipv1.EgressIPCondition{
+			EgressIP:           ip,
+			Type:               egressipv1.EgressIPConditionAssigned,
+			Status:             metav1.ConditionFalse,
+			LastTransitionTime: now,
+		}
+		previous, hasPrevious := existing[ip]
+		if node, ok := assigned[ip]; ok {
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = egressipv1.EgressIPReasonAssigned
+			condition.Message = fmt.Sprintf("egress IP is assigned to node %s", node)
+		} else if failure, ok := failures[ip]; ok {
+			condition.Reason = failure.reason
+			condition.Message = failure.message
+		} else if hasPrevious && previous.Status == metav1.ConditionFalse {
+			condition.Reason = previous.Reason
+			condition.Message = previous.Message
+		} else {
+			condition.Reason = egressipv1.EgressIPReasonPending
+			condition.Message = "egress IP is pending assignment"
+		}
+		if hasPrevious && previous.Status == condition.Status {
+			condition.LastTransitionTime = previous.LastTransitionTime
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions
+}
+
+// egressIPConditionsEqual returns true if both condition lists report the same
+// state for the same egress IPs, disregarding transition times.
+func egressIPConditionsEqual(a, b []egressipv1.EgressIPCondition) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].EgressIP != b[i].EgressIP || a[i].Type != b[i].Type || a[i].Status != b[i].Status ||
+			a[i].Reason != b[i].Reason || a[i].Message != b[i].Message {
+			return false
+		}
+	}
+	return true
 }
 
 func getIPFamilyAllocationCount(allocations map[string]string, isIPv6 bool) (count int) {
@@ -1542,7 +1773,7 @@ func (eIPC *egressIPClusterController) reconcileCloudPrivateIPConfig(old, new *o
 					updatedStatus = append(updatedStatus, status)
 				}
 			}
-			if err := eIPC.patchEgressIP(egressIP.Name, eIPC.generateEgressIPPatches(egressIP.Name, egressIP.Annotations, updatedStatus)...); err != nil {
+			if err := eIPC.patchEgressIP(egressIP.Name, eIPC.generateEgressIPPatches(egressIP, updatedStatus, nil)...); err != nil {
 				return err
 			}
 		}
@@ -1589,7 +1820,7 @@ func (eIPC *egressIPClusterController) reconcileCloudPrivateIPConfig(old, new *o
 		}
 		if !hasStatus {
 			statusToKeep := append(egressIP.Status.Items, statusItem)
-			if err := eIPC.patchEgressIP(egressIP.Name, eIPC.generateEgressIPPatches(egressIP.Name, egressIP.Annotations, statusToKeep)...); err != nil {
+			if err := eIPC.patchEgressIP(egressIP.Name, eIPC.generateEgressIPPatches(egressIP, statusToKeep, nil)...); err != nil {
 				return err
 			}
 		}
@@ -1740,17 +1971,17 @@ func (eIPC *egressIPClusterController) patchEgressIP(name string, patches ...jso
 // log an error instead of failing because we do not wish to block primary default network egress IP assignments due to potential
 // mark range exhaustion. Primary default network egress IP currently does not utilize marks to config EgressIP.
 // Generating the status patch is mandatory
-func (eIPC *egressIPClusterController) generateEgressIPPatches(name string, annotations map[string]string,
-	statusItems []egressipv1.EgressIPStatusItem) []jsonPatchOperation {
+func (eIPC *egressIPClusterController) generateEgressIPPatches(egressIP *egressipv1.EgressIP,
+	statusItems []egressipv1.EgressIPStatusItem, failures map[string]egressIPAssignmentFailure) []jsonPatchOperation {
 	patches := make([]jsonPatchOperation, 0, 1)
-	if !util.IsEgressIPMarkSet(annotations) {
-		if mark, _, err := eIPC.getOrAllocMark(name); err != nil {
-			klog.Errorf("Failed to get mark for EgressIP %s: %v", name, err)
+	if !util.IsEgressIPMarkSet(egressIP.Annotations) {
+		if mark, _, err := eIPC.getOrAllocMark(egressIP.Name); err != nil {
+			klog.Errorf("Failed to get mark for EgressIP %s: %v", egressIP.Name, err)
 		} else {
 			patches = append(patches, generateMarkPatchOp(mark))
 		}
 	}
-	return append(patches, generateStatusPatchOp(statusItems))
+	return append(patches, generateStatusPatchOp(statusItems, generateEgressIPConditions(egressIP, statusItems, failures)))
 }
 
 func generateMarkPatchOp(mark int) jsonPatchOperation {
@@ -1765,12 +1996,13 @@ func createAnnotWithMark(mark int) map[string]string {
 	return map[string]string{util.EgressIPMarkAnnotation: fmt.Sprintf("%d", mark)}
 }
 
-func generateStatusPatchOp(statusItems []egressipv1.EgressIPStatusItem) jsonPatchOperation {
+func generateStatusPatchOp(statusItems []egressipv1.EgressIPStatusItem, conditions []egressipv1.EgressIPCondition) jsonPatchOperation {
 	return jsonPatchOperation{
 		Operation: "replace",
 		Path:      "/status",
 		Value: egressipv1.EgressIPStatus{
-			Items: statusItems,
+			Items:      statusItems,
+			Conditions: conditions,
 		},
 	}
 }
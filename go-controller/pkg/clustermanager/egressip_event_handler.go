@@ -119,7 +119,7 @@ func (h *egressIPClusterControllerEventHandler) UpdateResource(oldObj, newObj in
 		h.eIPC.setNodeEgressAssignable(newNode.Name, newHasEgressLabel)
 		if oldHadEgressLabel && !newHasEgressLabel {
 			klog.Infof("Node: %s has been un-labeled, deleting it from egress assignment", newNode.Name)
-			return h.eIPC.deleteEgressNode(oldNode.Name)
+			return h.eIPC.deleteEgressNode(oldNode.Name, "")
 		}
 		isOldReady := h.eIPC.isEgressNodeReady(oldNode)
 		isNewReady := h.eIPC.isEgressNodeReady(newNode)
@@ -144,7 +144,7 @@ func (h *egressIPClusterControllerEventHandler) UpdateResource(oldObj, newObj in
 		}
 		if !isNewReady {
 			klog.Warningf("Node: %s is not ready, deleting it from egress assignment", newNode.Name)
-			if err := h.eIPC.deleteEgressNode(newNode.Name); err != nil {
+			if err := h.eIPC.deleteEgressNode(newNode.Name, egressNodeNotReadyReason); err != nil {
 				return err
 			}
 		} else if isNewReady && isNewReachable {
@@ -188,7 +188,7 @@ func (h *egressIPClusterControllerEventHandler) DeleteResource(obj, _ interface{
 		nodeLabels := node.GetLabels()
 		_, hasEgressLabel := nodeLabels[nodeEgressLabel]
 		if hasEgressLabel {
-			if err := h.eIPC.deleteEgressNode(node.Name); err != nil {
+			if err := h.eIPC.deleteEgressNode(node.Name, ""); err != nil {
 				return err
 			}
 		}
@@ -0,0 +1,142 @@
+package clustermanager
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
+)
+
+// NetworkCapacityReporter reports the usage of the network subnets along with
+// a condition warning about their exhaustion.
+type NetworkCapacityReporter func(networkName string, fieldManager string, capacity []userdefinednetworkv1.SubnetCapacity, condition *metav1.Condition) error
+
+const (
+	// networkCapacityReportInterval is how often the network capacity is
+	// refreshed
+	networkCapacityReportInterval = 30 * time.Second
+
+	// networkCapacityFieldManager owns the capacity status fields. It has to
+	// differ from the field manager of the other conditions, otherwise they
+	// would be dropped when the capacity is applied.
+	networkCapacityFieldManager = "NetworkCapacityReporter"
+
+	networkCapacityConditionType = "NetworkCapacitySufficient"
+)
+
+// getNetworkCapacity returns the number of available and used host subnets and
+// pod IPs of each of the network subnets, for the resources allocated by the
+// cluster manager. Pod IPs are only allocated by the cluster manager when
+// interconnect is enabled, so their usage is not reported otherwise.
+func (ncc *networkClusterController) getNetworkCapacity() ([]userdefinednetworkv1.SubnetCapacity, error) {
+	var capacity []userdefinednetworkv1.SubnetCapacity
+	if ncc.nodeAllocator != nil {
+		for _, usage := range ncc.nodeAllocator.HostSubnetsUsage() {
+			capacity = append(capacity, userdefinednetworkv1.SubnetCapacity{
+				CIDR: userdefinednetworkv1.CIDR(usage.Network.String()),
+				HostSubnets: &userdefinednetworkv1.CapacityUsage{
+					Total: int64(usage.Count),
+					Used:  int64(usage.Used),
+				},
+			})
+		}
+	}
+	if ncc.subnetAllocator != nil {
+		subnetsUsage, err := ncc.subnetAllocator.GetSubnetsUsage(ncc.GetNetworkName())
+		if err != nil {
+			return nil, err
+		}
+		for _, usage := range subnetsUsage {
+			capacity = append(capacity, userdefinednetworkv1.SubnetCapacity{
+				CIDR: userdefinednetworkv1.CIDR(usage.Subnet.String()),
+				IPs: &userdefinednetworkv1.CapacityUsage{
+					Total: int64(usage.Total),
+					Used:  int64(usage.Used),
+				},
+			})
+		}
+	}
+	return capacity, nil
+}
+
+// reportNetworkCapacity records the network capacity metrics and, for networks
+// created by a UDN, reports the capacity in its status if it changed since last
+// reported.
+func (ncc *networkClusterController) reportNetworkCapacity() {
+	netName := ncc.GetNetworkName()
+	capacity, err := ncc.getNetworkCapacity()
+	if err != nil {
+		klog.Errorf("Failed to get the capacity of network %s: %v", netName, err)
+		return
+	}
+
+	for _, subnet := range capacity {
+		if subnet.HostSubnets != nil {
+			metrics.RecordClusterSubnetHostSubnetUsage(float64(subnet.HostSubnets.Total), float64(subnet.HostSubnets.Used), netName, string(subnet.CIDR))
+		}
+		if subnet.IPs != nil {
+			metrics.RecordPodIPUsage(float64(subnet.IPs.Total), float64(subnet.IPs.Used), netName, string(subnet.CIDR))
+		}
+	}
+
+	if ncc.capacityReporter == nil || len(capacity) == 0 {
+		return
+	}
+	if ncc.reportedCondition != nil && reflect.DeepEqual(ncc.reportedCapacity, capacity) {
+		return
+	}
+
+	condition := getNetworkCapacityUDNCondition(capacity, config.ClusterManager.NetworkCapacityWarningThreshold)
+	if ncc.reportedCondition != nil && ncc.reportedCondition.Status == condition.Status {
+		condition.LastTransitionTime = ncc.reportedCondition.LastTransitionTime
+	}
+	if err := ncc.capacityReporter(netName, networkCapacityFieldManager, capacity, condition); err != nil {
+		klog.Errorf("Failed to report the capacity of network %s: %v", netName, err)
+		return
+	}
+	ncc.reportedCapacity = capacity
+	ncc.reportedCondition = condition
+}
+
+// getNetworkCapacityUDNCondition returns a condition that is false when the
+// host subnets or pod IPs in use of any of the network subnets are above the
+// given percentage.
+func getNetworkCapacityUDNCondition(capacity []userdefinednetworkv1.SubnetCapacity, threshold int) *metav1.Condition {
+	var exceeded []string
+	for _, subnet := range capacity {
+		if isAboveThreshold(subnet.HostSubnets, threshold) {
+			exceeded = append(exceeded, fmt.Sprintf("%s host subnets %d/%d", subnet.CIDR, subnet.HostSubnets.Used, subnet.HostSubnets.Total))
+		}
+		if isAboveThreshold(subnet.IPs, threshold) {
+			exceeded = append(exceeded, fmt.Sprintf("%s IPs %d/%d", subnet.CIDR, subnet.IPs.Used, subnet.IPs.Total))
+		}
+	}
+	condition := &metav1.Condition{
+		Type:               networkCapacityConditionType,
+		LastTransitionTime: metav1.Now(),
+	}
+	if len(exceeded) == 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "CapacityAvailable"
+		condition.Message = fmt.Sprintf("Network subnets usage is below %d%%.", threshold)
+	} else {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "CapacityThresholdExceeded"
+		condition.Message = fmt.Sprintf("Network subnets usage is above %d%%: %s.", threshold, strings.Join(exceeded, ", "))
+	}
+	return condition
+}
+
+func isAboveThreshold(usage *userdefinednetworkv1.CapacityUsage, threshold int) bool {
+	if usage == nil || usage.Total == 0 {
+		return false
+	}
+	return float64(usage.Used)*100 > float64(usage.Total)*float64(threshold)
+}
@@ -13,6 +13,8 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	appslisters "k8s.io/client-go/listers/apps/v1"
 	cache "k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
@@ -24,8 +26,10 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/node"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/pod"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/persistentips"
 	objretry "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/retry"
@@ -79,6 +83,12 @@ type networkClusterController struct {
 	// To avoid changing that error report with every update, we store reported error node.
 	reportedErrorNode string
 
+	capacityReporter NetworkCapacityReporter
+	// last capacity and condition reported, only accessed by the capacity
+	// reporting goroutine
+	reportedCapacity  []userdefinednetworkv1.SubnetCapacity
+	reportedCondition *metav1.Condition
+
 	util.ReconcilableNetInfo
 }
 
@@ -229,11 +239,16 @@ func (ncc *networkClusterController) init() error {
 		)
 
 		if ncc.allowPersistentIPs() {
+			var statefulSetLister appslisters.StatefulSetLister
+			if util.IsStatefulSetPersistentIPsEnabled() {
+				statefulSetLister = ncc.watchFactory.StatefulSetCoreInformer().Lister()
+			}
 			ncc.retryIPAMClaims = ncc.newRetryFramework(factory.IPAMClaimsType, true)
 			ncc.ipamClaimReconciler = persistentips.NewIPAMClaimReconciler(
 				ncc.kube,
 				ncc.GetNetInfo(),
 				ncc.watchFactory.IPAMClaimsInformer().Lister(),
+				statefulSetLister,
 			)
 			ipamClaimsReconciler = ncc.ipamClaimReconciler
 		}
@@ -417,6 +432,12 @@ func (ncc *networkClusterController) Start(_ context.Context) error {
 		klog.Infof("Cluster manager network controller %q completed watch Pods. Took: %v", ncc.GetNetworkName(), time.Since(start))
 	}
 
+	ncc.wg.Add(1)
+	go func() {
+		defer ncc.wg.Done()
+		wait.Until(ncc.reportNetworkCapacity, networkCapacityReportInterval, ncc.stopChan)
+	}()
+
 	return nil
 }
 
@@ -435,6 +456,8 @@ func (ncc *networkClusterController) Stop() {
 	if ncc.podHandler != nil {
 		ncc.watchFactory.RemovePodHandler(ncc.podHandler)
 	}
+
+	metrics.DeleteNetworkCapacityMetrics(ncc.GetNetworkName())
 }
 
 func (ncc *networkClusterController) newRetryFramework(objectType reflect.Type, hasUpdateFunc bool) *objretry.RetryFramework {
@@ -479,9 +502,41 @@ func (ncc *networkClusterController) Reconcile(netInfo util.NetInfo) error {
 			klog.Errorf("Failed to requeue pending pods for network %s: %v", ncc.GetNetworkName(), err)
 		}
 	}
+	if ncc.nodeAllocator != nil {
+		added, err := ncc.nodeAllocator.SyncNetworkRanges()
+		if err != nil {
+			klog.Errorf("Failed to add the subnets appended to network %s: %v", ncc.GetNetworkName(), err)
+		}
+		if added {
+			if err := ncc.requeueNodesPendingAllocation(); err != nil {
+				klog.Errorf("Failed to requeue nodes pending allocation for network %s: %v", ncc.GetNetworkName(), err)
+			}
+		}
+	}
 	return nil
 }
 
+// requeueNodesPendingAllocation retries the nodes that could not be allocated
+// a subnet, now that more subnets are available.
+func (ncc *networkClusterController) requeueNodesPendingAllocation() error {
+	nodes, err := ncc.watchFactory.GetNodes()
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, node := range nodes {
+		if !ncc.nodeAllocator.NeedsNodeAllocation(node) {
+			continue
+		}
+		klog.V(5).Infof("Adding node %s pending allocation to retryNodes for network %s", node.Name, ncc.GetNetworkName())
+		if err := ncc.retryNodes.AddRetryObjWithAddNoBackoff(node); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	ncc.retryNodes.RequestRetryObjs()
+	return errors.Join(errs...)
+}
+
 // networkClusterControllerEventHandler object handles the events
 // from retry framework.
 type networkClusterControllerEventHandler struct {
@@ -771,7 +826,7 @@ func (h *networkClusterControllerEventHandler) clearInitialNodeNetworkUnavailabl
 }
 
 // newIPAllocatorForNetwork returns an initialized subnet allocator for the
-// subnets / excluded subnets provided in `netInfo`
+// subnets / excluded subnets / reserved subnets provided in `netInfo`
 func newIPAllocatorForNetwork(netInfo util.NetInfo) (subnet.Allocator, error) {
 	ipAllocator := subnet.NewAllocator()
 
@@ -796,6 +851,10 @@ func newIPAllocatorForNetwork(netInfo util.NetInfo) (subnet.Allocator, error) {
 		return nil, err
 	}
 
+	if err := ipAllocator.ReserveSubnets(netInfo.GetNetworkName(), netInfo.ReservedSubnets()...); err != nil {
+		return nil, err
+	}
+
 	return ipAllocator, nil
 }
 
@@ -15,6 +15,7 @@ import (
 	"k8s.io/client-go/tools/record"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
 	ovntypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
@@ -46,6 +47,7 @@ var _ = ginkgo.Describe("Network Cluster Controller", func() {
 		close(stopChan)
 		if f != nil {
 			f.Shutdown()
+			f = nil
 		}
 		wg.Wait()
 	})
@@ -206,4 +208,73 @@ var _ = ginkgo.Describe("Network Cluster Controller", func() {
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
 		})
 	})
+
+	ginkgo.Context("Network capacity", func() {
+		ginkgo.It("reports the host subnets usage when it changes", func() {
+			app.Action = func(ctx *cli.Context) error {
+				node := &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "node1",
+						Annotations: map[string]string{
+							ovnNodeIDAnnotaton: "3",
+						},
+					},
+				}
+				fakeClient := &util.OVNClusterManagerClientset{
+					KubeClient: fake.NewSimpleClientset(node),
+				}
+
+				_, err := config.InitConfig(ctx, nil, nil)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				config.Kubernetes.HostNetworkNamespace = ""
+
+				f, err = factory.NewClusterManagerWatchFactory(fakeClient)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(f.Start()).To(gomega.Succeed())
+
+				var reported [][]udnv1.SubnetCapacity
+				var reportedConditions []*metav1.Condition
+				ncc := newDefaultNetworkClusterController(&util.DefaultNetInfo{}, fakeClient, f, recorder)
+				ncc.capacityReporter = func(_, fieldManager string, capacity []udnv1.SubnetCapacity, condition *metav1.Condition) error {
+					gomega.Expect(fieldManager).To(gomega.Equal(networkCapacityFieldManager))
+					reported = append(reported, capacity)
+					reportedConditions = append(reportedConditions, condition)
+					return nil
+				}
+				gomega.Expect(ncc.init()).To(gomega.Succeed())
+
+				ncc.reportNetworkCapacity()
+				gomega.Expect(ncc.nodeAllocator.HandleAddUpdateNodeEvent(node)).To(gomega.Succeed())
+				ncc.reportNetworkCapacity()
+				// no change, nothing reported
+				ncc.reportNetworkCapacity()
+
+				gomega.Expect(reported).To(gomega.Equal([][]udnv1.SubnetCapacity{
+					{{CIDR: "10.128.0.0/14", HostSubnets: &udnv1.CapacityUsage{Total: 512, Used: 0}}},
+					{{CIDR: "10.128.0.0/14", HostSubnets: &udnv1.CapacityUsage{Total: 512, Used: 1}}},
+				}))
+				gomega.Expect(reportedConditions).To(gomega.HaveLen(2))
+				gomega.Expect(reportedConditions[1].Status).To(gomega.Equal(metav1.ConditionTrue))
+				gomega.Expect(reportedConditions[1].LastTransitionTime).To(gomega.Equal(reportedConditions[0].LastTransitionTime))
+				return nil
+			}
+
+			err := app.Run([]string{
+				app.Name,
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("warns when the usage of a subnet is above the threshold", func() {
+			condition := getNetworkCapacityUDNCondition([]udnv1.SubnetCapacity{
+				{CIDR: "10.128.0.0/14", HostSubnets: &udnv1.CapacityUsage{Total: 512, Used: 400}},
+				{CIDR: "10.200.0.0/16", IPs: &udnv1.CapacityUsage{Total: 100, Used: 81}},
+				{CIDR: "10.201.0.0/16", IPs: &udnv1.CapacityUsage{Total: 100, Used: 80}},
+			}, 80)
+			gomega.Expect(condition.Type).To(gomega.Equal("NetworkCapacitySufficient"))
+			gomega.Expect(condition.Status).To(gomega.Equal(metav1.ConditionFalse))
+			gomega.Expect(condition.Reason).To(gomega.Equal("CapacityThresholdExceeded"))
+			gomega.Expect(condition.Message).To(gomega.Equal("Network subnets usage is above 80%: 10.200.0.0/16 IPs 81/100."))
+		})
+	})
 })
@@ -3,12 +3,16 @@ package node
 import (
 	"fmt"
 	"net"
+	"strconv"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
@@ -94,13 +98,8 @@ func (na *NodeAllocator) Init() error {
 		return nil
 	}
 
-	clusterSubnets := na.netInfo.Subnets()
-
-	for _, clusterSubnet := range clusterSubnets {
-		if err := na.clusterSubnetAllocator.AddNetworkRange(clusterSubnet.CIDR, clusterSubnet.HostSubnetLength); err != nil {
-			return err
-		}
-		klog.V(5).Infof("Added network range %s to cluster subnet allocator", clusterSubnet.CIDR)
+	if _, err := na.addNetworkRanges(); err != nil {
+		return err
 	}
 
 	if na.hasHybridOverlayAllocation() {
@@ -118,6 +117,44 @@ func (na *NodeAllocator) Init() error {
 	return nil
 }
 
+// SyncNetworkRanges makes the subnets appended to the network since the
+// allocator was initialized available for allocation. It returns whether any
+// subnet was added.
+func (na *NodeAllocator) SyncNetworkRanges() (bool, error) {
+	if !na.hasNodeSubnetAllocation() {
+		return false, nil
+	}
+	added, err := na.addNetworkRanges()
+	if err != nil {
+		return false, err
+	}
+	if added {
+		na.recordSubnetCount()
+	}
+	return added, nil
+}
+
+// addNetworkRanges adds the network subnets that are not yet known to the
+// cluster subnet allocator
+func (na *NodeAllocator) addNetworkRanges() (bool, error) {
+	known := sets.New[string]()
+	for _, usage := range na.clusterSubnetAllocator.RangesUsage() {
+		known.Insert(usage.Network.String())
+	}
+	var added bool
+	for _, clusterSubnet := range na.netInfo.Subnets() {
+		if known.Has(clusterSubnet.CIDR.String()) {
+			continue
+		}
+		if err := na.clusterSubnetAllocator.AddNetworkRange(clusterSubnet.CIDR, clusterSubnet.HostSubnetLength); err != nil {
+			return added, err
+		}
+		added = true
+		klog.V(5).Infof("Added network range %s to cluster subnet allocator", clusterSubnet.CIDR)
+	}
+	return added, nil
+}
+
 func (na *NodeAllocator) hasHybridOverlayAllocation() bool {
 	// When config.HybridOverlay.ClusterSubnets is empty, assume the subnet allocation will be managed by an external component.
 	return config.HybridOverlay.Enabled && !na.netInfo.IsSecondary() && len(config.HybridOverlay.ClusterSubnets) > 0
@@ -139,6 +176,15 @@ func (na *NodeAllocator) recordSubnetUsage() {
 	}
 }
 
+// HostSubnetsUsage returns the number of available and allocated host subnets
+// of each cluster subnet, or nil if the network has no host subnets
+func (na *NodeAllocator) HostSubnetsUsage() []RangeUsage {
+	if !na.hasNodeSubnetAllocation() {
+		return nil
+	}
+	return na.clusterSubnetAllocator.RangesUsage()
+}
+
 // hybridOverlayNodeEnsureSubnet allocates a subnet and sets the
 // hybrid overlay subnet annotation. It returns any newly allocated subnet
 // or an error. If an error occurs, the newly allocated subnet will be released.
@@ -155,7 +201,7 @@ func (na *NodeAllocator) hybridOverlayNodeEnsureSubnet(node *corev1.Node, annota
 
 	// Allocate a new host subnet for this node
 	// FIXME: hybrid overlay is only IPv4 for now due to limitations on the Windows side
-	hostSubnets, allocatedSubnets, err := na.allocateNodeSubnets(na.hybridOverlaySubnetAllocator, node.Name, existingSubnets, true, false)
+	hostSubnets, allocatedSubnets, err := na.allocateNodeSubnets(na.hybridOverlaySubnetAllocator, node.Name, existingSubnets, true, false, 0)
 	if err != nil {
 		return nil, fmt.Errorf("error allocating hybrid overlay HostSubnet for node %s: %v", node.Name, err)
 	}
@@ -310,7 +356,7 @@ func (na *NodeAllocator) syncNodeNetworkAnnotations(node *corev1.Node) error {
 		// any newly allocated subnets required to ensure that the node has one subnet
 		// from each enabled IP family.
 		ipv4Mode, ipv6Mode := na.netInfo.IPMode()
-		validExistingSubnets, allocatedSubnets, err = na.allocateNodeSubnets(na.clusterSubnetAllocator, node.Name, existingSubnets, ipv4Mode, ipv6Mode)
+		validExistingSubnets, allocatedSubnets, err = na.allocateNodeSubnets(na.clusterSubnetAllocator, node.Name, existingSubnets, ipv4Mode, ipv6Mode, na.getNodeHostSubnetLength(node))
 		if err != nil {
 			return err
 		}
@@ -516,9 +562,73 @@ func (na *NodeAllocator) Cleanup() error {
 	return nil
 }
 
+// getNodeHostSubnetLength returns the prefix length of the IPv4 host subnet
+// requested by the node through its label, or 0 to use the one of the cluster
+// subnets. Only nodes of the default network can request it.
+func (na *NodeAllocator) getNodeHostSubnetLength(node *corev1.Node) int {
+	if !na.netInfo.IsDefault() {
+		return 0
+	}
+	value, ok := node.Labels[types.HostSubnetLengthLabel]
+	if !ok {
+		return 0
+	}
+	hostSubnetLen, err := strconv.Atoi(value)
+	if err != nil || hostSubnetLen <= 0 || hostSubnetLen > types.MaxHostSubnetLength {
+		klog.Warningf("Ignoring invalid %s label %q of node %s: expected a prefix length up to %d",
+			types.HostSubnetLengthLabel, value, node.Name, types.MaxHostSubnetLength)
+		return 0
+	}
+	return hostSubnetLen
+}
+
+// mustReallocateNodeSubnet returns whether the existing IPv4 subnet of the node
+// must be replaced by one of the requested prefix length. Replacing it would
+// disrupt the pods of the node, so it is only done once none is left on the
+// pod network.
+func (na *NodeAllocator) mustReallocateNodeSubnet(nodeName string, subnet *net.IPNet, v4HostSubnetLen int) bool {
+	if prefixLen, _ := subnet.Mask.Size(); v4HostSubnetLen == 0 || prefixLen == v4HostSubnetLen {
+		return false
+	}
+	drained, err := na.isNodeDrained(nodeName)
+	if err != nil {
+		klog.Warningf("Node %s keeps its existing subnet %v on network %s: %v",
+			nodeName, subnet, na.netInfo.GetNetworkName(), err)
+		return false
+	}
+	if !drained {
+		klog.Warningf("Node %s keeps its existing subnet %v on network %s instead of the requested /%d one until it is drained",
+			nodeName, subnet, na.netInfo.GetNetworkName(), v4HostSubnetLen)
+		return false
+	}
+	klog.Infof("Replacing the existing subnet %v of drained node %s on network %s with a /%d one",
+		subnet, nodeName, na.netInfo.GetNetworkName(), v4HostSubnetLen)
+	return true
+}
+
+// isNodeDrained returns whether no pod attached to the pod network is left on
+// the node
+func (na *NodeAllocator) isNodeDrained(nodeName string) (bool, error) {
+	pods, err := na.kube.GetPods(metav1.NamespaceAll, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to list the pods of node %s: %w", nodeName, err)
+	}
+	for _, pod := range pods {
+		if pod.Spec.NodeName == nodeName && !util.PodWantsHostNetwork(pod) && !util.PodCompleted(pod) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // allocateNodeSubnets either validates existing node subnets against the allocators
-// ranges, or allocates new subnets if the node doesn't have any yet, or returns an error
-func (na *NodeAllocator) allocateNodeSubnets(allocator SubnetAllocator, nodeName string, existingSubnets []*net.IPNet, ipv4Mode, ipv6Mode bool) ([]*net.IPNet, []*net.IPNet, error) {
+// ranges, or allocates new subnets if the node doesn't have any yet, or returns an error.
+// New IPv4 subnets have the given prefix length, or the host subnet length of
+// the ranges if 0. Existing IPv4 subnets of another length are only replaced
+// once the node is drained.
+func (na *NodeAllocator) allocateNodeSubnets(allocator SubnetAllocator, nodeName string, existingSubnets []*net.IPNet, ipv4Mode, ipv6Mode bool, v4HostSubnetLen int) ([]*net.IPNet, []*net.IPNet, error) {
 	allocatedSubnets := []*net.IPNet{}
 
 	// OVN can work in single-stack or dual-stack only.
@@ -538,7 +648,8 @@ func (na *NodeAllocator) allocateNodeSubnets(allocator SubnetAllocator, nodeName
 	foundIPv6 := false
 	n := 0
 	for _, subnet := range existingSubnets {
-		if (ipv4Mode && utilnet.IsIPv4CIDR(subnet) && !foundIPv4) || (ipv6Mode && utilnet.IsIPv6CIDR(subnet) && !foundIPv6) {
+		if (ipv4Mode && utilnet.IsIPv4CIDR(subnet) && !foundIPv4 && !na.mustReallocateNodeSubnet(nodeName, subnet, v4HostSubnetLen)) ||
+			(ipv6Mode && utilnet.IsIPv6CIDR(subnet) && !foundIPv6) {
 			if err := allocator.MarkAllocatedNetworks(nodeName, subnet); err == nil {
 				existingSubnets[n] = subnet
 				n++
@@ -598,7 +709,13 @@ func (na *NodeAllocator) allocateNodeSubnets(allocator SubnetAllocator, nodeName
 
 	// allocate new subnets if needed
 	if ipv4Mode && !foundIPv4 {
-		if err := allocateOneSubnet(allocator.AllocateIPv4Network(nodeName)); err != nil {
+		allocate := allocator.AllocateIPv4Network
+		if v4HostSubnetLen != 0 {
+			allocate = func(owner string) (*net.IPNet, error) {
+				return allocator.AllocateIPv4NetworkWithLength(owner, v4HostSubnetLen)
+			}
+		}
+		if err := allocateOneSubnet(allocate(nodeName)); err != nil {
 			return nil, nil, err
 		}
 	}
@@ -8,8 +8,14 @@ import (
 
 	cnitypes "github.com/containernetworking/cni/pkg/types"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
 	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
 	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -30,6 +36,13 @@ func rangesFromStrings(ranges []string, networkLens []int) ([]config.CIDRNetwork
 	return entries, nil
 }
 
+func newNodePod(name, nodeName string, hostNetwork bool) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       corev1.PodSpec{NodeName: nodeName, HostNetwork: hostNetwork},
+	}
+}
+
 type existingAllocation struct {
 	subnet string
 	owner  string
@@ -44,6 +57,10 @@ func TestController_allocateNodeSubnets(t *testing.T) {
 		configIPv6    bool
 		existingNets  []*net.IPNet
 		alreadyOwned  *existingAllocation
+		// IPv4 host subnet length requested by the node
+		hostSubnetLen int
+		// pods running on the node
+		nodePods []runtime.Object
 		// to be converted during the test to []*net.IPNet
 		wantStr   []string
 		allocated int
@@ -180,6 +197,83 @@ func TestController_allocateNodeSubnets(t *testing.T) {
 			wantStr:       []string{"172.16.0.0/24"},
 			allocated:     1,
 		},
+		{
+			name:          "new node requesting a longer host subnet, IPv4 only cluster",
+			networkRanges: []string{"172.16.0.0/16"},
+			networkLens:   []int{24},
+			configIPv4:    true,
+			configIPv6:    false,
+			hostSubnetLen: 26,
+			alreadyOwned: &existingAllocation{
+				owner:  "another-node",
+				subnet: "172.16.0.0/24",
+			},
+			wantStr:   []string{"172.16.1.0/26"},
+			allocated: 1,
+		},
+		{
+			name:          "new node requesting a shorter host subnet, dual stack cluster",
+			networkRanges: []string{"172.16.0.0/16", "2001:db2:1::/56"},
+			networkLens:   []int{24, 64},
+			configIPv4:    true,
+			configIPv6:    true,
+			hostSubnetLen: 22,
+			alreadyOwned: &existingAllocation{
+				owner:  "another-node",
+				subnet: "172.16.254.0/24",
+			},
+			wantStr:   []string{"172.16.248.0/22", "2001:db2:1::/64"},
+			allocated: 2,
+		},
+		{
+			name:          "existing annotated node requesting another host subnet length keeps its subnet until drained",
+			networkRanges: []string{"172.16.0.0/16"},
+			networkLens:   []int{24},
+			configIPv4:    true,
+			configIPv6:    false,
+			existingNets:  ovntest.MustParseIPNets("172.16.3.0/24"),
+			hostSubnetLen: 22,
+			nodePods: []runtime.Object{
+				newNodePod("host-network-pod", "testnode", true),
+				newNodePod("pod", "testnode", false),
+			},
+			wantStr:   []string{"172.16.3.0/24"},
+			allocated: 0,
+		},
+		{
+			name:          "drained annotated node requesting another host subnet length gets a new subnet",
+			networkRanges: []string{"172.16.0.0/16"},
+			networkLens:   []int{24},
+			configIPv4:    true,
+			configIPv6:    false,
+			existingNets:  ovntest.MustParseIPNets("172.16.3.0/24"),
+			hostSubnetLen: 22,
+			nodePods: []runtime.Object{
+				newNodePod("host-network-pod", "testnode", true),
+				newNodePod("pod", "othernode", false),
+			},
+			wantStr:   []string{"172.16.252.0/22"},
+			allocated: 1,
+		},
+		{
+			name:          "existing annotated node with a longer host subnet",
+			networkRanges: []string{"172.16.0.0/16"},
+			networkLens:   []int{24},
+			configIPv4:    true,
+			configIPv6:    false,
+			existingNets:  ovntest.MustParseIPNets("172.16.3.64/26"),
+			wantStr:       []string{"172.16.3.64/26"},
+			allocated:     0,
+		},
+		{
+			name:          "new node requesting a host subnet too short for the cluster subnet",
+			networkRanges: []string{"172.16.0.0/16"},
+			networkLens:   []int{24},
+			configIPv4:    true,
+			configIPv6:    false,
+			hostSubnetLen: 16,
+			wantErr:       true,
+		},
 		{
 			name:          "existing annotated node with too many subnets",
 			networkRanges: []string{"172.16.0.0/16", "2001:db2:1::/56"},
@@ -226,6 +320,7 @@ func TestController_allocateNodeSubnets(t *testing.T) {
 			na := &NodeAllocator{
 				netInfo:                netInfo,
 				clusterSubnetAllocator: NewSubnetAllocator(),
+				kube:                   &kube.Kube{KClient: fake.NewSimpleClientset(tt.nodePods...)},
 			}
 
 			if err := na.Init(); err != nil {
@@ -240,7 +335,7 @@ func TestController_allocateNodeSubnets(t *testing.T) {
 			}
 
 			// test network allocation works correctly
-			got, allocated, err := na.allocateNodeSubnets(na.clusterSubnetAllocator, "testnode", tt.existingNets, tt.configIPv4, tt.configIPv6)
+			got, allocated, err := na.allocateNodeSubnets(na.clusterSubnetAllocator, "testnode", tt.existingNets, tt.configIPv4, tt.configIPv6, tt.hostSubnetLen)
 			if (err != nil) != tt.wantErr {
 				t.Fatalf("Controller.addNode() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -304,7 +399,7 @@ func TestController_allocateNodeSubnets_ReleaseOnError(t *testing.T) {
 
 	// test network allocation works correctly
 	v4usedBefore, v6usedBefore := na.clusterSubnetAllocator.Usage()
-	got, allocated, err := na.allocateNodeSubnets(na.clusterSubnetAllocator, "testNode", nil, true, true)
+	got, allocated, err := na.allocateNodeSubnets(na.clusterSubnetAllocator, "testNode", nil, true, true, 0)
 	if err == nil {
 		t.Fatalf("allocateNodeSubnets() expected error but got success")
 	}
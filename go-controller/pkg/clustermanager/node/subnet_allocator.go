@@ -3,6 +3,7 @@ package node
 import (
 	"fmt"
 	"net"
+	"slices"
 	"sync"
 
 	"k8s.io/klog/v2"
@@ -13,6 +14,13 @@ import (
 
 var ErrSubnetAllocatorFull = fmt.Errorf("no subnets available")
 
+// RangeUsage reports how many of the subnets of a network range are allocated
+type RangeUsage struct {
+	Network *net.IPNet
+	Count   uint64
+	Used    uint64
+}
+
 type SubnetAllocator interface {
 	AddNetworkRange(network *net.IPNet, hostSubnetLen int) error
 	MarkAllocatedNetworks(string, ...*net.IPNet) error
@@ -20,8 +28,15 @@ type SubnetAllocator interface {
 	Usage() (uint64, uint64)
 	// Count returns the number available (both used and unused) v4 and v6 subnets
 	Count() (uint64, uint64)
+	// RangesUsage returns the number of available and used subnets of each
+	// network range
+	RangesUsage() []RangeUsage
 	AllocateNetworks(string) ([]*net.IPNet, error)
 	AllocateIPv4Network(string) (*net.IPNet, error)
+	// AllocateIPv4NetworkWithLength tries to allocate an IPv4 network with
+	// the given prefix length instead of the host subnet length of the
+	// ranges
+	AllocateIPv4NetworkWithLength(string, int) (*net.IPNet, error)
 	AllocateIPv6Network(string) (*net.IPNet, error)
 	// ReleaseNetworks releases the given networks if they are owned by the
 	// given owner
@@ -73,6 +88,26 @@ func (sna *BaseSubnetAllocator) Count() (uint64, uint64) {
 	return v4count, v6count
 }
 
+// RangesUsage returns the number of available and used subnets of each network
+// range, v4 ranges first
+func (sna *BaseSubnetAllocator) RangesUsage() []RangeUsage {
+	sna.Lock()
+	defer sna.Unlock()
+
+	usage := make([]RangeUsage, 0, len(sna.v4ranges)+len(sna.v6ranges))
+	for _, ranges := range [][]*subnetAllocatorRange{sna.v4ranges, sna.v6ranges} {
+		for _, snr := range ranges {
+			network := *snr.network
+			usage = append(usage, RangeUsage{
+				Network: &network,
+				Count:   snr.count(),
+				Used:    snr.usage(),
+			})
+		}
+	}
+	return usage
+}
+
 // AddNetworkRange makes the given range available for allocation and returns
 // nil, or an error on failure.
 func (sna *BaseSubnetAllocator) AddNetworkRange(network *net.IPNet, hostSubnetLen int) error {
@@ -176,6 +211,31 @@ func (sna *BaseSubnetAllocator) AllocateIPv4Network(owner string) (*net.IPNet, e
 	return nil, ErrSubnetAllocatorFull
 }
 
+// AllocateIPv4NetworkWithLength tries to allocate an IPv4 network with the given
+// prefix length from the ranges it fits in
+func (sna *BaseSubnetAllocator) AllocateIPv4NetworkWithLength(owner string, hostSubnetLen int) (*net.IPNet, error) {
+	sna.Lock()
+	defer sna.Unlock()
+	if len(sna.v4ranges) == 0 {
+		return nil, nil
+	}
+	fits := false
+	for _, snr := range sna.v4ranges {
+		if !snr.fits(hostSubnetLen) {
+			continue
+		}
+		fits = true
+		sn := snr.allocateNetworkWithLength(owner, hostSubnetLen)
+		if sn != nil {
+			return sn, nil
+		}
+	}
+	if !fits {
+		return nil, fmt.Errorf("no network range can provide a /%d network", hostSubnetLen)
+	}
+	return nil, ErrSubnetAllocatorFull
+}
+
 // AllocateIPv6Network tries to allocate an IPv6 network if there are ranges available
 func (sna *BaseSubnetAllocator) AllocateIPv6Network(owner string) (*net.IPNet, error) {
 	sna.Lock()
@@ -253,14 +313,20 @@ func (sna *BaseSubnetAllocator) releaseAllNetworks(owner string) {
 	}
 }
 
-// subnetAllocatorRange handles allocating subnets out of a single CIDR
+// subnetAllocatorRange handles allocating subnets out of a single CIDR. Most
+// subnets have the host subnet length of the range, but subnets of other
+// lengths can be allocated as well: those are tracked in sizedSubnets so that
+// the overlaps can be checked without going through all the allocations.
 type subnetAllocatorRange struct {
-	network    *net.IPNet
-	hostBits   uint32
-	subnetBits uint32
-	next       uint32
-	allocMap   map[string]string
-	used       uint32
+	network      *net.IPNet
+	hostBits     uint32
+	subnetBits   uint32
+	next         uint32
+	allocMap     map[string]string
+	sizedSubnets []*net.IPNet
+	// used is in host subnets of the length of the range: a longer subnet
+	// counts as a whole one, a shorter one as all the ones it contains
+	used uint32
 
 	// IPv4-only address-alignment hackery; see below
 	leftShift  uint32
@@ -344,31 +410,137 @@ func IsAlreadyOwnedError(err error) bool {
 	return ok
 }
 
+// hostSubnetLen returns the host subnet length of the range
+func (snr *subnetAllocatorRange) hostSubnetLen() int {
+	_, addrLen := snr.network.Mask.Size()
+	return addrLen - int(snr.hostBits)
+}
+
+// fits returns whether subnets of the given prefix length can be allocated
+// out of the range
+func (snr *subnetAllocatorRange) fits(prefixLen int) bool {
+	clusterCIDRLen, addrLen := snr.network.Mask.Size()
+	return prefixLen > clusterCIDRLen && prefixLen < addrLen
+}
+
+// contains returns whether the network is part of the range
+func (snr *subnetAllocatorRange) contains(network *net.IPNet) bool {
+	prefixLen, _ := network.Mask.Size()
+	clusterCIDRLen, _ := snr.network.Mask.Size()
+	return snr.network.Contains(network.IP) && prefixLen >= clusterCIDRLen
+}
+
+// weight returns the number of host subnets of the length of the range the
+// network accounts for
+func (snr *subnetAllocatorRange) weight(network *net.IPNet) uint32 {
+	prefixLen, _ := network.Mask.Size()
+	if prefixLen >= snr.hostSubnetLen() {
+		return 1
+	}
+	return 1 << (snr.hostSubnetLen() - prefixLen)
+}
+
+// allocate records the network as allocated to the owner
+func (snr *subnetAllocatorRange) allocate(owner string, network *net.IPNet) {
+	snr.allocMap[network.String()] = owner
+	if prefixLen, _ := network.Mask.Size(); prefixLen != snr.hostSubnetLen() {
+		snr.sizedSubnets = append(snr.sizedSubnets, network)
+	}
+	snr.used += snr.weight(network)
+}
+
+// release records the network as no longer allocated
+func (snr *subnetAllocatorRange) release(network string) {
+	delete(snr.allocMap, network)
+	_, subnet, err := net.ParseCIDR(network)
+	if err != nil {
+		klog.Errorf("Failed to parse released subnet %s: %v", network, err)
+		return
+	}
+	snr.sizedSubnets = slices.DeleteFunc(snr.sizedSubnets, func(sized *net.IPNet) bool {
+		return sized.String() == network
+	})
+	snr.used -= snr.weight(subnet)
+}
+
+// overlappingOwner returns the owner of an allocated subnet overlapping with
+// the network, if any
+func (snr *subnetAllocatorRange) overlappingOwner(network *net.IPNet) (string, string, bool) {
+	if owner, ok := snr.allocMap[network.String()]; ok {
+		return network.String(), owner, true
+	}
+	for _, sized := range snr.sizedSubnets {
+		if sized.Contains(network.IP) || network.Contains(sized.IP) {
+			return sized.String(), snr.allocMap[sized.String()], true
+		}
+	}
+
+	// check the subnets of the length of the range either containing or
+	// contained in the network
+	prefixLen, addrLen := network.Mask.Size()
+	hostSubnetLen := snr.hostSubnetLen()
+	if prefixLen >= hostSubnetLen {
+		hostSubnet := &net.IPNet{IP: network.IP.Mask(net.CIDRMask(hostSubnetLen, addrLen)), Mask: net.CIDRMask(hostSubnetLen, addrLen)}
+		owner, ok := snr.allocMap[hostSubnet.String()]
+		return hostSubnet.String(), owner, ok
+	}
+	if hostSubnetLen-prefixLen > 16 {
+		// cheaper to go through the allocations than through the subnets
+		for str, owner := range snr.allocMap {
+			_, allocated, err := net.ParseCIDR(str)
+			if err == nil && network.Contains(allocated.IP) {
+				return str, owner, true
+			}
+		}
+		return "", "", false
+	}
+	hostSubnet := &net.IPNet{IP: network.IP, Mask: net.CIDRMask(hostSubnetLen, addrLen)}
+	for i := 0; i < 1<<(hostSubnetLen-prefixLen); i++ {
+		if owner, ok := snr.allocMap[hostSubnet.String()]; ok {
+			return hostSubnet.String(), owner, true
+		}
+		hostSubnet = &net.IPNet{IP: nextSubnetIP(hostSubnet.IP, hostSubnetLen), Mask: hostSubnet.Mask}
+	}
+	return "", "", false
+}
+
+// nextSubnetIP returns the IP of the subnet of the given prefix length
+// following the one of the given IP
+func nextSubnetIP(ip net.IP, prefixLen int) net.IP {
+	next := append(net.IP{}, ip...)
+	b := (prefixLen - 1) / 8
+	carry := uint16(1) << (7 - (prefixLen-1)%8)
+	for ; b >= 0 && carry != 0; b-- {
+		sum := uint16(next[b]) + carry
+		next[b] = byte(sum)
+		carry = sum >> 8
+	}
+	return next
+}
+
 // markAllocatedNetwork marks network as being in use, if it is part of snr's range.
 // It returns whether the network was in snr's range, and returns an error if
-// network was already allocated to a different owner.
+// network overlaps with a network already allocated to a different owner, or
+// to the same owner but with a different length.
 func (snr *subnetAllocatorRange) markAllocatedNetwork(owner string, network *net.IPNet) (bool, error) {
 	str := network.String()
-	if !snr.network.Contains(network.IP) {
+	if !snr.contains(network) {
 		return false, nil
 	}
 
-	existingOwner, ok := snr.allocMap[str]
+	existing, existingOwner, ok := snr.overlappingOwner(network)
 	if !ok {
-		snr.allocMap[str] = owner
-		snr.used++
+		snr.allocate(owner, network)
 		return true, nil
-	} else if existingOwner == owner {
+	} else if existingOwner == owner && existing == str {
 		return true, nil
 	}
 
-	return false, alreadyOwnedError{str, existingOwner}
+	return false, alreadyOwnedError{existing, existingOwner}
 }
 
-// allocateNetwork returns a new subnet, or nil if the range is full
-func (snr *subnetAllocatorRange) allocateNetwork(owner string) *net.IPNet {
-	// Return an already allocated subnet instead of creating a new one if a
-	// combination of subnet and node name already exists in the cache
+// ownedNetwork returns the subnet already allocated to the owner, if any
+func (snr *subnetAllocatorRange) ownedNetwork(owner string) *net.IPNet {
 	for nodeSubnet, nodeName := range snr.allocMap {
 		if nodeName == owner {
 			_, subnet, err := net.ParseCIDR(nodeSubnet)
@@ -379,6 +551,16 @@ func (snr *subnetAllocatorRange) allocateNetwork(owner string) *net.IPNet {
 			return subnet
 		}
 	}
+	return nil
+}
+
+// allocateNetwork returns a new subnet, or nil if the range is full
+func (snr *subnetAllocatorRange) allocateNetwork(owner string) *net.IPNet {
+	// Return an already allocated subnet instead of creating a new one if a
+	// combination of subnet and node name already exists in the cache
+	if subnet := snr.ownedNetwork(owner); subnet != nil {
+		return subnet
+	}
 	netMaskSize, addrLen := snr.network.Mask.Size()
 	numSubnets := uint32(1) << snr.subnetBits
 	if snr.subnetBits > 24 {
@@ -415,10 +597,9 @@ func (snr *subnetAllocatorRange) allocateNetwork(owner string) *net.IPNet {
 		}
 
 		genSubnet := &net.IPNet{IP: genIP, Mask: net.CIDRMask(int(snr.subnetBits)+netMaskSize, addrLen)}
-		if _, ok := snr.allocMap[genSubnet.String()]; !ok {
-			snr.allocMap[genSubnet.String()] = owner
+		if _, _, ok := snr.overlappingOwner(genSubnet); !ok {
+			snr.allocate(owner, genSubnet)
 			snr.next = n + 1
-			snr.used++
 			return genSubnet
 		}
 	}
@@ -427,6 +608,41 @@ func (snr *subnetAllocatorRange) allocateNetwork(owner string) *net.IPNet {
 	return nil
 }
 
+// allocateNetworkWithLength returns a new subnet of the given prefix length,
+// or nil if the range is full. To keep room for them, subnets shorter than
+// the host subnet length of the range are allocated from the end of the
+// range while the other ones are allocated from its start, packed together.
+func (snr *subnetAllocatorRange) allocateNetworkWithLength(owner string, prefixLen int) *net.IPNet {
+	if prefixLen == snr.hostSubnetLen() {
+		return snr.allocateNetwork(owner)
+	}
+	if subnet := snr.ownedNetwork(owner); subnet != nil {
+		return subnet
+	}
+
+	clusterCIDRLen, addrLen := snr.network.Mask.Size()
+	// same cap as allocateNetwork
+	numSubnets := uint32(1) << min(prefixLen-clusterCIDRLen, 24)
+	for i := uint32(0); i < numSubnets; i++ {
+		n := i
+		if prefixLen < snr.hostSubnetLen() {
+			n = numSubnets - 1 - i
+		}
+		genIP := append(net.IP{}, snr.network.IP...)
+		subnetBits := uint64(n) << ((addrLen - prefixLen) % 8)
+		for b := (prefixLen - 1) / 8; subnetBits != 0; b-- {
+			genIP[b] |= byte(subnetBits)
+			subnetBits >>= 8
+		}
+		genSubnet := &net.IPNet{IP: genIP, Mask: net.CIDRMask(prefixLen, addrLen)}
+		if _, _, ok := snr.overlappingOwner(genSubnet); !ok {
+			snr.allocate(owner, genSubnet)
+			return genSubnet
+		}
+	}
+	return nil
+}
+
 // releaseNetwork marks network as being not in use, if it is part of snr's range.
 // It returns whether the network was in snr's range.
 func (snr *subnetAllocatorRange) releaseNetwork(owner string, network *net.IPNet) (bool, error) {
@@ -439,8 +655,7 @@ func (snr *subnetAllocatorRange) releaseNetwork(owner string, network *net.IPNet
 	if !ok {
 		return false, nil
 	} else if existingOwner == owner {
-		delete(snr.allocMap, str)
-		snr.used--
+		snr.release(str)
 		return true, nil
 	}
 
@@ -451,8 +666,7 @@ func (snr *subnetAllocatorRange) releaseNetwork(owner string, network *net.IPNet
 func (snr *subnetAllocatorRange) releaseAllNetworks(owner string) {
 	for network, existingOwner := range snr.allocMap {
 		if existingOwner == owner {
-			delete(snr.allocMap, network)
-			snr.used--
+			snr.release(network)
 		}
 	}
 }
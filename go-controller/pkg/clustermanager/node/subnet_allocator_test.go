@@ -601,6 +601,40 @@ func TestDualStack(t *testing.T) {
 	}
 }
 
+func TestRangesUsage(t *testing.T) {
+	sna, err := newSubnetAllocator("fd01::/48", 64)
+	if err != nil {
+		t.Fatal("Failed to initialize subnet allocator: ", err)
+	}
+	for _, cidr := range []string{"10.1.0.0/16", "10.2.0.0/16"} {
+		if err := sna.AddNetworkRange(ovntest.MustParseIPNet(cidr), 18); err != nil {
+			t.Fatal("Failed to add network range: ", err)
+		}
+	}
+
+	for i := 0; i < 5; i++ {
+		if _, err := sna.AllocateNetworks(fmt.Sprintf("%s-%d", testNodeName, i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := []RangeUsage{
+		{Network: ovntest.MustParseIPNet("10.1.0.0/16"), Count: 4, Used: 4},
+		{Network: ovntest.MustParseIPNet("10.2.0.0/16"), Count: 4, Used: 1},
+		{Network: ovntest.MustParseIPNet("fd01::/48"), Count: 65536, Used: 5},
+	}
+	usage := sna.RangesUsage()
+	if len(usage) != len(expected) {
+		t.Fatalf("expected usage of %d ranges but got %d", len(expected), len(usage))
+	}
+	for i := range expected {
+		if usage[i].Network.String() != expected[i].Network.String() || usage[i].Count != expected[i].Count || usage[i].Used != expected[i].Used {
+			t.Fatalf("expected usage %s %d/%d but got %s %d/%d", expected[i].Network, expected[i].Used, expected[i].Count,
+				usage[i].Network, usage[i].Used, usage[i].Count)
+		}
+	}
+}
+
 // Allocating multiple subnet from same Clusternetwork CIDR for
 // a node should not be allowed
 func TestAllocateSubnetSameOwner(t *testing.T) {
@@ -629,3 +663,114 @@ func TestAllocateSubnetSameOwner(t *testing.T) {
 		}
 	}
 }
+
+func TestAllocateSubnetWithLengthIPv4(t *testing.T) {
+	sna, err := newSubnetAllocator("10.1.0.0/16", 24)
+	if err != nil {
+		t.Fatal("Failed to initialize subnet allocator: ", err)
+	}
+
+	for _, tc := range []struct {
+		owner     string
+		prefixLen int
+		expected  string
+	}{
+		{owner: "node-0", prefixLen: 24, expected: "10.1.0.0/24"},
+		// longer subnets are packed from the start of the range
+		{owner: "node-1", prefixLen: 26, expected: "10.1.1.0/26"},
+		{owner: "node-2", prefixLen: 26, expected: "10.1.1.64/26"},
+		// shorter subnets are allocated from the end of the range
+		{owner: "node-3", prefixLen: 22, expected: "10.1.252.0/22"},
+		{owner: "node-4", prefixLen: 23, expected: "10.1.250.0/23"},
+		// an already allocated subnet is returned
+		{owner: "node-3", prefixLen: 22, expected: "10.1.252.0/22"},
+	} {
+		sn, err := sna.AllocateIPv4NetworkWithLength(tc.owner, tc.prefixLen)
+		if err != nil {
+			t.Fatalf("Failed to allocate a /%d network for %s: %v", tc.prefixLen, tc.owner, err)
+		}
+		if sn.String() != tc.expected {
+			t.Fatalf("Expected to allocate %s for %s but got %s", tc.expected, tc.owner, sn)
+		}
+	}
+
+	// the default length subnets skip the ones already allocated
+	if err := allocateExpected(sna, 5, "10.1.2.0/24"); err != nil {
+		t.Fatal(err)
+	}
+
+	// 1 + 1 + 1 + 4 + 2 + 1
+	if v4used, _ := sna.Usage(); v4used != 10 {
+		t.Fatalf("Expected 10 used v4 subnets but got %d", v4used)
+	}
+
+	if err := sna.ReleaseNetworks("node-3", ovntest.MustParseIPNet("10.1.252.0/22")); err != nil {
+		t.Fatal("Failed to release network: ", err)
+	}
+	if v4used, _ := sna.Usage(); v4used != 6 {
+		t.Fatalf("Expected 6 used v4 subnets but got %d", v4used)
+	}
+	sn, err := sna.AllocateIPv4NetworkWithLength("node-6", 22)
+	if err != nil {
+		t.Fatal("Failed to allocate network: ", err)
+	}
+	if sn.String() != "10.1.252.0/22" {
+		t.Fatalf("Expected to allocate the released 10.1.252.0/22 but got %s", sn)
+	}
+
+	if _, err := sna.AllocateIPv4NetworkWithLength("node-7", 16); err == nil || err == ErrSubnetAllocatorFull {
+		t.Fatalf("Expected an error allocating a network as big as the range but got %v", err)
+	}
+}
+
+func TestMarkAllocatedNetworkWithLength(t *testing.T) {
+	sna, err := newSubnetAllocator("10.1.0.0/16", 24)
+	if err != nil {
+		t.Fatal("Failed to initialize subnet allocator: ", err)
+	}
+
+	if err := sna.MarkAllocatedNetworks("node-0", ovntest.MustParseIPNet("10.1.4.0/22")); err != nil {
+		t.Fatal("Failed to mark allocated network: ", err)
+	}
+	if err := sna.MarkAllocatedNetworks("node-1", ovntest.MustParseIPNet("10.1.8.64/26")); err != nil {
+		t.Fatal("Failed to mark allocated network: ", err)
+	}
+	// marking the same subnets again for the same owners is fine
+	if err := sna.MarkAllocatedNetworks("node-0", ovntest.MustParseIPNet("10.1.4.0/22")); err != nil {
+		t.Fatal("Failed to mark allocated network: ", err)
+	}
+
+	for _, tc := range []struct {
+		owner  string
+		subnet string
+	}{
+		// contained in another owner's subnet
+		{owner: "node-2", subnet: "10.1.5.0/24"},
+		// containing another owner's subnet
+		{owner: "node-2", subnet: "10.1.8.0/24"},
+		{owner: "node-2", subnet: "10.1.0.0/20"},
+		// same owner but a different subnet
+		{owner: "node-0", subnet: "10.1.4.0/24"},
+	} {
+		err := sna.MarkAllocatedNetworks(tc.owner, ovntest.MustParseIPNet(tc.subnet))
+		if !IsAlreadyOwnedError(err) {
+			t.Fatalf("Expected marking %s for %s to fail as already owned but got %v", tc.subnet, tc.owner, err)
+		}
+	}
+
+	if err := sna.MarkAllocatedNetworks("node-2", ovntest.MustParseIPNet("10.1.8.128/26")); err != nil {
+		t.Fatal("Failed to mark allocated network: ", err)
+	}
+	if err := allocateExpected(sna, 3, "10.1.0.0/24"); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"10.1.1.0/24", "10.1.2.0/24", "10.1.3.0/24", "10.1.9.0/24"} {
+		sn, err := allocateOneNetwork(sna, "node-"+expected)
+		if err != nil {
+			t.Fatal("Failed to allocate network: ", err)
+		}
+		if sn.String() != expected {
+			t.Fatalf("Expected to allocate %s but got %s", expected, sn)
+		}
+	}
+}
@@ -283,6 +283,13 @@ func (a *PodAllocator) releasePodOnNAD(pod *corev1.Pod, nad string, network *net
 		}
 	}
 
+	if hasIPAMClaim && podDeleted && releaseFromAllocator {
+		// the IPs are released once the IPAMClaim deletion is handled
+		if err := a.ipamClaimsReconciler.ReleaseStatefulSetIPAMClaim(pod, network); err != nil {
+			return err
+		}
+	}
+
 	if !hasIPAM && !hasIDAllocation {
 		// we only take care of IP and tunnel ID allocation, if neither were
 		// allocated we have nothing to do
@@ -86,6 +86,10 @@ func (a *ipAllocatorStub) AddOrUpdateSubnet(string, []*net.IPNet, ...*net.IPNet)
 	panic("not implemented") // TODO: Implement
 }
 
+func (a *ipAllocatorStub) ReserveSubnets(string, ...*net.IPNet) error {
+	panic("not implemented") // TODO: Implement
+}
+
 func (a ipAllocatorStub) DeleteSubnet(string) {
 	panic("not implemented") // TODO: Implement
 }
@@ -126,6 +130,10 @@ func (a *ipAllocatorStub) GetSubnetName([]*net.IPNet) (string, bool) {
 	panic("not implemented") // TODO: Implement
 }
 
+func (a *ipAllocatorStub) GetSubnetsUsage(string) ([]subnet.SubnetUsage, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 type idAllocatorStub struct {
 	released bool
 }
@@ -598,7 +606,7 @@ func TestPodAllocator_reconcileForNAD(t *testing.T) {
 			if tt.ipam && tt.args.ipamClaim != nil {
 				ctx, cancel := context.WithCancel(context.Background())
 				ipamClaimsLister, teardownFn := generateIPAMClaimsListerAndTeardownFunc(ctx.Done(), tt.args.ipamClaim)
-				ipamClaimsReconciler = persistentips.NewIPAMClaimReconciler(kubeMock, netInfo, ipamClaimsLister)
+				ipamClaimsReconciler = persistentips.NewIPAMClaimReconciler(kubeMock, netInfo, ipamClaimsLister, nil)
 
 				t.Cleanup(func() {
 					cancel()
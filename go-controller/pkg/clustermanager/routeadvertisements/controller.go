@@ -322,6 +322,9 @@ type selectedNetworks struct {
 	hostNetworkSubnets map[string][]string
 	// prefixLength is a map of selected network to their prefix length
 	prefixLength map[string]uint32
+	// maxPrefixLength is a map of selected network to the longest prefix
+	// length of their node subnets, when they can differ from prefixLength
+	maxPrefixLength map[string]uint32
 	// networkType is a map of selected network to their topology
 	networkTopology map[string]string
 }
@@ -354,6 +357,7 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 		networkVRFs:     map[string]string{},
 		networkSubnets:  map[string][]string{},
 		prefixLength:    map[string]uint32{},
+		maxPrefixLength: map[string]uint32{},
 		networkTopology: map[string]string{},
 	}
 	for _, nad := range nads {
@@ -392,6 +396,14 @@ func (c *Controller) generateFRRConfigurations(ra *ratypes.RouteAdvertisements)
 			selectedNetworks.networkSubnets[networkName] = append(selectedNetworks.networkSubnets[networkName], subnet)
 			selectedNetworks.subnets = append(selectedNetworks.subnets, subnet)
 			selectedNetworks.prefixLength[subnet] = len
+			selectedNetworks.maxPrefixLength[subnet] = len
+			// nodes can request IPv4 host subnets of other lengths on the
+			// default network
+			if network.IsDefault() && utilnet.IsIPv4CIDR(cidr.CIDR) {
+				clusterLen, _ := cidr.CIDR.Mask.Size()
+				selectedNetworks.prefixLength[subnet] = uint32(clusterLen + 1)
+				selectedNetworks.maxPrefixLength[subnet] = max(len, types.MaxHostSubnetLength)
+			}
 		}
 		// ordered
 		slices.Sort(selectedNetworks.networkSubnets[networkName])
@@ -690,7 +702,7 @@ func (c *Controller) generateFRRConfiguration(
 					neighbor.ToReceive.Allowed.Prefixes = append(neighbor.ToReceive.Allowed.Prefixes,
 						frrtypes.PrefixSelector{
 							Prefix: prefix,
-							LE:     selectedNetworks.prefixLength[prefix],
+							LE:     selectedNetworks.maxPrefixLength[prefix],
 							GE:     selectedNetworks.prefixLength[prefix],
 						},
 					)
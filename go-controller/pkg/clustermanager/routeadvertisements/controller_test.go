@@ -188,12 +188,17 @@ func (tn testNeighbor) Neighbor() frrapi.Neighbor {
 
 		first := receive[:sep]
 		last := receive[sep+1:]
-		len := ovntest.MustAtoi(last)
+		// the last part is either the host subnet length or a range of
+		// lengths like "17-28"
+		ge, le, isRange := strings.Cut(last, "-")
+		if !isRange {
+			le = ge
+		}
 		n.ToReceive.Allowed.Prefixes = append(n.ToReceive.Allowed.Prefixes,
 			frrapi.PrefixSelector{
 				Prefix: first,
-				GE:     uint32(len),
-				LE:     uint32(len),
+				GE:     uint32(ovntest.MustAtoi(ge)),
+				LE:     uint32(ovntest.MustAtoi(le)),
 			},
 		)
 	}
@@ -429,7 +434,7 @@ func TestController_reconcile(t *testing.T) {
 					NodeSelector: map[string]string{"kubernetes.io/hostname": "node"},
 					Routers: []*testRouter{
 						{ASN: 1, Prefixes: []string{"1.0.1.1/32", "1.1.0.0/24"}, Neighbors: []*testNeighbor{
-							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"1.0.1.1/32", "1.1.0.0/24"}, Receive: []string{"1.1.0.0/16/24"}},
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"1.0.1.1/32", "1.1.0.0/24"}, Receive: []string{"1.1.0.0/16/17-28"}},
 						}},
 					}},
 			},
@@ -461,7 +466,7 @@ func TestController_reconcile(t *testing.T) {
 					NodeSelector: map[string]string{"kubernetes.io/hostname": "node"},
 					Routers: []*testRouter{
 						{ASN: 1, Prefixes: []string{"1.0.1.1/32", "1.1.0.0/24", "fd01::/64", "fd03::ffff:100:101/128"}, Neighbors: []*testNeighbor{
-							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"1.0.1.1/32", "1.1.0.0/24"}, Receive: []string{"1.1.0.0/16/24"}},
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"1.0.1.1/32", "1.1.0.0/24"}, Receive: []string{"1.1.0.0/16/17-28"}},
 							{ASN: 1, Address: "fd02::ffff:100:64", Advertise: []string{"fd01::/64", "fd03::ffff:100:101/128"}, Receive: []string{"fd01::/48/64"}},
 						}},
 					}},
@@ -641,7 +646,7 @@ func TestController_reconcile(t *testing.T) {
 					NodeSelector: map[string]string{"kubernetes.io/hostname": "node"},
 					Routers: []*testRouter{
 						{ASN: 1, Prefixes: []string{"1.0.1.1/32", "1.1.0.0/24"}, Neighbors: []*testNeighbor{
-							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"1.0.1.1/32", "1.1.0.0/24"}, Receive: []string{"1.1.0.0/16/24"}},
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"1.0.1.1/32", "1.1.0.0/24"}, Receive: []string{"1.1.0.0/16/17-28"}},
 						}},
 					},
 				},
@@ -749,7 +754,7 @@ func TestController_reconcile(t *testing.T) {
 					NodeSelector: map[string]string{"kubernetes.io/hostname": "node1"},
 					Routers: []*testRouter{
 						{ASN: 1, Prefixes: []string{"1.1.1.0/24"}, Neighbors: []*testNeighbor{
-							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"1.1.1.0/24"}, Receive: []string{"1.1.0.0/16/24"}},
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"1.1.1.0/24"}, Receive: []string{"1.1.0.0/16/17-28"}},
 						}},
 						{ASN: 1, VRF: "red", Prefixes: []string{"1.2.1.0/24"}, Neighbors: []*testNeighbor{
 							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"1.2.1.0/24"}, Receive: []string{"1.2.0.0/16/24"}},
@@ -765,7 +770,7 @@ func TestController_reconcile(t *testing.T) {
 					NodeSelector: map[string]string{"kubernetes.io/hostname": "node2"},
 					Routers: []*testRouter{
 						{ASN: 1, Prefixes: []string{"1.1.2.0/24"}, Neighbors: []*testNeighbor{
-							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"1.1.2.0/24"}, Receive: []string{"1.1.0.0/16/24"}},
+							{ASN: 1, Address: "1.0.0.100", Advertise: []string{"1.1.2.0/24"}, Receive: []string{"1.1.0.0/16/17-28"}},
 						}},
 					},
 				},
@@ -26,7 +26,8 @@ type secondaryNetworkClusterManager struct {
 	// event recorder used to post events to k8s
 	recorder record.EventRecorder
 
-	errorReporter NetworkStatusReporter
+	errorReporter    NetworkStatusReporter
+	capacityReporter NetworkCapacityReporter
 }
 
 func newSecondaryNetworkClusterManager(
@@ -49,6 +50,10 @@ func (sncm *secondaryNetworkClusterManager) SetNetworkStatusReporter(errorReport
 	sncm.errorReporter = errorReporter
 }
 
+func (sncm *secondaryNetworkClusterManager) SetNetworkCapacityReporter(capacityReporter NetworkCapacityReporter) {
+	sncm.capacityReporter = capacityReporter
+}
+
 func (sncm *secondaryNetworkClusterManager) GetDefaultNetworkController() networkmanager.ReconcilableNetworkController {
 	return nil
 }
@@ -70,6 +75,7 @@ func (sncm *secondaryNetworkClusterManager) NewNetworkController(nInfo util.NetI
 		sncm.networkManager,
 		sncm.errorReporter,
 	)
+	sncc.capacityReporter = sncm.capacityReporter
 	return sncc, nil
 }
 
@@ -22,6 +22,7 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip"
 	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
 	ovntypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
@@ -105,6 +106,72 @@ var _ = ginkgo.Describe("Cluster Controller Manager", func() {
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
 		})
 
+		ginkgo.It("Allocates the subnets appended to a secondary layer3 network", func() {
+			app.Action = func(ctx *cli.Context) error {
+				kubeFakeClient := fake.NewSimpleClientset(&corev1.NodeList{Items: nodes()})
+				fakeClient := &util.OVNClusterManagerClientset{
+					KubeClient:            kubeFakeClient,
+					IPAMClaimsClient:      fakeipamclaimclient.NewSimpleClientset(),
+					NetworkAttchDefClient: fakenadclient.NewSimpleClientset(),
+				}
+
+				gomega.Expect(initConfig(ctx, config.OVNKubernetesFeatureConfig{EnableMultiNetwork: true})).To(gomega.Succeed())
+				var err error
+				f, err = factory.NewClusterManagerWatchFactory(fakeClient)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = f.Start()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				sncm, err := newSecondaryNetworkClusterManager(fakeClient, f, networkmanager.Default().Interface(), recorder)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				// room for two of the three nodes only
+				netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{NetConf: types.NetConf{Name: "blue"}, Topology: ovntypes.Layer3Topology, Subnets: "192.168.0.0/23/24"})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				nc, err := sncm.NewNetworkController(netInfo)
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(nc.Start(ctx.Context)).To(gomega.Succeed())
+				defer nc.Stop()
+
+				nodeSubnets := func() ([]string, error) {
+					var subnets []string
+					for _, n := range nodes() {
+						updatedNode, err := fakeClient.KubeClient.CoreV1().Nodes().Get(context.TODO(), n.Name, metav1.GetOptions{})
+						if err != nil {
+							return nil, err
+						}
+						nodeSubnets, err := util.ParseNodeHostSubnetAnnotation(updatedNode, "blue")
+						if util.IsAnnotationNotSetError(err) {
+							continue
+						}
+						if err != nil {
+							return nil, err
+						}
+						subnets = append(subnets, util.StringSlice(nodeSubnets)...)
+					}
+					return subnets, nil
+				}
+				gomega.Eventually(nodeSubnets).Should(gomega.ConsistOf("192.168.0.0/24", "192.168.1.0/24"))
+
+				appended, err := util.NewNetInfo(&ovncnitypes.NetConf{NetConf: types.NetConf{Name: "blue"}, Topology: ovntypes.Layer3Topology, Subnets: "192.168.0.0/23/24,192.168.8.0/23/24"})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Expect(util.AreNetworksCompatible(nc, appended)).To(gomega.BeTrue())
+				gomega.Expect(nc.(*networkClusterController).Reconcile(appended)).To(gomega.Succeed())
+
+				gomega.Eventually(nodeSubnets).Should(gomega.ConsistOf("192.168.0.0/24", "192.168.1.0/24", "192.168.8.0/24"))
+				gomega.Expect(nc.(*networkClusterController).getNetworkCapacity()).To(gomega.ConsistOf(
+					udnv1.SubnetCapacity{CIDR: "192.168.0.0/23", HostSubnets: &udnv1.CapacityUsage{Total: 2, Used: 2}},
+					udnv1.SubnetCapacity{CIDR: "192.168.8.0/23", HostSubnets: &udnv1.CapacityUsage{Total: 2, Used: 1}},
+				))
+
+				return nil
+			}
+
+			err := app.Run([]string{
+				app.Name,
+			})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
 		ginkgo.When("Attaching to a layer2 network", func() {
 			const subnets = "192.168.200.0/24,fd12:1234::0/64"
 			var (
@@ -2,9 +2,14 @@ package status_manager
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metaapply "k8s.io/client-go/applyconfigurations/meta/v1"
 
 	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
 	adminpolicybasedrouteapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/applyconfiguration/adminpolicybasedroute/v1"
@@ -35,6 +40,14 @@ func (m *apbRouteManager) getMessages(route *adminpolicybasedrouteapi.AdminPolic
 	return route.Status.Messages
 }
 
+// zoneStatusChanged returns true when the next hops reported by the zones changed, e.g. when a BFD session
+// goes up or down, since they don't change the zone messages.
+//
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *apbRouteManager) zoneStatusChanged(oldRoute, newRoute *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute) bool {
+	return !equality.Semantic.DeepEqual(oldRoute.Status.NextHops, newRoute.Status.NextHops)
+}
+
 //lint:ignore U1000 generic interfaces throw false-positives
 func (m *apbRouteManager) updateStatus(route *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute, applyOpts *metav1.ApplyOptions,
 	applyEmptyOrFailed bool) error {
@@ -51,8 +64,17 @@ func (m *apbRouteManager) updateStatus(route *adminpolicybasedrouteapi.AdminPoli
 	if applyEmptyOrFailed && newStatus != adminpolicybasedrouteapi.FailStatus {
 		newStatus = ""
 	}
+	// next hops are only aggregated once all the zones reported them
+	var conditions []metav1.Condition
+	if !applyEmptyOrFailed {
+		conditions = make([]metav1.Condition, len(route.Status.Conditions))
+		for i := range route.Status.Conditions {
+			route.Status.Conditions[i].DeepCopyInto(&conditions[i])
+		}
+		meta.SetStatusCondition(&conditions, getNextHopsReadyCondition(route))
+	}
 
-	if route.Status.Status == newStatus {
+	if route.Status.Status == newStatus && equality.Semantic.DeepEqual(route.Status.Conditions, conditions) {
 		// already set to the same value
 		return nil
 	}
@@ -62,6 +84,15 @@ func (m *apbRouteManager) updateStatus(route *adminpolicybasedrouteapi.AdminPoli
 	if newStatus != "" {
 		applyStatus.WithStatus(newStatus)
 	}
+	for _, condition := range conditions {
+		applyStatus.WithConditions(metaapply.Condition().
+			WithType(condition.Type).
+			WithStatus(condition.Status).
+			WithObservedGeneration(condition.ObservedGeneration).
+			WithLastTransitionTime(condition.LastTransitionTime).
+			WithReason(condition.Reason).
+			WithMessage(condition.Message))
+	}
 
 	applyObj := adminpolicybasedrouteapply.AdminPolicyBasedExternalRoute(route.Name).
 		WithStatus(applyStatus)
@@ -70,6 +101,41 @@ func (m *apbRouteManager) updateStatus(route *adminpolicybasedrouteapi.AdminPoli
 	return err
 }
 
+// getNextHopsReadyCondition aggregates the BFD state of the next hops reported by all the zones.
+func getNextHopsReadyCondition(route *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               adminpolicybasedrouteapi.NextHopsReadyCondition,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: route.Generation,
+	}
+	bfdNextHops := 0
+	var notUp []string
+	for _, nextHop := range route.Status.NextHops {
+		if !nextHop.BFDEnabled {
+			continue
+		}
+		bfdNextHops++
+		if nextHop.BFDState != adminpolicybasedrouteapi.BFDStateUp {
+			notUp = append(notUp, fmt.Sprintf("%s (%s: %s)", nextHop.IP, nextHop.Zone, nextHop.BFDState))
+		}
+	}
+	switch {
+	case bfdNextHops == 0:
+		condition.Reason = "BFDNotEnabled"
+		condition.Message = "No next hop has BFD enabled"
+	case len(notUp) == 0:
+		condition.Reason = "NextHopsUp"
+		condition.Message = fmt.Sprintf("All %d next hops with BFD enabled are up", bfdNextHops)
+	default:
+		sort.Strings(notUp)
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "NextHopsNotUp"
+		condition.Message = fmt.Sprintf("%d of %d next hops with BFD enabled are not up: %s", len(notUp), bfdNextHops,
+			strings.Join(notUp, ", "))
+	}
+	return condition
+}
+
 //lint:ignore U1000 generic interfaces throw false-positives
 func (m *apbRouteManager) cleanupStatus(route *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute, applyOpts *metav1.ApplyOptions) error {
 	applyObj := adminpolicybasedrouteapply.AdminPolicyBasedExternalRoute(route.Name).
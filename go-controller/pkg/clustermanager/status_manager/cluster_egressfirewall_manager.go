@@ -0,0 +1,69 @@
+package status_manager
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	egressfirewallapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+	egressfirewallapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1/apis/applyconfiguration/egressfirewall/v1"
+	egressfirewallclientset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1/apis/clientset/versioned"
+	egressfirewalllisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1/apis/listers/egressfirewall/v1"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+)
+
+type clusterEgressFirewallManager struct {
+	lister egressfirewalllisters.ClusterEgressFirewallLister
+	client egressfirewallclientset.Interface
+}
+
+func newClusterEgressFirewallManager(lister egressfirewalllisters.ClusterEgressFirewallLister, client egressfirewallclientset.Interface) *clusterEgressFirewallManager {
+	return &clusterEgressFirewallManager{
+		lister: lister,
+		client: client,
+	}
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives https://github.com/dominikh/go-tools/issues/1440
+func (m *clusterEgressFirewallManager) get(_, name string) (*egressfirewallapi.ClusterEgressFirewall, error) {
+	return m.lister.Get(name)
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *clusterEgressFirewallManager) getMessages(clusterEgressFirewall *egressfirewallapi.ClusterEgressFirewall) []string {
+	return clusterEgressFirewall.Status.Messages
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *clusterEgressFirewallManager) updateStatus(clusterEgressFirewall *egressfirewallapi.ClusterEgressFirewall, applyOpts *metav1.ApplyOptions,
+	applyEmptyOrFailed bool) error {
+	if clusterEgressFirewall == nil {
+		return nil
+	}
+	newStatus := getEgressFirewallStatus(clusterEgressFirewall.Status.Messages, types.ClusterEgressFirewallAppliedCorrectly,
+		applyEmptyOrFailed)
+	if clusterEgressFirewall.Status.Status == newStatus {
+		// already set to the same value
+		return nil
+	}
+
+	applyStatus := egressfirewallapply.EgressFirewallStatus()
+	if newStatus != "" {
+		applyStatus.WithStatus(newStatus)
+	}
+
+	applyObj := egressfirewallapply.ClusterEgressFirewall(clusterEgressFirewall.Name).
+		WithStatus(applyStatus)
+
+	_, err := m.client.K8sV1().ClusterEgressFirewalls().ApplyStatus(context.TODO(), applyObj, *applyOpts)
+	return err
+}
+
+//lint:ignore U1000 generic interfaces throw false-positives
+func (m *clusterEgressFirewallManager) cleanupStatus(clusterEgressFirewall *egressfirewallapi.ClusterEgressFirewall, applyOpts *metav1.ApplyOptions) error {
+	applyObj := egressfirewallapply.ClusterEgressFirewall(clusterEgressFirewall.Name).
+		WithStatus(egressfirewallapply.EgressFirewallStatus())
+
+	_, err := m.client.K8sV1().ClusterEgressFirewalls().ApplyStatus(context.TODO(), applyObj, *applyOpts)
+	return err
+}
@@ -41,17 +41,7 @@ func (m *egressFirewallManager) updateStatus(egressFirewall *egressfirewallapi.E
 	if egressFirewall == nil {
 		return nil
 	}
-	newStatus := "EgressFirewall Rules applied"
-	for _, message := range egressFirewall.Status.Messages {
-		if strings.Contains(message, types.EgressFirewallErrorMsg) {
-			newStatus = types.EgressFirewallErrorMsg
-			break
-		}
-	}
-	if applyEmptyOrFailed && newStatus != types.EgressFirewallErrorMsg {
-		newStatus = ""
-	}
-
+	newStatus := getEgressFirewallStatus(egressFirewall.Status.Messages, "EgressFirewall Rules applied", applyEmptyOrFailed)
 	if egressFirewall.Status.Status == newStatus {
 		// already set to the same value
 		return nil
@@ -69,6 +59,21 @@ func (m *egressFirewallManager) updateStatus(egressFirewall *egressfirewallapi.E
 	return err
 }
 
+// getEgressFirewallStatus returns the status of an EgressFirewall or ClusterEgressFirewall based on the zone
+// messages: the error status if any zone failed, appliedStatus otherwise. If applyEmptyOrFailed is set, only
+// the error status is reported.
+func getEgressFirewallStatus(messages []string, appliedStatus string, applyEmptyOrFailed bool) string {
+	for _, message := range messages {
+		if strings.Contains(message, types.EgressFirewallErrorMsg) {
+			return types.EgressFirewallErrorMsg
+		}
+	}
+	if applyEmptyOrFailed {
+		return ""
+	}
+	return appliedStatus
+}
+
 //lint:ignore U1000 generic interfaces throw false-positives
 func (m *egressFirewallManager) cleanupStatus(egressFirewall *egressfirewallapi.EgressFirewall, applyOpts *metav1.ApplyOptions) error {
 	applyObj := egressfirewallapply.EgressFirewall(egressFirewall.Name, egressFirewall.Namespace).
@@ -43,6 +43,12 @@ type resourceManager[T any] interface {
 	cleanupStatus(obj *T, applyOpts *metav1.ApplyOptions) error
 }
 
+// zoneStatusComparer is implemented by the resources that aggregate more zone status fields than the messages.
+type zoneStatusComparer[T any] interface {
+	// zoneStatusChanged returns true when the zone status fields other than the messages changed
+	zoneStatusChanged(oldObj, newObj *T) bool
+}
+
 // typedStatusManager manages status for a resource of type T.
 type typedStatusManager[T any] struct {
 	name string
@@ -76,6 +82,9 @@ func (m *typedStatusManager[T]) needsUpdate(oldObj, newObj *T) bool {
 	if oldObj == nil || newObj == nil {
 		return true
 	}
+	if comparer, ok := m.resource.(zoneStatusComparer[T]); ok && comparer.zoneStatusChanged(oldObj, newObj) {
+		return true
+	}
 	return !reflect.DeepEqual(m.resource.getMessages(oldObj), m.resource.getMessages(newObj))
 }
 
@@ -193,6 +202,14 @@ func NewStatusManager(wf *factory.WatchFactory, ovnClient *util.OVNClusterManage
 			sm.withZonesRLock,
 		)
 		sm.typedManagers["egressfirewalls"] = egressFirewallManager
+		clusterEgressFirewallManager := newStatusManager[egressfirewallapi.ClusterEgressFirewall](
+			"clusteregressfirewalls_statusmanager",
+			wf.ClusterEgressFirewallInformer().Informer(),
+			wf.ClusterEgressFirewallInformer().Lister().List,
+			newClusterEgressFirewallManager(wf.ClusterEgressFirewallInformer().Lister(), ovnClient.EgressFirewallClient),
+			sm.withZonesRLock,
+		)
+		sm.typedManagers["clusteregressfirewalls"] = clusterEgressFirewallManager
 	}
 	if config.OVNKubernetesFeature.EnableEgressQoS {
 		egressQoSManager := newStatusManager[egressqosapi.EgressQoS](
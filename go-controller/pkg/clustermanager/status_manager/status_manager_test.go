@@ -8,6 +8,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -116,6 +117,55 @@ func checkEmptyEFStatusConsistently(egressFirewall *egressfirewallapi.EgressFire
 	}).Should(BeTrue(), "expected Status to be consistently empty")
 }
 
+func newClusterEgressFirewall(name string) *egressfirewallapi.ClusterEgressFirewall {
+	return &egressfirewallapi.ClusterEgressFirewall{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: egressfirewallapi.ClusterEgressFirewallSpec{
+			Placement: egressfirewallapi.ClusterEgressFirewallPlacementBeforeNamespace,
+			Egress: []egressfirewallapi.EgressFirewallRule{
+				{
+					Type: "Deny",
+					To: egressfirewallapi.EgressFirewallDestination{
+						CIDRSelector: "1.2.3.4/23",
+					},
+				},
+			},
+		},
+	}
+}
+
+func updateClusterEgressFirewallStatus(clusterEgressFirewall *egressfirewallapi.ClusterEgressFirewall, status *egressfirewallapi.EgressFirewallStatus,
+	fakeClient *util.OVNClusterManagerClientset) {
+	clusterEgressFirewall.Status = *status
+	_, err := fakeClient.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().
+		Update(context.TODO(), clusterEgressFirewall, metav1.UpdateOptions{})
+	Expect(err).ToNot(HaveOccurred())
+}
+
+func checkCEFStatusEventually(clusterEgressFirewall *egressfirewallapi.ClusterEgressFirewall, expectFailure bool, expectEmpty bool, fakeClient *util.OVNClusterManagerClientset) {
+	Eventually(func() bool {
+		cef, err := fakeClient.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().
+			Get(context.TODO(), clusterEgressFirewall.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		if expectFailure {
+			return strings.Contains(cef.Status.Status, types.EgressFirewallErrorMsg)
+		} else if expectEmpty {
+			return cef.Status.Status == ""
+		} else {
+			return strings.Contains(cef.Status.Status, "applied")
+		}
+	}).Should(BeTrue(), fmt.Sprintf("expected cluster egress firewall status with expectFailure=%v expectEmpty=%v", expectFailure, expectEmpty))
+}
+
+func checkEmptyCEFStatusConsistently(clusterEgressFirewall *egressfirewallapi.ClusterEgressFirewall, fakeClient *util.OVNClusterManagerClientset) {
+	Consistently(func() bool {
+		cef, err := fakeClient.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().
+			Get(context.TODO(), clusterEgressFirewall.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return cef.Status.Status == ""
+	}).Should(BeTrue(), "expected Status to be consistently empty")
+}
+
 func newAPBRoute(name string) *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute {
 	return &adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute{
 		ObjectMeta: util.NewObjectMeta(name, ""),
@@ -376,6 +426,36 @@ var _ = Describe("Cluster Manager Status Manager", func() {
 		}, fakeClient)
 		checkEFStatusEventually(egressFirewall, false, false, fakeClient)
 	})
+	It("updates ClusterEgressFirewall status with 2 zones", func() {
+		config.OVNKubernetesFeature.EnableEgressFirewall = true
+		zones := sets.New("zone1", "zone2")
+		clusterEgressFirewall := newClusterEgressFirewall("baseline")
+		start(zones, clusterEgressFirewall)
+
+		updateClusterEgressFirewallStatus(clusterEgressFirewall, &egressfirewallapi.EgressFirewallStatus{
+			Messages: []string{types.GetZoneStatus("zone1", "OK")},
+		}, fakeClient)
+		checkEmptyCEFStatusConsistently(clusterEgressFirewall, fakeClient)
+
+		updateClusterEgressFirewallStatus(clusterEgressFirewall, &egressfirewallapi.EgressFirewallStatus{
+			Messages: []string{types.GetZoneStatus("zone1", "OK"), types.GetZoneStatus("zone2", "OK")},
+		}, fakeClient)
+		checkCEFStatusEventually(clusterEgressFirewall, false, false, fakeClient)
+	})
+
+	It("updates ClusterEgressFirewall status with a failed zone", func() {
+		config.OVNKubernetesFeature.EnableEgressFirewall = true
+		zones := sets.New("zone1", "zone2")
+		clusterEgressFirewall := newClusterEgressFirewall("baseline")
+		start(zones, clusterEgressFirewall)
+
+		// failure is reported even if not all zones reported their status
+		updateClusterEgressFirewallStatus(clusterEgressFirewall, &egressfirewallapi.EgressFirewallStatus{
+			Messages: []string{types.GetZoneStatus("zone1", types.EgressFirewallErrorMsg+": failed")},
+		}, fakeClient)
+		checkCEFStatusEventually(clusterEgressFirewall, true, false, fakeClient)
+	})
+
 	It("updates APBRoute status with 1 zone", func() {
 		config.OVNKubernetesFeature.EnableMultiExternalGateway = true
 		zones := sets.New("zone1")
@@ -430,6 +510,74 @@ var _ = Describe("Cluster Manager Status Manager", func() {
 		checkAPBRouteStatusEventually(apbRoute, false, false, fakeClient)
 	})
 
+	It("updates APBRoute next hops condition with 2 zones", func() {
+		config.OVNKubernetesFeature.EnableMultiExternalGateway = true
+		zones := sets.New("zone1", "zone2")
+		apbRoute := newAPBRoute(apbrouteName)
+		start(zones, apbRoute)
+
+		nextHop := func(zone string, state adminpolicybasedrouteapi.BFDStateType) adminpolicybasedrouteapi.NextHopStatus {
+			return adminpolicybasedrouteapi.NextHopStatus{
+				Zone:             zone,
+				IP:               "10.10.10.1",
+				Source:           adminpolicybasedrouteapi.StaticNextHopSource,
+				BFDEnabled:       true,
+				BFDState:         state,
+				ServedNamespaces: 1,
+			}
+		}
+		// getCondition returns the NextHopsReady condition without its transition time
+		getCondition := func() metav1.Condition {
+			route, err := fakeClient.AdminPolicyRouteClient.K8sV1().AdminPolicyBasedExternalRoutes().
+				Get(context.TODO(), apbRoute.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			condition := meta.FindStatusCondition(route.Status.Conditions, adminpolicybasedrouteapi.NextHopsReadyCondition)
+			if condition == nil {
+				return metav1.Condition{}
+			}
+			condition.LastTransitionTime = metav1.Time{}
+			return *condition
+		}
+
+		// the condition is not set until all zones report their next hops
+		updateAPBRouteStatus(apbRoute, &adminpolicybasedrouteapi.AdminPolicyBasedRouteStatus{
+			Messages: []string{types.GetZoneStatus("zone1", "OK")},
+			NextHops: []adminpolicybasedrouteapi.NextHopStatus{nextHop("zone1", adminpolicybasedrouteapi.BFDStateUp)},
+		}, fakeClient)
+		Consistently(getCondition).Should(Equal(metav1.Condition{}))
+
+		updateAPBRouteStatus(apbRoute, &adminpolicybasedrouteapi.AdminPolicyBasedRouteStatus{
+			Messages: []string{types.GetZoneStatus("zone1", "OK"), types.GetZoneStatus("zone2", "OK")},
+			NextHops: []adminpolicybasedrouteapi.NextHopStatus{
+				nextHop("zone1", adminpolicybasedrouteapi.BFDStateUp),
+				nextHop("zone2", adminpolicybasedrouteapi.BFDStateDown),
+			},
+		}, fakeClient)
+		checkAPBRouteStatusEventually(apbRoute, false, false, fakeClient)
+		Eventually(getCondition).Should(Equal(metav1.Condition{
+			Type:    adminpolicybasedrouteapi.NextHopsReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  "NextHopsNotUp",
+			Message: "1 of 2 next hops with BFD enabled are not up: 10.10.10.1 (zone2: Down)",
+		}))
+
+		route, err := fakeClient.AdminPolicyRouteClient.K8sV1().AdminPolicyBasedExternalRoutes().
+			Get(context.TODO(), apbRoute.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		// only the next hops change, the zone messages stay the same
+		route.Status.NextHops = []adminpolicybasedrouteapi.NextHopStatus{
+			nextHop("zone1", adminpolicybasedrouteapi.BFDStateUp),
+			nextHop("zone2", adminpolicybasedrouteapi.BFDStateUp),
+		}
+		updateAPBRouteStatus(route, &route.Status, fakeClient)
+		Eventually(getCondition).Should(Equal(metav1.Condition{
+			Type:    adminpolicybasedrouteapi.NextHopsReadyCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  "NextHopsUp",
+			Message: "All 2 next hops with BFD enabled are up",
+		}))
+	})
+
 	It("updates EgressQoS status with 1 zone", func() {
 		config.OVNKubernetesFeature.EnableEgressQoS = true
 		zones := sets.New("zone1")
@@ -30,6 +30,7 @@ import (
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork/notifier"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/clustermanager/userdefinednetwork/template"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
 	userdefinednetworkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
 	udnapplyconfkv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1/apis/applyconfiguration/userdefinednetwork/v1"
@@ -52,6 +53,20 @@ func (n *networkInUseError) Error() string {
 	return n.err.Error()
 }
 
+// localnetNotAllowedError is returned when a UserDefinedNetwork requests a physical network and VLAN
+// that are not in the allow-list configured by the cluster admin.
+type localnetNotAllowedError struct {
+	physicalNetworkName string
+	vlanID              int
+}
+
+func (l *localnetNotAllowedError) Error() string {
+	if l.vlanID == 0 {
+		return fmt.Sprintf("physical network %q without VLAN is not allowed for UserDefinedNetworks", l.physicalNetworkName)
+	}
+	return fmt.Sprintf("physical network %q with VLAN %d is not allowed for UserDefinedNetworks", l.physicalNetworkName, l.vlanID)
+}
+
 type Controller struct {
 	// cudnController manage ClusterUserDefinedNetwork CRs.
 	cudnController controller.Controller
@@ -350,6 +365,77 @@ func (c *Controller) UpdateSubsystemCondition(
 	return nil
 }
 
+// UpdateNetworkCapacity may be used by the controllers allocating the network resources to report the usage of the
+// network subnets, along with a condition warning about their exhaustion, on the UDN or CUDN managing the network.
+// FieldManager should be unique for the reporting subsystem, so that the capacity does not override other conditions.
+// If given network is not managed by a UDN or CUDN, nothing will be reported and no error will be returned.
+func (c *Controller) UpdateNetworkCapacity(
+	networkName string,
+	fieldManager string,
+	capacity []userdefinednetworkv1.SubnetCapacity,
+	condition *metav1.Condition,
+) error {
+	udnNamespace, udnName := util.ParseNetworkName(networkName)
+	if udnName == "" {
+		return nil
+	}
+
+	applyCapacity := make([]*udnapplyconfkv1.SubnetCapacityApplyConfiguration, 0, len(capacity))
+	for _, subnet := range capacity {
+		applySubnet := udnapplyconfkv1.SubnetCapacity().WithCIDR(subnet.CIDR)
+		if subnet.HostSubnets != nil {
+			applySubnet.WithHostSubnets(udnapplyconfkv1.CapacityUsage().
+				WithTotal(subnet.HostSubnets.Total).
+				WithUsed(subnet.HostSubnets.Used))
+		}
+		if subnet.IPs != nil {
+			applySubnet.WithIPs(udnapplyconfkv1.CapacityUsage().
+				WithTotal(subnet.IPs.Total).
+				WithUsed(subnet.IPs.Used))
+		}
+		applyCapacity = append(applyCapacity, applySubnet)
+	}
+	applyCondition := &metaapplyv1.ConditionApplyConfiguration{
+		Type:               &condition.Type,
+		Status:             &condition.Status,
+		LastTransitionTime: &condition.LastTransitionTime,
+		Reason:             &condition.Reason,
+		Message:            &condition.Message,
+	}
+	opts := metav1.ApplyOptions{
+		FieldManager: fieldManager,
+		Force:        true,
+	}
+
+	var err error
+	if udnNamespace == "" {
+		if _, err = c.cudnLister.Get(udnName); err != nil {
+			return nil
+		}
+		applyCUDN := udnapplyconfkv1.ClusterUserDefinedNetwork(udnName).
+			WithStatus(udnapplyconfkv1.ClusterUserDefinedNetworkStatus().
+				WithConditions(applyCondition).
+				WithCapacity(applyCapacity...))
+		_, err = c.udnClient.K8sV1().ClusterUserDefinedNetworks().ApplyStatus(context.Background(), applyCUDN, opts)
+	} else {
+		if _, err = c.udnLister.UserDefinedNetworks(udnNamespace).Get(udnName); err != nil {
+			return nil
+		}
+		applyUDN := udnapplyconfkv1.UserDefinedNetwork(udnName, udnNamespace).
+			WithStatus(udnapplyconfkv1.UserDefinedNetworkStatus().
+				WithConditions(applyCondition).
+				WithCapacity(applyCapacity...))
+		_, err = c.udnClient.K8sV1().UserDefinedNetworks(udnNamespace).ApplyStatus(context.Background(), applyUDN, opts)
+	}
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to update capacity of network %s: %w", networkName, err)
+	}
+	return nil
+}
+
 func (c *Controller) udnNeedUpdate(_, _ *userdefinednetworkv1.UserDefinedNetwork) bool {
 	return true
 }
@@ -380,10 +466,54 @@ func (c *Controller) reconcileUDN(key string) error {
 		c.udnController.ReconcileAfter(key, c.networkInUseRequeueInterval)
 		return updateStatusErr
 	}
+	var localnetNotAllowed *localnetNotAllowedError
+	if errors.As(syncErr, &localnetNotAllowed) {
+		// the allow-list can only change on restart, retrying won't help
+		return updateStatusErr
+	}
 
 	return errors.Join(syncErr, updateStatusErr)
 }
 
+// validateLocalnetAllowed checks the physical network and VLAN of a Localnet UserDefinedNetwork
+// are allowed by the cluster admin.
+func validateLocalnetAllowed(udn *userdefinednetworkv1.UserDefinedNetwork) error {
+	cfg := udn.Spec.GetLocalnet()
+	if udn.Spec.GetTopology() != userdefinednetworkv1.NetworkTopologyLocalnet || cfg == nil {
+		return nil
+	}
+	vlanIDs := []int{0}
+	if cfg.VLAN != nil && cfg.VLAN.Access != nil {
+		vlanIDs = []int{int(cfg.VLAN.Access.ID)}
+	}
+	if cfg.VLAN != nil && cfg.VLAN.Trunk != nil {
+		// every VLAN the pods can reach through the trunk has to be allowed. With a native VLAN the pods
+		// also send and receive untagged traffic, which requires untagged access to the physical network.
+		vlanRanges, err := util.ParseVLANRanges(strings.Join(cfg.VLAN.Trunk.AllowedVLANs, ","))
+		if err != nil {
+			return err
+		}
+		vlanIDs = nil
+		if cfg.VLAN.Trunk.NativeVLAN != 0 {
+			vlanIDs = append(vlanIDs, 0)
+		}
+		for _, vlanRange := range vlanRanges {
+			for id := vlanRange.Start; id <= vlanRange.End; id++ {
+				vlanIDs = append(vlanIDs, int(id))
+			}
+		}
+	}
+	for _, vlanID := range vlanIDs {
+		if !slices.Contains(config.ClusterManager.UDNAllowedLocalnets, config.LocalnetAllowListEntry{
+			PhysicalNetworkName: cfg.PhysicalNetworkName,
+			VLANID:              vlanID,
+		}) {
+			return &localnetNotAllowedError{physicalNetworkName: cfg.PhysicalNetworkName, vlanID: vlanID}
+		}
+	}
+	return nil
+}
+
 func (c *Controller) syncUserDefinedNetwork(udn *userdefinednetworkv1.UserDefinedNetwork) (*netv1.NetworkAttachmentDefinition, error) {
 	if udn == nil {
 		return nil, nil
@@ -406,6 +536,10 @@ func (c *Controller) syncUserDefinedNetwork(udn *userdefinednetworkv1.UserDefine
 		return nil, nil
 	}
 
+	if err := validateLocalnetAllowed(udn); err != nil {
+		return nil, err
+	}
+
 	if finalizerAdded := controllerutil.AddFinalizer(udn, template.FinalizerUserDefinedNetwork); finalizerAdded {
 		udn, err := c.udnClient.K8sV1().UserDefinedNetworks(udn.Namespace).Update(context.Background(), udn, metav1.UpdateOptions{})
 		if err != nil {
@@ -474,6 +608,10 @@ func newNetworkCreatedCondition(nad *netv1.NetworkAttachmentDefinition, syncErro
 		networkCreatedCondition.Status = metav1.ConditionFalse
 		networkCreatedCondition.Reason = "SyncError"
 		networkCreatedCondition.Message = syncError.Error()
+		var localnetNotAllowed *localnetNotAllowedError
+		if errors.As(syncError, &localnetNotAllowed) {
+			networkCreatedCondition.Reason = "LocalnetNotAllowed"
+		}
 	}
 
 	return networkCreatedCondition
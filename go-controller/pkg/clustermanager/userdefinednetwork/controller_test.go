@@ -139,6 +139,105 @@ var _ = Describe("User Defined Network Controller", func() {
 				Expect(nad).To(Equal(expectedNAD))
 			})
 
+			It("should create NAD for localnet UDN when physical network and VLAN are allowed", func() {
+				config.ClusterManager.UDNAllowedLocalnets = []config.LocalnetAllowListEntry{
+					{PhysicalNetworkName: "physnet1", VLANID: 100},
+				}
+				udn := testLocalnetUDN("physnet1", 100)
+				expectedNAD := testNAD()
+				c = newTestController(renderNadStub(expectedNAD), udn, testNamespace("test"))
+				Expect(c.Run()).To(Succeed())
+
+				Eventually(func() []metav1.Condition {
+					udn, err := cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return normalizeConditions(udn.Status.Conditions)
+				}).Should(Equal([]metav1.Condition{{
+					Type:    "NetworkCreated",
+					Status:  "True",
+					Reason:  "NetworkAttachmentDefinitionCreated",
+					Message: "NetworkAttachmentDefinition has been created",
+				}}))
+
+				nad, err := cs.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(nad).To(Equal(expectedNAD))
+			})
+
+			It("should fail when localnet UDN physical network and VLAN are not allowed", func() {
+				config.ClusterManager.UDNAllowedLocalnets = []config.LocalnetAllowListEntry{
+					{PhysicalNetworkName: "physnet1"},
+				}
+				udn := testLocalnetUDN("physnet1", 100)
+				c = newTestController(renderNadStub(testNAD()), udn, testNamespace("test"))
+				Expect(c.Run()).To(Succeed())
+
+				Eventually(func() []metav1.Condition {
+					udn, err := cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return normalizeConditions(udn.Status.Conditions)
+				}).Should(Equal([]metav1.Condition{{
+					Type:    "NetworkCreated",
+					Status:  "False",
+					Reason:  "LocalnetNotAllowed",
+					Message: `physical network "physnet1" with VLAN 100 is not allowed for UserDefinedNetworks`,
+				}}))
+
+				_, err := cs.NetworkAttchDefClient.K8sCniCncfIoV1().NetworkAttachmentDefinitions(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("should fail when a VLAN of a trunk localnet UDN is not allowed", func() {
+				config.ClusterManager.UDNAllowedLocalnets = []config.LocalnetAllowListEntry{
+					{PhysicalNetworkName: "physnet1"},
+					{PhysicalNetworkName: "physnet1", VLANID: 100},
+					{PhysicalNetworkName: "physnet1", VLANID: 101},
+				}
+				udn := testLocalnetUDN("physnet1", 0)
+				udn.Spec.Localnet.VLAN = &udnv1.VLANConfig{
+					Mode:  udnv1.VLANModeTrunk,
+					Trunk: &udnv1.TrunkVLANConfig{AllowedVLANs: []string{"100-102"}, NativeVLAN: 10},
+				}
+				c = newTestController(renderNadStub(testNAD()), udn, testNamespace("test"))
+				Expect(c.Run()).To(Succeed())
+
+				Eventually(func() []metav1.Condition {
+					udn, err := cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return normalizeConditions(udn.Status.Conditions)
+				}).Should(Equal([]metav1.Condition{{
+					Type:    "NetworkCreated",
+					Status:  "False",
+					Reason:  "LocalnetNotAllowed",
+					Message: `physical network "physnet1" with VLAN 102 is not allowed for UserDefinedNetworks`,
+				}}))
+			})
+
+			It("should fail when a trunk localnet UDN has a native VLAN without untagged access allowed", func() {
+				config.ClusterManager.UDNAllowedLocalnets = []config.LocalnetAllowListEntry{
+					{PhysicalNetworkName: "physnet1", VLANID: 10},
+					{PhysicalNetworkName: "physnet1", VLANID: 100},
+				}
+				udn := testLocalnetUDN("physnet1", 0)
+				udn.Spec.Localnet.VLAN = &udnv1.VLANConfig{
+					Mode:  udnv1.VLANModeTrunk,
+					Trunk: &udnv1.TrunkVLANConfig{AllowedVLANs: []string{"100"}, NativeVLAN: 10},
+				}
+				c = newTestController(renderNadStub(testNAD()), udn, testNamespace("test"))
+				Expect(c.Run()).To(Succeed())
+
+				Eventually(func() []metav1.Condition {
+					udn, err := cs.UserDefinedNetworkClient.K8sV1().UserDefinedNetworks(udn.Namespace).Get(context.Background(), udn.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					return normalizeConditions(udn.Status.Conditions)
+				}).Should(Equal([]metav1.Condition{{
+					Type:    "NetworkCreated",
+					Status:  "False",
+					Reason:  "LocalnetNotAllowed",
+					Message: `physical network "physnet1" without VLAN is not allowed for UserDefinedNetworks`,
+				}}))
+			})
+
 			It("should fail when NAD render fail", func() {
 				udn := testPrimaryUDN()
 				renderErr := errors.New("render NAD fails")
@@ -1104,6 +1203,55 @@ var _ = Describe("User Defined Network Controller", func() {
 		})
 	})
 
+	Context("network capacity update", func() {
+		capacity := []udnv1.SubnetCapacity{
+			{
+				CIDR:        "10.10.0.0/16",
+				HostSubnets: &udnv1.CapacityUsage{Total: 256, Used: 240},
+			},
+		}
+		condition := &metav1.Condition{
+			Type:    "NetworkCapacitySufficient",
+			Status:  "False",
+			Reason:  "CapacityThresholdExceeded",
+			Message: "Network subnets usage is above 80%: 10.10.0.0/16 host subnets 240/256.",
+		}
+
+		It("should report the capacity on the UDN", func() {
+			udn := testPrimaryUDN()
+			c := newTestController(noopRenderNadStub(), udn)
+
+			Expect(c.UpdateNetworkCapacity(util.GenerateUDNNetworkName(udn.Namespace, udn.Name), "test", capacity, condition)).To(Succeed())
+
+			assertUserDefinedNetworkStatus(cs.UserDefinedNetworkClient, udn, &udnv1.UserDefinedNetworkStatus{
+				Conditions: []metav1.Condition{*condition},
+				Capacity:   capacity,
+			})
+		})
+
+		It("should report the capacity on the CUDN", func() {
+			cudn := testClusterUDN("test", "red")
+			c := newTestController(noopRenderNadStub(), cudn)
+
+			Expect(c.UpdateNetworkCapacity(util.GenerateCUDNNetworkName(cudn.Name), "test", capacity, condition)).To(Succeed())
+
+			cudn, err := cs.UserDefinedNetworkClient.K8sV1().ClusterUserDefinedNetworks().Get(context.Background(), cudn.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(normalizeConditions(cudn.Status.Conditions)).To(ConsistOf(*condition))
+			Expect(cudn.Status.Capacity).To(Equal(capacity))
+		})
+
+		It("should not report the capacity of networks not managed by a UDN", func() {
+			c := newTestController(noopRenderNadStub())
+			cs.UserDefinedNetworkClient.(*udnfakeclient.Clientset).PrependReactor("patch", "*", func(testing.Action) (bool, runtime.Object, error) {
+				return true, nil, errors.New("unexpected patch")
+			})
+
+			Expect(c.UpdateNetworkCapacity("net1", "test", capacity, condition)).To(Succeed())
+			Expect(c.UpdateNetworkCapacity(util.GenerateUDNNetworkName("test", "test"), "test", capacity, condition)).To(Succeed())
+		})
+	})
+
 	Context("ClusterUserDefinedNetwork object sync", func() {
 		It("should succeed given no CR", func() {
 			c := newTestController(noopRenderNadStub())
@@ -1422,6 +1570,22 @@ func testSecondaryUDN() *udnv1.UserDefinedNetwork {
 	}
 }
 
+func testLocalnetUDN(physicalNetworkName string, vlanID int32) *udnv1.UserDefinedNetwork {
+	udn := testSecondaryUDN()
+	udn.Spec = udnv1.UserDefinedNetworkSpec{
+		Topology: udnv1.NetworkTopologyLocalnet,
+		Localnet: &udnv1.LocalnetConfig{
+			Role:                udnv1.NetworkRoleSecondary,
+			PhysicalNetworkName: physicalNetworkName,
+			VLAN: &udnv1.VLANConfig{
+				Mode:   udnv1.VLANModeAccess,
+				Access: &udnv1.AccessVLANConfig{ID: vlanID},
+			},
+		},
+	}
+	return udn
+}
+
 func testsUDNWithDeletionTimestamp(ts time.Time) *udnv1.UserDefinedNetwork {
 	udn := testPrimaryUDN()
 	deletionTimestamp := metav1.NewTime(ts)
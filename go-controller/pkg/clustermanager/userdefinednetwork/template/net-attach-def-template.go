@@ -134,7 +134,10 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 		cfg := spec.GetLayer3()
 		netConfSpec.Role = strings.ToLower(string(cfg.Role))
 		netConfSpec.MTU = int(cfg.MTU)
+		netConfSpec.Bandwidth = bandwidthConf(cfg.Bandwidth)
 		netConfSpec.Subnets = layer3SubnetsString(cfg.Subnets)
+		netConfSpec.ExcludeSubnets = cidrString(cfg.ExcludeSubnets)
+		netConfSpec.ReservedSubnets = cidrString(cfg.ReservedSubnets)
 		netConfSpec.JoinSubnet = cidrString(renderJoinSubnets(cfg.Role, cfg.JoinSubnets))
 	case userdefinednetworkv1.NetworkTopologyLayer2:
 		cfg := spec.GetLayer2()
@@ -150,13 +153,17 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 
 		netConfSpec.Role = strings.ToLower(string(cfg.Role))
 		netConfSpec.MTU = int(cfg.MTU)
+		netConfSpec.Bandwidth = bandwidthConf(cfg.Bandwidth)
 		netConfSpec.AllowPersistentIPs = cfg.IPAM != nil && cfg.IPAM.Lifecycle == userdefinednetworkv1.IPAMLifecyclePersistent
 		netConfSpec.Subnets = cidrString(cfg.Subnets)
+		netConfSpec.ExcludeSubnets = cidrString(cfg.ExcludeSubnets)
+		netConfSpec.ReservedSubnets = cidrString(cfg.ReservedSubnets)
 		netConfSpec.JoinSubnet = cidrString(renderJoinSubnets(cfg.Role, cfg.JoinSubnets))
 	case userdefinednetworkv1.NetworkTopologyLocalnet:
 		cfg := spec.GetLocalnet()
 		netConfSpec.Role = strings.ToLower(string(cfg.Role))
 		netConfSpec.MTU = localnetMTU(cfg.MTU)
+		netConfSpec.Bandwidth = bandwidthConf(cfg.Bandwidth)
 		netConfSpec.AllowPersistentIPs = cfg.IPAM != nil && cfg.IPAM.Lifecycle == userdefinednetworkv1.IPAMLifecyclePersistent
 		netConfSpec.Subnets = cidrString(cfg.Subnets)
 		netConfSpec.ExcludeSubnets = cidrString(cfg.ExcludeSubnets)
@@ -165,6 +172,13 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 		if cfg.VLAN != nil && cfg.VLAN.Access != nil {
 			netConfSpec.VLANID = int(cfg.VLAN.Access.ID)
 		}
+		if cfg.VLAN != nil && cfg.VLAN.Trunk != nil {
+			if len(cfg.Subnets) > 0 && cfg.VLAN.Trunk.NativeVLAN == 0 {
+				return nil, fmt.Errorf("vlan.trunk.nativeVLAN is required when subnets are set")
+			}
+			netConfSpec.VLANTrunk = strings.Join(cfg.VLAN.Trunk.AllowedVLANs, ",")
+			netConfSpec.NativeVLANID = int(cfg.VLAN.Trunk.NativeVLAN)
+		}
 	}
 
 	if err := util.ValidateNetConf(nadName, netConfSpec); err != nil {
@@ -191,6 +205,9 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 	if mtu := netConfSpec.MTU; mtu > 0 {
 		cniNetConf["mtu"] = mtu
 	}
+	if netConfSpec.Bandwidth != nil {
+		cniNetConf["bandwidth"] = netConfSpec.Bandwidth
+	}
 	if len(netConfSpec.JoinSubnet) > 0 {
 		cniNetConf["joinSubnets"] = netConfSpec.JoinSubnet
 	}
@@ -206,9 +223,18 @@ func renderCNINetworkConfig(networkName, nadName string, spec SpecGetter) (map[s
 	if len(netConfSpec.ExcludeSubnets) > 0 {
 		cniNetConf["excludeSubnets"] = netConfSpec.ExcludeSubnets
 	}
+	if len(netConfSpec.ReservedSubnets) > 0 {
+		cniNetConf["reservedSubnets"] = netConfSpec.ReservedSubnets
+	}
 	if netConfSpec.VLANID != 0 {
 		cniNetConf["vlanID"] = netConfSpec.VLANID
 	}
+	if netConfSpec.VLANTrunk != "" {
+		cniNetConf["vlanTrunk"] = netConfSpec.VLANTrunk
+	}
+	if netConfSpec.NativeVLANID != 0 {
+		cniNetConf["nativeVlanID"] = netConfSpec.NativeVLANID
+	}
 	return cniNetConf, nil
 }
 
@@ -223,6 +249,16 @@ func localnetMTU(desiredMTU int32) int {
 	return mtu
 }
 
+func bandwidthConf(bandwidth *userdefinednetworkv1.Bandwidth) *ovncnitypes.BandwidthConf {
+	if bandwidth == nil {
+		return nil
+	}
+	return &ovncnitypes.BandwidthConf{
+		IngressRate: bandwidth.IngressRate,
+		EgressRate:  bandwidth.EgressRate,
+	}
+}
+
 func ipamEnabled(ipam *userdefinednetworkv1.IPAMConfig) bool {
 	return ipam == nil || ipam.Mode == "" || ipam.Mode == userdefinednetworkv1.IPAMEnabled
 }
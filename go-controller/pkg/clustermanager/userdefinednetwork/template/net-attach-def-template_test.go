@@ -219,6 +219,27 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				},
 			}},
 		),
+		Entry("UDN, layer2: reservedSubnets not in range of subnets",
+			&udnv1.UserDefinedNetwork{Spec: udnv1.UserDefinedNetworkSpec{
+				Topology: udnv1.NetworkTopologyLayer2,
+				Layer2: &udnv1.Layer2Config{
+					Role:            udnv1.NetworkRoleSecondary,
+					Subnets:         udnv1.DualStackCIDRs{"192.168.100.0/24"},
+					ReservedSubnets: []udnv1.CIDR{"192.168.200.0/28"},
+				},
+			}},
+		),
+		Entry("UDN, layer3: reservedSubnets overlapping with excludeSubnets",
+			&udnv1.UserDefinedNetwork{Spec: udnv1.UserDefinedNetworkSpec{
+				Topology: udnv1.NetworkTopologyLayer3,
+				Layer3: &udnv1.Layer3Config{
+					Role:            udnv1.NetworkRoleSecondary,
+					Subnets:         []udnv1.Layer3Subnet{{CIDR: "192.168.0.0/16"}},
+					ExcludeSubnets:  []udnv1.CIDR{"192.168.1.0/24"},
+					ReservedSubnets: []udnv1.CIDR{"192.168.1.0/28"},
+				},
+			}},
+		),
 		Entry("CUDN, invalid topology: topology layer2 & layer3 config",
 			&udnv1.ClusterUserDefinedNetwork{Spec: udnv1.ClusterUserDefinedNetworkSpec{Network: udnv1.NetworkSpec{
 				Topology: udnv1.NetworkTopologyLayer2, Layer3: &udnv1.Layer3Config{}}}},
@@ -252,6 +273,24 @@ var _ = Describe("NetAttachDefTemplate", func() {
 				},
 			}}},
 		),
+		Entry("CUDN, localnet: trunk VLAN with subnets and no native VLAN",
+			&udnv1.ClusterUserDefinedNetwork{Spec: udnv1.ClusterUserDefinedNetworkSpec{Network: udnv1.NetworkSpec{
+				Topology: udnv1.NetworkTopologyLocalnet,
+				Localnet: &udnv1.LocalnetConfig{Role: udnv1.NetworkRoleSecondary, PhysicalNetworkName: "localnet1",
+					Subnets: udnv1.DualStackCIDRs{"192.168.0.0/16"},
+					VLAN:    &udnv1.VLANConfig{Mode: udnv1.VLANModeTrunk, Trunk: &udnv1.TrunkVLANConfig{AllowedVLANs: []string{"100"}}},
+				},
+			}}},
+		),
+		Entry("CUDN, localnet: trunk VLAN with invalid VLAN range",
+			&udnv1.ClusterUserDefinedNetwork{Spec: udnv1.ClusterUserDefinedNetworkSpec{Network: udnv1.NetworkSpec{
+				Topology: udnv1.NetworkTopologyLocalnet,
+				Localnet: &udnv1.LocalnetConfig{Role: udnv1.NetworkRoleSecondary, PhysicalNetworkName: "localnet1",
+					IPAM: &udnv1.IPAMConfig{Mode: udnv1.IPAMDisabled},
+					VLAN: &udnv1.VLANConfig{Mode: udnv1.VLANModeTrunk, Trunk: &udnv1.TrunkVLANConfig{AllowedVLANs: []string{"210-200"}}},
+				},
+			}}},
+		),
 	)
 
 	It("should return no error given no UDN", func() {
@@ -356,6 +395,29 @@ var _ = Describe("NetAttachDefTemplate", func() {
 			  "allowPersistentIPs": true
         	}`,
 		),
+		Entry("primary network, layer2, with bandwidth",
+			udnv1.UserDefinedNetworkSpec{
+				Topology: udnv1.NetworkTopologyLayer2,
+				Layer2: &udnv1.Layer2Config{
+					Role:      udnv1.NetworkRolePrimary,
+					Subnets:   udnv1.DualStackCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
+					MTU:       1500,
+					Bandwidth: &udnv1.Bandwidth{IngressRate: 100000000, EgressRate: 50000000},
+				},
+			},
+			`{
+			  "cniVersion": "1.0.0",
+			  "type": "ovn-k8s-cni-overlay",
+			  "name": "mynamespace_test-net",
+			  "netAttachDefName": "mynamespace/test-net",
+			  "role": "primary",
+			  "topology": "layer2",
+			  "joinSubnets": "100.65.0.0/16,fd99::/64",
+			  "subnets": "192.168.100.0/24,2001:dbb::/64",
+			  "mtu": 1500,
+			  "bandwidth": {"ingressRate": 100000000, "egressRate": 50000000}
+			}`,
+		),
 		Entry("primary network, should override join-subnets when specified",
 			udnv1.UserDefinedNetworkSpec{
 				Topology: udnv1.NetworkTopologyLayer2,
@@ -382,6 +444,82 @@ var _ = Describe("NetAttachDefTemplate", func() {
 			  "allowPersistentIPs": true
 			}`,
 		),
+		Entry("primary network, layer3, with appended subnets",
+			udnv1.UserDefinedNetworkSpec{
+				Topology: udnv1.NetworkTopologyLayer3,
+				Layer3: &udnv1.Layer3Config{
+					Role: udnv1.NetworkRolePrimary,
+					Subnets: []udnv1.Layer3Subnet{
+						{CIDR: "192.168.0.0/16", HostSubnet: 24},
+						{CIDR: "2001:dbb::/60"},
+						{CIDR: "10.100.0.0/16", HostSubnet: 24},
+					},
+					MTU: 1500,
+				},
+			},
+			`{
+				"cniVersion": "1.0.0",
+				"type": "ovn-k8s-cni-overlay",
+				"name": "mynamespace_test-net",
+				"netAttachDefName": "mynamespace/test-net",
+				"role": "primary",
+				"topology": "layer3",
+				"joinSubnets": "100.65.0.0/16,fd99::/64",
+				"subnets": "192.168.0.0/16/24,2001:dbb::/60,10.100.0.0/16/24",
+				"mtu": 1500
+			}`,
+		),
+		Entry("primary network, layer3, with excluded and reserved subnets",
+			udnv1.UserDefinedNetworkSpec{
+				Topology: udnv1.NetworkTopologyLayer3,
+				Layer3: &udnv1.Layer3Config{
+					Role: udnv1.NetworkRolePrimary,
+					Subnets: []udnv1.Layer3Subnet{
+						{CIDR: "192.168.0.0/16"},
+					},
+					ExcludeSubnets:  []udnv1.CIDR{"192.168.0.0/28"},
+					ReservedSubnets: []udnv1.CIDR{"192.168.10.0/24", "192.168.11.5/32"},
+					MTU:             1500,
+				},
+			},
+			`{
+				"cniVersion": "1.0.0",
+				"type": "ovn-k8s-cni-overlay",
+				"name": "mynamespace_test-net",
+				"netAttachDefName": "mynamespace/test-net",
+				"role": "primary",
+				"topology": "layer3",
+				"joinSubnets": "100.65.0.0/16,fd99::/64",
+				"subnets": "192.168.0.0/16",
+				"excludeSubnets": "192.168.0.0/28",
+				"reservedSubnets": "192.168.10.0/24,192.168.11.5/32",
+				"mtu": 1500
+			}`,
+		),
+		Entry("secondary network, layer2, with excluded and reserved subnets",
+			udnv1.UserDefinedNetworkSpec{
+				Topology: udnv1.NetworkTopologyLayer2,
+				Layer2: &udnv1.Layer2Config{
+					Role:            udnv1.NetworkRoleSecondary,
+					Subnets:         udnv1.DualStackCIDRs{"192.168.100.0/24", "2001:dbb::/64"},
+					ExcludeSubnets:  []udnv1.CIDR{"192.168.100.0/30", "2001:dbb::/126"},
+					ReservedSubnets: []udnv1.CIDR{"192.168.100.128/25"},
+					MTU:             1500,
+				},
+			},
+			`{
+			  "cniVersion": "1.0.0",
+			  "type": "ovn-k8s-cni-overlay",
+			  "name": "mynamespace_test-net",
+			  "netAttachDefName": "mynamespace/test-net",
+			  "role": "secondary",
+			  "topology": "layer2",
+			  "subnets": "192.168.100.0/24,2001:dbb::/64",
+			  "excludeSubnets": "192.168.100.0/30,2001:dbb::/126",
+			  "reservedSubnets": "192.168.100.128/25",
+			  "mtu": 1500
+			}`,
+		),
 		Entry("secondary network, no join-subnets should be set",
 			udnv1.UserDefinedNetworkSpec{
 				Topology: udnv1.NetworkTopologyLayer2,
@@ -406,6 +544,32 @@ var _ = Describe("NetAttachDefTemplate", func() {
 			  "allowPersistentIPs": true
 			}`,
 		),
+		Entry("secondary network, localnet",
+			udnv1.UserDefinedNetworkSpec{
+				Topology: udnv1.NetworkTopologyLocalnet,
+				Localnet: &udnv1.LocalnetConfig{
+					Role:                udnv1.NetworkRoleSecondary,
+					PhysicalNetworkName: "physnet1",
+					Subnets:             udnv1.DualStackCIDRs{"192.168.100.0/24"},
+					VLAN: &udnv1.VLANConfig{
+						Mode:   udnv1.VLANModeAccess,
+						Access: &udnv1.AccessVLANConfig{ID: 200},
+					},
+				},
+			},
+			`{
+			  "cniVersion": "1.0.0",
+			  "type": "ovn-k8s-cni-overlay",
+			  "name": "mynamespace_test-net",
+			  "netAttachDefName": "mynamespace/test-net",
+			  "role": "secondary",
+			  "topology": "localnet",
+			  "subnets": "192.168.100.0/24",
+			  "physicalNetworkName": "physnet1",
+			  "vlanID": 200,
+			  "mtu": 1500
+			}`,
+		),
 	)
 
 	DescribeTable("should create CUDN NAD from spec",
@@ -600,5 +764,55 @@ var _ = Describe("NetAttachDefTemplate", func() {
 			  "allowPersistentIPs": true
 			}`,
 		),
+		Entry("secondary network, localnet, with bandwidth",
+			udnv1.NetworkSpec{
+				Topology: udnv1.NetworkTopologyLocalnet,
+				Localnet: &udnv1.LocalnetConfig{
+					Role:                udnv1.NetworkRoleSecondary,
+					PhysicalNetworkName: "mylocalnet1",
+					Subnets:             udnv1.DualStackCIDRs{"192.168.100.0/24"},
+					Bandwidth:           &udnv1.Bandwidth{EgressRate: 10000000},
+				},
+			},
+			`{
+			  "cniVersion": "1.0.0",
+			  "type": "ovn-k8s-cni-overlay",
+			  "name": "cluster_udn_test-net",
+			  "netAttachDefName": "mynamespace/test-net",
+			  "role": "secondary",
+			  "topology": "localnet",
+			  "physicalNetworkName": "mylocalnet1",
+			  "subnets": "192.168.100.0/24",
+			  "mtu": 1500,
+			  "bandwidth": {"egressRate": 10000000}
+			}`,
+		),
+		Entry("secondary network, localnet, trunk VLAN",
+			udnv1.NetworkSpec{
+				Topology: udnv1.NetworkTopologyLocalnet,
+				Localnet: &udnv1.LocalnetConfig{
+					Role:                udnv1.NetworkRoleSecondary,
+					PhysicalNetworkName: "mylocalnet1",
+					VLAN: &udnv1.VLANConfig{Mode: udnv1.VLANModeTrunk, Trunk: &udnv1.TrunkVLANConfig{
+						AllowedVLANs: []string{"100", "200-210"},
+						NativeVLAN:   10,
+					}},
+					Subnets: udnv1.DualStackCIDRs{"192.168.100.0/24"},
+				},
+			},
+			`{
+			  "cniVersion": "1.0.0",
+			  "type": "ovn-k8s-cni-overlay",
+			  "name": "cluster_udn_test-net",
+			  "netAttachDefName": "mynamespace/test-net",
+			  "role": "secondary",
+			  "topology": "localnet",
+			  "physicalNetworkName": "mylocalnet1",
+			  "subnets": "192.168.100.0/24",
+			  "mtu": 1500,
+			  "vlanTrunk": "100,200-210",
+			  "nativeVlanID": 10
+			}`,
+		),
 	)
 })
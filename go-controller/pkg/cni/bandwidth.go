@@ -4,20 +4,16 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
-)
 
-func clearPodBandwidth(sandboxID string) error {
-	// interfaces will have the same name as ports
-	portList, err := ovsFind("interface", "name", "external-ids:sandbox="+sandboxID)
-	if err != nil {
-		return err
-	}
+	"k8s.io/apimachinery/pkg/util/sets"
 
-	return clearPodBandwidthForPorts(portList, sandboxID)
-}
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
 
+// clearPodBandwidthForPorts removes the bandwidth limits of the given ports
+// of a sandbox, leaving the ones of its other ports in place.
 func clearPodBandwidthForPorts(portList []string, sandboxID string) error {
-	// Clear the QoS for any ports of this sandbox
+	// Clear the QoS for the given ports of this sandbox
 	for _, port := range portList {
 		if err := ovsClear("port", port, "qos"); err != nil {
 			return err
@@ -25,12 +21,28 @@ func clearPodBandwidthForPorts(portList []string, sandboxID string) error {
 	}
 
 	// Now that the QoS is unused remove it
-	qosList, err := ovsFind("qos", "_uuid", "external-ids:sandbox="+sandboxID)
+	qosList, err := ovsFind("qos", "_uuid,external_ids", "external-ids:sandbox="+sandboxID)
 	if err != nil {
 		return err
 	}
+	ports := sets.New(portList...)
 	for _, qos := range qosList {
-		if err := ovsDestroy("qos", qos); err != nil {
+		uuid, externalIDs, _ := strings.Cut(qos, ",")
+		port := util.GetExternalIDValByKey(strings.Trim(externalIDs, "\""), "port")
+		if port == "" {
+			// QoS created before they were tracked per port might still be
+			// in use by another port of the sandbox
+			users, err := ovsFind("port", "name", "qos="+uuid)
+			if err != nil {
+				return err
+			}
+			if len(users) > 0 {
+				continue
+			}
+		} else if !ports.Has(port) {
+			continue
+		}
+		if err := ovsDestroy("qos", uuid); err != nil {
 			return err
 		}
 	}
@@ -42,7 +54,8 @@ func setPodBandwidth(sandboxID, ifname string, ingressBPS, egressBPS int64) erro
 	// note pod ingress == OVS egress and vice versa
 
 	if ingressBPS > 0 {
-		qos, err := ovsCreate("qos", "type=linux-htb", fmt.Sprintf("other-config:max-rate=%d", ingressBPS), "external-ids=sandbox="+sandboxID)
+		qos, err := ovsCreate("qos", "type=linux-htb", fmt.Sprintf("other-config:max-rate=%d", ingressBPS),
+			fmt.Sprintf("external-ids={sandbox=%s,port=%s}", sandboxID, ifname))
 		if err != nil {
 			return err
 		}
@@ -2,6 +2,7 @@ package cni
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"strings"
@@ -84,6 +85,29 @@ func extractPodBandwidth(podAnnotations map[string]string, dir direction) (int64
 	return bwVal.Value(), nil
 }
 
+// extractNetworkBandwidth returns the bandwidth limit of the pod interface
+// requested in its network selection element or, when not requested, the
+// default one of the network.
+func extractNetworkBandwidth(conf *ovncnitypes.NetConf, dir direction) (int64, error) {
+	for _, bandwidth := range []*ovncnitypes.BandwidthConf{conf.RuntimeConfig.Bandwidth, conf.Bandwidth} {
+		if bandwidth == nil {
+			continue
+		}
+		rate := bandwidth.EgressRate
+		if dir == Ingress {
+			rate = bandwidth.IngressRate
+		}
+		if rate == 0 {
+			continue
+		}
+		if err := validateBandwidthIsReasonable(resource.NewQuantity(rate, resource.DecimalSI)); err != nil {
+			return 0, fmt.Errorf("invalid %s bandwidth of network %s: %w", dir, conf.Name, err)
+		}
+		return rate, nil
+	}
+	return 0, BandwidthNotFound
+}
+
 func (pr *PodRequest) String() string {
 	return fmt.Sprintf("[%s/%s %s network %s NAD %s]", pr.PodNamespace, pr.PodName, pr.SandboxID, pr.netName, pr.nadName)
 }
@@ -305,11 +329,64 @@ func (pr *PodRequest) cmdDel(clientset *ClientSet) (*Response, error) {
 	return response, nil
 }
 
-func (pr *PodRequest) cmdCheck() error {
-	// noop...CMD check is not considered useful, and has a considerable performance impact
-	// to pod bring up times with CRIO. This is due to the fact that CRIO currently calls check
-	// after CNI ADD before it finishes bringing the container up
-	return nil
+// cmdCheck verifies that the pod networking is still configured as on ADD,
+// as thoroughly as configured by the CNI check mode. It is disabled by default
+// since some runtimes call CHECK right after ADD, delaying the pod start up.
+func (pr *PodRequest) cmdCheck(clientset *ClientSet, networkManager networkmanager.Interface) error {
+	if config.CNI.CheckMode == config.CNICheckModeDisabled {
+		return nil
+	}
+
+	namespace := pr.PodNamespace
+	podName := pr.PodName
+	if namespace == "" || podName == "" {
+		return fmt.Errorf("required CNI variable missing")
+	}
+	if config.UnprivilegedMode {
+		// the pod interface was configured by the CNI shim
+		return nil
+	}
+
+	pod, err := clientset.getPod(namespace, podName)
+	if err != nil {
+		return fmt.Errorf("failed to get pod: %w", err)
+	}
+	if err = pr.checkOrUpdatePodUID(pod); err != nil {
+		return err
+	}
+	podNADAnnotation, err := util.UnmarshalPodAnnotation(pod.Annotations, pr.nadName)
+	if err != nil {
+		return fmt.Errorf("failed to get pod annotation: %w", err)
+	}
+	podInterfaceInfo, err := pr.buildPodInterfaceInfo(pod.Annotations, podNADAnnotation, "")
+	if err != nil {
+		return err
+	}
+	podInterfaceInfo.SkipIPConfig = kubevirt.IsPodLiveMigratable(pod)
+
+	checkNetns := config.CNI.CheckMode == config.CNICheckModeFull
+	if err = podRequestInterfaceOps.CheckInterface(pr, podInterfaceInfo, checkNetns); err != nil {
+		return err
+	}
+
+	if !util.IsNetworkSegmentationSupportEnabled() {
+		return nil
+	}
+	primaryUDN := udn.NewPrimaryNetwork(networkManager)
+	annotCondFn := primaryUDN.WaitForPrimaryAnnotationFn(podName, namespace, isOvnReady)
+	if _, isReady := annotCondFn(pod.Annotations, pr.nadName); !isReady {
+		return fmt.Errorf("primary user defined network annotation is not ready")
+	}
+	if !primaryUDN.Found() {
+		return nil
+	}
+	primaryUDNPodRequest := pr.buildPrimaryUDNPodRequest(pod, primaryUDN)
+	defer primaryUDNPodRequest.cancel()
+	primaryUDNPodInfo, err := primaryUDNPodRequest.buildPodInterfaceInfo(pod.Annotations, primaryUDN.Annotation(), primaryUDN.NetworkDevice())
+	if err != nil {
+		return err
+	}
+	return podRequestInterfaceOps.CheckInterface(primaryUDNPodRequest, primaryUDNPodInfo, checkNetns)
 }
 
 // HandlePodRequest is the callback for all the requests
@@ -335,7 +412,7 @@ func HandlePodRequest(
 	case CNIDel:
 		response, err = request.cmdDel(clientset)
 	case CNICheck:
-		err = request.cmdCheck()
+		err = request.cmdCheck(clientset, networkManager)
 	default:
 	}
 
@@ -416,7 +493,8 @@ func (pr *PodRequest) buildPrimaryUDNPodRequest(
 		CNIConf: &ovncnitypes.NetConf{
 			// primary UDN MTU will be taken from config.Default.MTU
 			// if not specified at the NAD
-			MTU: primaryUDN.MTU(),
+			MTU:       primaryUDN.MTU(),
+			Bandwidth: primaryUDN.Bandwidth(),
 		},
 		timestamp:  time.Now(),
 		IsVFIO:     pr.IsVFIO,
@@ -429,7 +507,7 @@ func (pr *PodRequest) buildPrimaryUDNPodRequest(
 }
 
 func (pr *PodRequest) buildPodInterfaceInfo(annotations map[string]string, podAnnotation *util.PodAnnotation, netDevice string) (*PodInterfaceInfo, error) {
-	return PodAnnotation2PodInfo(
+	podInterfaceInfo, err := PodAnnotation2PodInfo(
 		annotations,
 		podAnnotation,
 		pr.PodUID,
@@ -438,6 +516,26 @@ func (pr *PodRequest) buildPodInterfaceInfo(annotations map[string]string, podAn
 		pr.netName,
 		pr.CNIConf.MTU,
 	)
+	if err != nil {
+		return nil, err
+	}
+	// the bandwidth requested for the network takes precedence over the one
+	// of the pod annotations
+	ingress, err := extractNetworkBandwidth(pr.CNIConf, Ingress)
+	if err != nil && !errors.Is(err, BandwidthNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		podInterfaceInfo.Ingress = ingress
+	}
+	egress, err := extractNetworkBandwidth(pr.CNIConf, Egress)
+	if err != nil && !errors.Is(err, BandwidthNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		podInterfaceInfo.Egress = egress
+	}
+	return podInterfaceInfo, nil
 }
 
 func checkBridgeMapping(ovsClient client.Client, topology string, networkName string) error {
@@ -34,6 +34,7 @@ import (
 
 type podRequestInterfaceOpsStub struct {
 	unconfiguredInterfaces []*PodInterfaceInfo
+	checkedInterfaces      []*PodInterfaceInfo
 }
 
 func (stub *podRequestInterfaceOpsStub) ConfigureInterface(*PodRequest, PodInfoGetter, *PodInterfaceInfo) ([]*current.Interface, error) {
@@ -43,6 +44,10 @@ func (stub *podRequestInterfaceOpsStub) UnconfigureInterface(_ *PodRequest, ifIn
 	stub.unconfiguredInterfaces = append(stub.unconfiguredInterfaces, ifInfo)
 	return nil
 }
+func (stub *podRequestInterfaceOpsStub) CheckInterface(_ *PodRequest, ifInfo *PodInterfaceInfo, _ bool) error {
+	stub.checkedInterfaces = append(stub.checkedInterfaces, ifInfo)
+	return nil
+}
 
 var _ = Describe("Network Segmentation", func() {
 	var (
@@ -61,6 +66,7 @@ var _ = Describe("Network Segmentation", func() {
 		}
 		prInterfaceOpsStub                            = &podRequestInterfaceOpsStub{}
 		enableMultiNetwork, enableNetworkSegmentation bool
+		cniCheckMode                                  string
 	)
 
 	BeforeEach(func() {
@@ -69,6 +75,7 @@ var _ = Describe("Network Segmentation", func() {
 		config.IPv6Mode = true
 		enableMultiNetwork = config.OVNKubernetesFeature.EnableMultiNetwork
 		enableNetworkSegmentation = config.OVNKubernetesFeature.EnableNetworkSegmentation
+		cniCheckMode = config.CNI.CheckMode
 
 		podRequestInterfaceOps = prInterfaceOpsStub
 
@@ -109,6 +116,7 @@ var _ = Describe("Network Segmentation", func() {
 	AfterEach(func() {
 		config.OVNKubernetesFeature.EnableMultiNetwork = enableMultiNetwork
 		config.OVNKubernetesFeature.EnableNetworkSegmentation = enableNetworkSegmentation
+		config.CNI.CheckMode = cniCheckMode
 
 		podRequestInterfaceOps = &defaultPodRequestInterfaceOps{}
 	})
@@ -140,6 +148,20 @@ var _ = Describe("Network Segmentation", func() {
 			Expect(pr.cmdDel(clientSet)).NotTo(BeNil())
 			Expect(prInterfaceOpsStub.unconfiguredInterfaces).To(HaveLen(1))
 		})
+		It("should not check the pod interface at cmdCheck when the check mode is disabled", func() {
+			config.CNI.CheckMode = config.CNICheckModeDisabled
+			prInterfaceOpsStub.checkedInterfaces = nil
+			Expect(pr.cmdCheck(clientSet, networkmanager.Default().Interface())).To(Succeed())
+			Expect(prInterfaceOpsStub.checkedInterfaces).To(BeEmpty())
+		})
+		It("should check the pod interface at cmdCheck", func() {
+			config.CNI.CheckMode = config.CNICheckModeOVS
+			prInterfaceOpsStub.checkedInterfaces = nil
+			podNamespaceLister.On("Get", pr.PodName).Return(pod, nil)
+			Expect(pr.cmdCheck(clientSet, networkmanager.Default().Interface())).To(Succeed())
+			Expect(prInterfaceOpsStub.checkedInterfaces).To(HaveLen(1))
+			Expect(prInterfaceOpsStub.checkedInterfaces[0].MAC.String()).To(Equal("0a:58:fd:98:00:01"))
+		})
 
 	})
 	Context("with network segmentation fg enabled and annotation with role field", func() {
@@ -247,6 +269,17 @@ var _ = Describe("Network Segmentation", func() {
 				getCNIResultStub = dummyGetCNIResult
 			})
 
+			It("should check both the default net and the primary UDN interfaces at cmdCheck", func() {
+				config.CNI.CheckMode = config.CNICheckModeFull
+				prInterfaceOpsStub.checkedInterfaces = nil
+				podNamespaceLister.On("Get", pr.PodName).Return(pod, nil)
+				Expect(pr.cmdCheck(clientSet, fakeNetworkManager)).To(Succeed())
+				Expect(prInterfaceOpsStub.checkedInterfaces).To(HaveLen(2))
+				Expect(prInterfaceOpsStub.checkedInterfaces[0].NetName).To(Equal(ovntypes.DefaultNetworkName))
+				Expect(prInterfaceOpsStub.checkedInterfaces[1].NetName).To(Equal("tenantred"))
+				Expect(prInterfaceOpsStub.checkedInterfaces[1].MAC.String()).To(Equal("02:03:04:05:06:07"))
+			})
+
 			It("should return the information of both the default net and the primary UDN in the result", func() {
 				podNamespaceLister.On("Get", pr.PodName).Return(pod, nil)
 				ovsClient, err := newOVSClientWithExternalIDs(map[string]string{})
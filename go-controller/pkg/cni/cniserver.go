@@ -220,6 +220,13 @@ func (s *Server) handleCNIRequest(r *http.Request) ([]byte, error) {
 	if err := json.Unmarshal(b, &cr); err != nil {
 		return nil, err
 	}
+	// STATUS and GC are not pod requests
+	switch command(cr.Env["CNI_COMMAND"]) {
+	case CNIStatus:
+		return nil, s.handleStatus()
+	case CNIGC:
+		return nil, s.handleGC(&cr)
+	}
 	req, err := cniRequestToPodRequest(&cr)
 	if err != nil {
 		return nil, err
@@ -234,6 +241,44 @@ func (s *Server) handleCNIRequest(r *http.Request) ([]byte, error) {
 	return result, nil
 }
 
+// AddStatusCheck registers a check that fails when ovnkube-node is not ready
+// to set up pod networking. The checks are run on CNI STATUS requests.
+func (s *Server) AddStatusCheck(check func() error) {
+	s.statusChecks = append(s.statusChecks, check)
+}
+
+// handleStatus returns an error if ovnkube-node is not ready to set up pod
+// networking
+func (s *Server) handleStatus() error {
+	if s.ovsClient != nil && !s.ovsClient.Connected() {
+		return fmt.Errorf("not connected to the OVS database")
+	}
+	for _, check := range s.statusChecks {
+		if err := check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// handleGC removes the pod interfaces of the network that do not belong to
+// any of the attachments the runtime still considers valid
+func (s *Server) handleGC(cr *Request) error {
+	conf, err := config.ReadCNIConfig(cr.Config)
+	if err != nil {
+		return fmt.Errorf("broken stdin args")
+	}
+	if config.UnprivilegedMode || config.OvnKubeNode.Mode == types.NodeModeDPUHost {
+		// the pod OVS ports are not handled here
+		return nil
+	}
+	nadName := types.DefaultNetworkName
+	if conf.Name != types.DefaultNetworkName {
+		nadName = conf.NADName
+	}
+	return gcPodInterfaces(conf.Name, nadName, conf.ValidAttachments)
+}
+
 func (s *Server) handleCNIMetrics(w http.ResponseWriter, r *http.Request) {
 	var cm CNIRequestMetrics
 
@@ -16,8 +16,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/containernetworking/cni/pkg/skel"
 	cnitypes "github.com/containernetworking/cni/pkg/types"
 	cni020 "github.com/containernetworking/cni/pkg/types/020"
+	"github.com/containernetworking/cni/pkg/version"
 	nadapi "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 
 	"k8s.io/client-go/kubernetes/fake"
@@ -25,8 +27,10 @@ import (
 
 	"github.com/ovn-org/libovsdb/client"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
@@ -105,6 +109,8 @@ func TestCNIServer(t *testing.T) {
 	}
 	// override request handler
 	s.handlePodRequestFunc = serverHandleCNI
+	var statusErr error
+	s.AddStatusCheck(func() error { return statusErr })
 	if err := s.Start(tmpDir); err != nil {
 		t.Fatalf("error starting CNI server: %v", err)
 	}
@@ -131,6 +137,7 @@ func TestCNIServer(t *testing.T) {
 		name        string
 		request     *Request
 		result      cnitypes.Result
+		statusErr   error
 		errorPrefix string
 	}
 
@@ -214,6 +221,30 @@ func TestCNIServer(t *testing.T) {
 			},
 			result: nil,
 		},
+		// Normal STATUS request
+		{
+			name: "STATUS",
+			request: &Request{
+				Env: map[string]string{
+					"CNI_COMMAND": string(CNIStatus),
+				},
+				Config: []byte(cniConfig),
+			},
+			result: nil,
+		},
+		// STATUS request when not ready
+		{
+			name: "STATUS_NOT_READY",
+			request: &Request{
+				Env: map[string]string{
+					"CNI_COMMAND": string(CNIStatus),
+				},
+				Config: []byte(cniConfig),
+			},
+			result:      nil,
+			statusErr:   fmt.Errorf("management port ovn-k8s-mp0 is down"),
+			errorPrefix: "management port ovn-k8s-mp0 is down",
+		},
 		// Missing CNI_ARGS
 		{
 			name: "ARGS1",
@@ -259,6 +290,7 @@ func TestCNIServer(t *testing.T) {
 	}
 
 	for _, tc := range testcases {
+		statusErr = tc.statusErr
 		body, code := clientDoCNI(t, client, tc.request)
 		if tc.errorPrefix == "" {
 			if code != http.StatusOK {
@@ -283,3 +315,125 @@ func TestCNIServer(t *testing.T) {
 		}
 	}
 }
+
+// runSkel runs the CNI shim command through skel, as the container runtime
+// does, with the given CNI config on stdin
+func runSkel(t *testing.T, p *Plugin, command string, stdinData []byte) *cnitypes.Error {
+	t.Helper()
+	t.Setenv("CNI_COMMAND", command)
+	t.Setenv("CNI_PATH", "/opt/cni/bin")
+
+	stdin, err := os.CreateTemp(t.TempDir(), "stdin")
+	if err != nil {
+		t.Fatalf("failed to create stdin file: %v", err)
+	}
+	defer stdin.Close()
+	if _, err := stdin.Write(stdinData); err != nil {
+		t.Fatalf("failed to write stdin file: %v", err)
+	}
+	if _, err := stdin.Seek(0, io.SeekStart); err != nil {
+		t.Fatalf("failed to rewind stdin file: %v", err)
+	}
+	origStdin := os.Stdin
+	os.Stdin = stdin
+	defer func() { os.Stdin = origStdin }()
+
+	return skel.PluginMainFuncsWithError(
+		skel.CNIFuncs{
+			Add:    p.CmdAdd,
+			Del:    p.CmdDel,
+			Check:  p.CmdCheck,
+			GC:     p.CmdGC,
+			Status: p.CmdStatus,
+		},
+		version.All,
+		"ovn-k8s-cni-overlay")
+}
+
+func TestCNIShimStatusAndGC(t *testing.T) {
+	if err := config.PrepareTestConfig(); err != nil {
+		t.Fatalf("failed to prepare test config: %v", err)
+	}
+	tmpDir, err := utiltesting.MkTmpdir("cnishim")
+	if err != nil {
+		t.Fatalf("failed to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// the runtime only issues STATUS and GC with the CNI config ovnkube-node writes
+	// when its version allows them
+	config.CNI.ConfDir = tmpDir
+	config.Logging.CNIFile = ""
+	if err := config.WriteCNIConfig(); err != nil {
+		t.Fatalf("failed to write CNI config: %v", err)
+	}
+	cniConf, err := os.ReadFile(filepath.Join(tmpDir, config.CNIConfFileName))
+	if err != nil {
+		t.Fatalf("failed to read CNI config: %v", err)
+	}
+
+	fakeClient := fake.NewSimpleClientset()
+	wf, err := factory.NewNodeWatchFactory(&util.OVNNodeClientset{KubeClient: fakeClient}, nodeName)
+	if err != nil {
+		t.Fatalf("failed to create watch factory: %v", err)
+	}
+	if err := wf.Start(); err != nil {
+		t.Fatalf("failed to start watch factory: %v", err)
+	}
+	defer wf.Shutdown()
+	ovsClient, err := newOVSClientWithExternalIDs(map[string]string{})
+	if err != nil {
+		t.Fatalf("failed to call newOVSClientWithExternalIDs: %v", err)
+	}
+	s, err := NewCNIServer(wf, fakeClient, networkmanager.Default().Interface(), ovsClient)
+	if err != nil {
+		t.Fatalf("error creating CNI server: %v", err)
+	}
+	var statusErr error
+	s.AddStatusCheck(func() error { return statusErr })
+	if err := s.Start(tmpDir); err != nil {
+		t.Fatalf("error starting CNI server: %v", err)
+	}
+	p := NewCNIPlugin(filepath.Join(tmpDir, serverSocketName))
+
+	if err := runSkel(t, p, "STATUS", cniConf); err != nil {
+		t.Fatalf("expected STATUS to succeed but got %v", err)
+	}
+
+	statusErr = fmt.Errorf("management port ovn-k8s-mp0 is down")
+	if skelErr := runSkel(t, p, "STATUS", cniConf); skelErr == nil || skelErr.Code != errPluginNotAvailable {
+		t.Fatalf("expected STATUS to report the plugin as not available but got %v", skelErr)
+	}
+
+	var gcConf map[string]interface{}
+	if err := json.Unmarshal(cniConf, &gcConf); err != nil {
+		t.Fatalf("failed to unmarshal CNI config: %v", err)
+	}
+	gcConf["cni.dev/valid-attachments"] = []cnitypes.GCAttachment{{ContainerID: "validsandbox", IfName: "eth0"}}
+	gcStdinData, err := json.Marshal(gcConf)
+	if err != nil {
+		t.Fatalf("failed to marshal GC CNI config: %v", err)
+	}
+	execMock := ovntest.NewFakeExec()
+	if err := SetExec(execMock); err != nil {
+		t.Fatalf("failed to set exec: %v", err)
+	}
+	execMock.AddFakeCmds([]*ovntest.ExpectedCmd{
+		{
+			Cmd: strings.TrimSuffix(genOVSFindCmd("30", "Interface", "name,external_ids", ""), " "),
+			Output: strings.Join([]string{
+				"validsandbox,\"iface-id=ns1_pod1 sandbox=validsandbox\"",
+				"enp1s0f0_1,iface-id=ns1_pod2 sandbox=stalesandbox vf-netdev-name=enp1s0f0v1",
+			}, "\n"),
+		},
+		{Cmd: "ovs-vsctl --timeout=30 --if-exists del-port br-int enp1s0f0_1"},
+		{Cmd: "ovs-vsctl --timeout=30 --if-exists clear port enp1s0f0_1 qos"},
+		{Cmd: genOVSFindCmd("30", "qos", "_uuid,external_ids", "external-ids:sandbox=stalesandbox")},
+	})
+	if err := runSkel(t, p, "GC", gcStdinData); err != nil {
+		t.Fatalf("expected GC to succeed but got %v", err)
+	}
+	if !execMock.CalledMatchesExpected() {
+		t.Fatal(execMock.ErrorDesc())
+	}
+}
@@ -35,6 +35,10 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
+// errPluginNotAvailable is the CNI error code reporting that the plugin is not
+// able to service ADD requests
+const errPluginNotAvailable uint = 50
+
 // Plugin is the structure to hold the endpoint information and the corresponding
 // functions to use it
 type Plugin struct {
@@ -327,9 +331,70 @@ func (p *Plugin) CmdDel(args *skel.CmdArgs) error {
 }
 
 // CmdCheck is the callback for 'checking' container's networking is as expected.
-func (p *Plugin) CmdCheck(_ *skel.CmdArgs) error {
-	// noop...CMD check is not considered useful, and has a considerable performance impact
-	// to pod bring up times with CRIO. This is due to the fact that CRIO currently calls check
-	// after CNI ADD before it finishes bringing the container up
+// The verification is done by the server, according to its CNI check mode.
+func (p *Plugin) CmdCheck(args *skel.CmdArgs) error {
+	var err error
+	var conf *ovntypes.NetConf
+
+	startTime := time.Now()
+	defer func() {
+		p.postMetrics(startTime, CNICheck, err)
+		if err != nil {
+			klog.Errorf("Error on CmdCheck: %v", err)
+		}
+	}()
+
+	// read the config stdin args
+	conf, err = config.ReadCNIConfig(args.StdinData)
+	if err != nil {
+		return err
+	}
+	setupLogging(conf)
+
+	req := newCNIRequest(args, nadapi.DeviceInfo{})
+	_, err = p.doCNI("http://dummy/", req)
+	return err
+}
+
+// CmdStatus is the callback for 'status' cni calls from skel. The plugin is
+// available when ovnkube-node is ready to set up pod networking.
+func (p *Plugin) CmdStatus(args *skel.CmdArgs) error {
+	conf, err := config.ReadCNIConfig(args.StdinData)
+	if err != nil {
+		return err
+	}
+	setupLogging(conf)
+
+	req := newCNIRequest(args, nadapi.DeviceInfo{})
+	if _, err = p.doCNI("http://dummy/", req); err != nil {
+		klog.Errorf("Error on CmdStatus: %v", err)
+		return types.NewError(errPluginNotAvailable, "ovnkube-node is not ready", err.Error())
+	}
 	return nil
 }
+
+// CmdGC is the callback for 'garbage collection' cni calls from skel. The
+// server removes the pod interfaces of the attachments that are not valid.
+func (p *Plugin) CmdGC(args *skel.CmdArgs) error {
+	var err error
+	var conf *ovntypes.NetConf
+
+	startTime := time.Now()
+	defer func() {
+		p.postMetrics(startTime, CNIGC, err)
+		if err != nil {
+			klog.Errorf("Error on CmdGC: %v", err)
+		}
+	}()
+
+	// read the config stdin args
+	conf, err = config.ReadCNIConfig(args.StdinData)
+	if err != nil {
+		return err
+	}
+	setupLogging(conf)
+
+	req := newCNIRequest(args, nadapi.DeviceInfo{})
+	_, err = p.doCNI("http://dummy/", req)
+	return err
+}
@@ -14,12 +14,14 @@ import (
 	"sync"
 	"time"
 
+	cnitypes "github.com/containernetworking/cni/pkg/types"
 	current "github.com/containernetworking/cni/pkg/types/100"
 	"github.com/containernetworking/plugins/pkg/ip"
 	"github.com/containernetworking/plugins/pkg/ns"
 	"github.com/safchain/ethtool"
 	"github.com/vishvananda/netlink"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/knftables"
 
@@ -430,7 +432,7 @@ func getPfEncapIP(deviceID string) (string, error) {
 
 // ConfigureOVS performs OVS configurations in order to set up Pod networking
 func ConfigureOVS(ctx context.Context, namespace, podName, hostIfaceName string,
-	ifInfo *PodInterfaceInfo, sandboxID, deviceID string, getter PodInfoGetter) error {
+	ifInfo *PodInterfaceInfo, sandboxID, netnsPath, deviceID string, getter PodInfoGetter) error {
 
 	ifaceID := util.GetIfaceId(namespace, podName)
 	if ifInfo.NetName != types.DefaultNetworkName {
@@ -531,6 +533,12 @@ func ConfigureOVS(ctx context.Context, namespace, podName, hostIfaceName string,
 		}
 	}
 
+	// the network namespace is recorded so that the routes appended to the pod
+	// annotation can be configured on the running pod
+	if netnsPath != "" {
+		ovsArgs = append(ovsArgs, fmt.Sprintf("external_ids:%s=%s", types.NetnsExternalID, netnsPath))
+	}
+
 	if len(ifInfo.NetdevName) != 0 {
 		// NOTE: For SF representor same external_id is used due to https://github.com/ovn-org/ovn-kubernetes/pull/3054
 		// Review this line when upgrade mechanism will be implemented
@@ -549,7 +557,7 @@ func ConfigureOVS(ctx context.Context, namespace, podName, hostIfaceName string,
 		return fmt.Errorf("failure in plugging pod interface: %v\n  %q", err, out)
 	}
 
-	if err := clearPodBandwidth(sandboxID); err != nil {
+	if err := clearPodBandwidthForPorts([]string{hostIfaceName}, sandboxID); err != nil {
 		return err
 	}
 
@@ -581,6 +589,7 @@ func ConfigureOVS(ctx context.Context, namespace, podName, hostIfaceName string,
 type PodRequestInterfaceOps interface {
 	ConfigureInterface(pr *PodRequest, getter PodInfoGetter, ifInfo *PodInterfaceInfo) ([]*current.Interface, error)
 	UnconfigureInterface(pr *PodRequest, ifInfo *PodInterfaceInfo) error
+	CheckInterface(pr *PodRequest, ifInfo *PodInterfaceInfo, checkNetns bool) error
 }
 
 type defaultPodRequestInterfaceOps struct{}
@@ -625,7 +634,7 @@ func (*defaultPodRequestInterfaceOps) ConfigureInterface(pr *PodRequest, getter
 	// END OCP HACK
 
 	if !ifInfo.IsDPUHostMode {
-		err = ConfigureOVS(pr.ctx, pr.PodNamespace, pr.PodName, hostIface.Name, ifInfo, pr.SandboxID, pr.CNIConf.DeviceID, getter)
+		err = ConfigureOVS(pr.ctx, pr.PodNamespace, pr.PodName, hostIface.Name, ifInfo, pr.SandboxID, pr.Netns, pr.CNIConf.DeviceID, getter)
 		if err != nil {
 			pr.deletePort(hostIface.Name, pr.PodNamespace, pr.PodName)
 			return nil, err
@@ -777,6 +786,9 @@ func (*defaultPodRequestInterfaceOps) UnconfigureInterface(pr *PodRequest, ifInf
 		// delete the port in traditional fashion
 		if hostIfName != "" {
 			pr.deletePort(hostIfName, pr.PodNamespace, pr.PodName)
+			// only clear the bandwidth of the deleted port, the ones of the
+			// other networks of the sandbox are still in use
+			portList = []string{hostIfName}
 		} else {
 			// this is a primary interface deletion and segmentation is enabled, delete all ports
 			// delete happens in reverse order for attached networks, so this is the final deletion
@@ -797,6 +809,130 @@ func (*defaultPodRequestInterfaceOps) UnconfigureInterface(pr *PodRequest, ifInf
 	return nil
 }
 
+// CheckInterface verifies that the pod OVS interface is bound to its OVN
+// logical switch port and, if checkNetns is set, that the container interface
+// is configured as in the pod annotation.
+func (*defaultPodRequestInterfaceOps) CheckInterface(pr *PodRequest, ifInfo *PodInterfaceInfo, checkNetns bool) error {
+	// there is no OVS on DPU hosts
+	if !ifInfo.IsDPUHostMode {
+		if err := checkPodOVSInterface(pr, ifInfo); err != nil {
+			return err
+		}
+	}
+	// the VFIO device is not a netdevice in the container namespace
+	if !checkNetns || pr.IsVFIO {
+		return nil
+	}
+
+	netns, err := ns.GetNS(pr.Netns)
+	if err != nil {
+		return fmt.Errorf("failed to open netns %q: %v", pr.Netns, err)
+	}
+	defer netns.Close()
+	return netns.Do(func(_ ns.NetNS) error {
+		link, err := util.GetNetLinkOps().LinkByName(pr.IfName)
+		if err != nil {
+			return fmt.Errorf("failed to get container interface %s: %v", pr.IfName, err)
+		}
+		return checkNetwork(link, ifInfo)
+	})
+}
+
+// checkPodOVSInterface verifies that the OVS interface of the pod sandbox has
+// the expected iface-id and MAC, and that ovn-controller installed its flows.
+func checkPodOVSInterface(pr *PodRequest, ifInfo *PodInterfaceInfo) error {
+	ifaceID := util.GetIfaceId(pr.PodNamespace, pr.PodName)
+	if ifInfo.NetName != types.DefaultNetworkName {
+		ifaceID = util.GetSecondaryNetworkIfaceId(pr.PodNamespace, pr.PodName, ifInfo.NADName)
+	}
+	names, err := ovsFind("Interface", "name", "external-ids:sandbox="+pr.SandboxID, "external-ids:iface-id="+ifaceID)
+	if err != nil {
+		return fmt.Errorf("failed to find the OVS interface with iface-id %s: %v", ifaceID, err)
+	}
+	if len(names) != 1 {
+		return fmt.Errorf("expected one OVS interface with iface-id %s, found %d", ifaceID, len(names))
+	}
+	ifaceName := names[0]
+	output, err := ovsGetMultiOutput("Interface", ifaceName, []string{"external-ids:attached_mac", "external-ids:ovn-installed"})
+	if err != nil || len(output) != 2 {
+		return fmt.Errorf("failed to get the OVN binding of OVS interface %s: %v", ifaceName, err)
+	}
+	if output[0] != ifInfo.MAC.String() {
+		return fmt.Errorf("OVS interface %s is attached to MAC %s, expected %s", ifaceName, output[0], ifInfo.MAC)
+	}
+	if output[1] != "true" {
+		return fmt.Errorf("OVS interface %s is not ovn-installed", ifaceName)
+	}
+	return nil
+}
+
+// checkNetwork verifies that the container interface is up and has the MAC,
+// IPs and routes set up by setupNetwork.
+func checkNetwork(link netlink.Link, ifInfo *PodInterfaceInfo) error {
+	attrs := link.Attrs()
+	if attrs.Flags&net.FlagUp == 0 {
+		return fmt.Errorf("interface %s is down", attrs.Name)
+	}
+	if len(ifInfo.MAC) > 0 && attrs.HardwareAddr.String() != ifInfo.MAC.String() {
+		return fmt.Errorf("interface %s has MAC %s, expected %s", attrs.Name, attrs.HardwareAddr, ifInfo.MAC)
+	}
+
+	if ifInfo.SkipIPConfig {
+		return nil
+	}
+
+	addrs, err := util.GetNetLinkOps().AddrList(link, netlink.FAMILY_ALL)
+	if err != nil {
+		return fmt.Errorf("failed to list addresses of interface %s: %v", attrs.Name, err)
+	}
+	for _, ip := range ifInfo.IPs {
+		found := false
+		for _, addr := range addrs {
+			if addr.IPNet != nil && addr.IPNet.String() == ip.String() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("interface %s is missing IP address %s", attrs.Name, ip)
+		}
+	}
+
+	routes, err := util.GetNetLinkOps().RouteList(link, netlink.FAMILY_ALL)
+	if err != nil {
+		return fmt.Errorf("failed to list routes of interface %s: %v", attrs.Name, err)
+	}
+	// a nil destination stands for the default route
+	routeDst := func(dst *net.IPNet) string {
+		if dst == nil {
+			return ""
+		}
+		if ones, _ := dst.Mask.Size(); ones == 0 && dst.IP.IsUnspecified() {
+			return ""
+		}
+		return dst.String()
+	}
+	hasRoute := func(dst *net.IPNet, gw net.IP) bool {
+		for _, route := range routes {
+			if routeDst(route.Dst) == routeDst(dst) && (gw == nil || gw.Equal(route.Gw)) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, gw := range ifInfo.Gateways {
+		if !hasRoute(nil, gw) {
+			return fmt.Errorf("interface %s is missing the default route via %s", attrs.Name, gw)
+		}
+	}
+	for _, route := range ifInfo.Routes {
+		if !hasRoute(route.Dest, route.NextHop) {
+			return fmt.Errorf("interface %s is missing route %s via %s", attrs.Name, route.Dest, route.NextHop)
+		}
+	}
+	return nil
+}
+
 func (pr *PodRequest) deletePodConntrack() {
 	if pr.CNIConf.PrevResult == nil {
 		return
@@ -846,6 +982,65 @@ func (pr *PodRequest) deletePorts(ifaces []string, podNamespace, podName string)
 	}
 }
 
+// gcPodInterfaces deletes the OVS ports, and the host side of their veths, of
+// the network pod sandboxes that are not in the given valid attachments. As
+// every pod sandbox is attached to the default network, the ports of all
+// networks are deleted for the sandboxes that are not valid for the default
+// network.
+func gcPodInterfaces(netName, nadName string, validAttachments []cnitypes.GCAttachment) error {
+	validSandboxes := sets.New[string]()
+	for _, attachment := range validAttachments {
+		validSandboxes.Insert(attachment.ContainerID)
+	}
+
+	// the external_ids are quoted as the IP addresses are comma separated
+	interfaces, err := ovsFind("Interface", "name,external_ids")
+	if err != nil {
+		return fmt.Errorf("failed to list OVS interfaces: %w", err)
+	}
+	// keep the sandboxes ordered as found so their cleanup is deterministic
+	staleSandboxes := []string{}
+	staleSandboxPorts := map[string][]string{}
+	for _, iface := range interfaces {
+		name, externalIDs, found := strings.Cut(iface, ",")
+		if !found {
+			continue
+		}
+		externalIDs = strings.Trim(externalIDs, "\"")
+		sandboxID := util.GetExternalIDValByKey(externalIDs, "sandbox")
+		if sandboxID == "" || validSandboxes.Has(sandboxID) {
+			continue
+		}
+		if netName != types.DefaultNetworkName && util.GetExternalIDValByKey(externalIDs, types.NADExternalID) != nadName {
+			continue
+		}
+		klog.Infof("Deleting stale OVS port %s of sandbox %s, iface-id %s", name, sandboxID,
+			util.GetExternalIDValByKey(externalIDs, "iface-id"))
+		out, err := ovsExec("--if-exists", "del-port", "br-int", name)
+		if err != nil {
+			klog.Warningf("Failed to delete stale OVS port %s: %v\n  %q", name, err, out)
+			continue
+		}
+		// skip deleting representor ports
+		if util.GetExternalIDValByKey(externalIDs, "vf-netdev-name") == "" {
+			if err = util.LinkDelete(name); err != nil {
+				klog.Warningf("Failed to delete stale interface %s: %v", name, err)
+			}
+		}
+		if _, ok := staleSandboxPorts[sandboxID]; !ok {
+			staleSandboxes = append(staleSandboxes, sandboxID)
+		}
+		staleSandboxPorts[sandboxID] = append(staleSandboxPorts[sandboxID], name)
+	}
+
+	for _, sandboxID := range staleSandboxes {
+		if err = clearPodBandwidthForPorts(staleSandboxPorts[sandboxID], sandboxID); err != nil {
+			klog.Warningf("Failed to clear the bandwidth of stale sandbox %s: %v", sandboxID, err)
+		}
+	}
+	return nil
+}
+
 // setupIngressFilter sets up an ingress filter using nftables to block
 // unwanted ICMPv6 Router Advertisement (RA) packets on a specific device.
 // It creates a new nftables table, chain, and rule to drop RA packets
@@ -1460,13 +1460,12 @@ func TestConfigureOVS(t *testing.T) {
 				Err: nil,
 			})
 
-			// clearPodBandwidth()
+			// clearPodBandwidthForPorts()
 			tc.execMock.AddFakeCmd(&ovntest.ExpectedCmd{
-				Cmd: genOVSFindCmd("30", "interface", "name",
-					fmt.Sprintf("external-ids:sandbox=%s", sandboxID)),
+				Cmd: fmt.Sprintf("ovs-vsctl --timeout=30 --if-exists clear port %s qos", tc.vfRep),
 			})
 			tc.execMock.AddFakeCmd(&ovntest.ExpectedCmd{
-				Cmd: genOVSFindCmd("30", "qos", "_uuid",
+				Cmd: genOVSFindCmd("30", "qos", "_uuid,external_ids",
 					fmt.Sprintf("external-ids:sandbox=%s", sandboxID)),
 			})
 
@@ -1504,7 +1503,7 @@ func TestConfigureOVS(t *testing.T) {
 			fakeClient := fake.NewSimpleClientset(&corev1.PodList{Items: []corev1.Pod{pod}})
 			clientset := NewClientSet(fakeClient, &podLister)
 			err = ConfigureOVS(ctx, tc.podNs, tc.podName, tc.vfRep,
-				tc.ifInfo, sandboxID, vfPciAddress, clientset)
+				tc.ifInfo, sandboxID, "", vfPciAddress, clientset)
 			if tc.errMatch != nil {
 				assert.Contains(t, err.Error(), tc.errMatch.Error())
 			} else {
@@ -1639,7 +1638,7 @@ func TestConfigureOVS_getPfEncapIpWithError(t *testing.T) {
 			var podLister v1mocks.PodLister
 			podLister.On("Pods", mock.AnythingOfType("string")).Return(&podNamespaceLister)
 			err = ConfigureOVS(ctx, tc.podNs, tc.podName, tc.vfRep,
-				tc.ifInfo, sandboxID, vfPciAddress, nil)
+				tc.ifInfo, sandboxID, "", vfPciAddress, nil)
 			if tc.errMatch != nil {
 				assert.Contains(t, err.Error(), tc.errMatch.Error())
 			} else {
@@ -1652,6 +1651,290 @@ func TestConfigureOVS_getPfEncapIpWithError(t *testing.T) {
 	}
 }
 
+func TestCheckPodOVSInterface(t *testing.T) {
+	sandboxID := "deadbeef"
+	hostIfaceName := "deadbeef"
+	podRequest := &PodRequest{
+		PodNamespace: "ns-foo",
+		PodName:      "pod-bar",
+		SandboxID:    sandboxID,
+	}
+	ifInfo := &PodInterfaceInfo{
+		PodAnnotation: util.PodAnnotation{
+			MAC: ovntest.MustParseMAC("0a:58:fd:98:00:01"),
+		},
+		NetName: ovntypes.DefaultNetworkName,
+		NADName: ovntypes.DefaultNetworkName,
+	}
+	findCmd := genOVSFindCmd("30", "Interface", "name",
+		"external-ids:sandbox="+sandboxID+" external-ids:iface-id="+genIfaceID("ns-foo", "pod-bar"))
+	getCmd := genOVSGetCmd("Interface", hostIfaceName, "external-ids", "attached_mac") + " external-ids:ovn-installed"
+
+	tests := []struct {
+		desc             string
+		execMockCommands []*ovntest.ExpectedCmd
+		errMatch         error
+	}{
+		{
+			desc: "test code path when the OVS interface is not found",
+			execMockCommands: []*ovntest.ExpectedCmd{
+				{Cmd: findCmd},
+			},
+			errMatch: fmt.Errorf("expected one OVS interface with iface-id ns-foo_pod-bar, found 0"),
+		},
+		{
+			desc: "test code path when the OVS interface is not ovn-installed",
+			execMockCommands: []*ovntest.ExpectedCmd{
+				{Cmd: findCmd, Output: hostIfaceName},
+				{Cmd: getCmd, Err: fmt.Errorf("no key \"ovn-installed\" in Interface record")},
+			},
+			errMatch: fmt.Errorf("failed to get the OVN binding of OVS interface deadbeef"),
+		},
+		{
+			desc: "test code path when the OVS interface has a different MAC",
+			execMockCommands: []*ovntest.ExpectedCmd{
+				{Cmd: findCmd, Output: hostIfaceName},
+				{Cmd: getCmd, Output: "\"0a:58:fd:98:00:02\"\ntrue"},
+			},
+			errMatch: fmt.Errorf("OVS interface deadbeef is attached to MAC 0a:58:fd:98:00:02, expected 0a:58:fd:98:00:01"),
+		},
+		{
+			desc: "test success path",
+			execMockCommands: []*ovntest.ExpectedCmd{
+				{Cmd: findCmd, Output: hostIfaceName},
+				{Cmd: getCmd, Output: "\"0a:58:fd:98:00:01\"\ntrue"},
+			},
+		},
+	}
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d:%s", i, tc.desc), func(t *testing.T) {
+			execMock := ovntest.NewFakeExec()
+			err := SetExec(execMock)
+			require.NoError(t, err)
+			execMock.AddFakeCmds(tc.execMockCommands)
+
+			err = checkPodOVSInterface(podRequest, ifInfo)
+			if tc.errMatch != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errMatch.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.True(t, execMock.CalledMatchesExpected(), execMock.ErrorDesc())
+		})
+	}
+}
+
+func TestCheckNetwork(t *testing.T) {
+	mockNetLinkOps := new(util_mocks.NetLinkOps)
+	mockLink := new(netlink_mocks.Link)
+	// below sets the `netLinkOps` in util/net_linux.go to a mock instance for purpose of unit tests execution
+	util.SetNetLinkOpMockInst(mockNetLinkOps)
+
+	ifInfo := &PodInterfaceInfo{
+		PodAnnotation: util.PodAnnotation{
+			IPs:      ovntest.MustParseIPNets("192.168.0.5/24"),
+			MAC:      ovntest.MustParseMAC("0A:58:FD:98:00:01"),
+			Gateways: ovntest.MustParseIPs("192.168.0.1"),
+			Routes: []util.PodRoute{
+				{
+					Dest:    ovntest.MustParseIPNet("192.168.1.0/24"),
+					NextHop: net.ParseIP("192.168.0.2"),
+				},
+			},
+		},
+	}
+	linkAttrs := &netlink.LinkAttrs{Name: "eth0", Flags: net.FlagUp, HardwareAddr: ovntest.MustParseMAC("0A:58:FD:98:00:01")}
+	addrs := []netlink.Addr{{IPNet: ovntest.MustParseIPNet("192.168.0.5/24")}}
+	defaultRoute := netlink.Route{Dst: ovntest.MustParseIPNet("0.0.0.0/0"), Gw: net.ParseIP("192.168.0.1")}
+	podRoute := netlink.Route{Dst: ovntest.MustParseIPNet("192.168.1.0/24"), Gw: net.ParseIP("192.168.0.2")}
+
+	tests := []struct {
+		desc                 string
+		inpPodIfaceInfo      *PodInterfaceInfo
+		errMatch             error
+		netLinkOpsMockHelper []ovntest.TestifyMockHelper
+		linkMockHelper       []ovntest.TestifyMockHelper
+	}{
+		{
+			desc:            "test code path when the interface is down",
+			inpPodIfaceInfo: ifInfo,
+			errMatch:        fmt.Errorf("interface eth0 is down"),
+			linkMockHelper: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "Attrs", OnCallMethodArgType: []string{}, RetArgList: []interface{}{&netlink.LinkAttrs{Name: "eth0"}}},
+			},
+		},
+		{
+			desc:            "test code path when the interface has a different MAC",
+			inpPodIfaceInfo: ifInfo,
+			errMatch:        fmt.Errorf("interface eth0 has MAC 0a:58:fd:98:00:02, expected 0a:58:fd:98:00:01"),
+			linkMockHelper: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "Attrs", OnCallMethodArgType: []string{}, RetArgList: []interface{}{
+					&netlink.LinkAttrs{Name: "eth0", Flags: net.FlagUp, HardwareAddr: ovntest.MustParseMAC("0A:58:FD:98:00:02")}}},
+			},
+		},
+		{
+			desc:            "test code path when an IP address is missing",
+			inpPodIfaceInfo: ifInfo,
+			errMatch:        fmt.Errorf("interface eth0 is missing IP address 192.168.0.5/24"),
+			netLinkOpsMockHelper: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "AddrList", OnCallMethodArgType: []string{"*mocks.Link", "int"}, RetArgList: []interface{}{[]netlink.Addr{}, nil}},
+			},
+			linkMockHelper: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "Attrs", OnCallMethodArgType: []string{}, RetArgList: []interface{}{linkAttrs}},
+			},
+		},
+		{
+			desc:            "test code path when the default route is missing",
+			inpPodIfaceInfo: ifInfo,
+			errMatch:        fmt.Errorf("interface eth0 is missing the default route via 192.168.0.1"),
+			netLinkOpsMockHelper: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "AddrList", OnCallMethodArgType: []string{"*mocks.Link", "int"}, RetArgList: []interface{}{addrs, nil}},
+				{OnCallMethodName: "RouteList", OnCallMethodArgType: []string{"*mocks.Link", "int"}, RetArgList: []interface{}{[]netlink.Route{podRoute}, nil}},
+			},
+			linkMockHelper: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "Attrs", OnCallMethodArgType: []string{}, RetArgList: []interface{}{linkAttrs}},
+			},
+		},
+		{
+			desc:            "test code path when a pod route is missing",
+			inpPodIfaceInfo: ifInfo,
+			errMatch:        fmt.Errorf("interface eth0 is missing route 192.168.1.0/24 via 192.168.0.2"),
+			netLinkOpsMockHelper: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "AddrList", OnCallMethodArgType: []string{"*mocks.Link", "int"}, RetArgList: []interface{}{addrs, nil}},
+				{OnCallMethodName: "RouteList", OnCallMethodArgType: []string{"*mocks.Link", "int"}, RetArgList: []interface{}{[]netlink.Route{defaultRoute}, nil}},
+			},
+			linkMockHelper: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "Attrs", OnCallMethodArgType: []string{}, RetArgList: []interface{}{linkAttrs}},
+			},
+		},
+		{
+			desc:            "test success path",
+			inpPodIfaceInfo: ifInfo,
+			netLinkOpsMockHelper: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "AddrList", OnCallMethodArgType: []string{"*mocks.Link", "int"}, RetArgList: []interface{}{addrs, nil}},
+				{OnCallMethodName: "RouteList", OnCallMethodArgType: []string{"*mocks.Link", "int"}, RetArgList: []interface{}{[]netlink.Route{defaultRoute, podRoute}, nil}},
+			},
+			linkMockHelper: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "Attrs", OnCallMethodArgType: []string{}, RetArgList: []interface{}{linkAttrs}},
+			},
+		},
+		{
+			desc: "test skip ip config",
+			inpPodIfaceInfo: &PodInterfaceInfo{
+				PodAnnotation: ifInfo.PodAnnotation,
+				SkipIPConfig:  true,
+			},
+			linkMockHelper: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "Attrs", OnCallMethodArgType: []string{}, RetArgList: []interface{}{linkAttrs}},
+			},
+		},
+	}
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d:%s", i, tc.desc), func(t *testing.T) {
+			ovntest.ProcessMockFnList(&mockNetLinkOps.Mock, tc.netLinkOpsMockHelper)
+			ovntest.ProcessMockFnList(&mockLink.Mock, tc.linkMockHelper)
+
+			err := checkNetwork(mockLink, tc.inpPodIfaceInfo)
+			if tc.errMatch != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errMatch.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			mockNetLinkOps.AssertExpectations(t)
+			mockLink.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGCPodInterfaces(t *testing.T) {
+	mockNetLinkOps := new(util_mocks.NetLinkOps)
+	mockLink := new(netlink_mocks.Link)
+	// below sets the `netLinkOps` in util/net_linux.go to a mock instance for purpose of unit tests execution
+	util.SetNetLinkOpMockInst(mockNetLinkOps)
+
+	const (
+		validSandboxID = "validsandbox"
+		staleSandboxID = "stalesandbox"
+		nadName        = "ns1/nad1"
+	)
+	ovsInterfaces := strings.Join([]string{
+		"br-int,",
+		"validsandbox,\"attached_mac=0a:58:0a:80:00:05 iface-id=ns1_pod1 ip_addresses=\"\"10.128.0.5/24,fd00::5/64\"\" sandbox=validsandbox\"",
+		"stalesandbox,\"attached_mac=0a:58:0a:80:00:06 iface-id=ns1_pod2 ip_addresses=\"\"10.128.0.6/24,fd00::6/64\"\" sandbox=stalesandbox\"",
+		"stalesandbo_3,attached_mac=0a:58:0a:81:00:06 iface-id=ns1.nad1_ns1_pod2 k8s.ovn.org/nad=ns1/nad1 k8s.ovn.org/network=net1 sandbox=stalesandbox",
+		"stalesandbo_4,attached_mac=0a:58:0a:82:00:06 iface-id=ns1.nad2_ns1_pod2 k8s.ovn.org/nad=ns1/nad2 k8s.ovn.org/network=net2 sandbox=stalesandbox",
+		"enp1s0f0_1,attached_mac=0a:58:0a:80:00:07 iface-id=ns1_pod3 sandbox=stalerepresentor vf-netdev-name=enp1s0f0v1",
+	}, "\n")
+	findCmd := genOVSFindCmd("30", "Interface", "name,external_ids", "")
+	findCmd = strings.TrimSuffix(findCmd, " ")
+	delPortCmd := func(name string) *ovntest.ExpectedCmd {
+		return &ovntest.ExpectedCmd{Cmd: "ovs-vsctl --timeout=30 --if-exists del-port br-int " + name}
+	}
+	clearQoSCmds := func(sandboxID string, ports ...string) []*ovntest.ExpectedCmd {
+		var cmds []*ovntest.ExpectedCmd
+		for _, port := range ports {
+			cmds = append(cmds, &ovntest.ExpectedCmd{Cmd: "ovs-vsctl --timeout=30 --if-exists clear port " + port + " qos"})
+		}
+		return append(cmds, &ovntest.ExpectedCmd{Cmd: genOVSFindCmd("30", "qos", "_uuid,external_ids", "external-ids:sandbox="+sandboxID)})
+	}
+
+	tests := []struct {
+		desc                 string
+		netName              string
+		nadName              string
+		execMockCommands     []*ovntest.ExpectedCmd
+		netLinkOpsMockHelper []ovntest.TestifyMockHelper
+	}{
+		{
+			desc:    "deletes the ports of all networks of stale sandboxes on default network GC",
+			netName: ovntypes.DefaultNetworkName,
+			nadName: ovntypes.DefaultNetworkName,
+			execMockCommands: append(append([]*ovntest.ExpectedCmd{
+				{Cmd: findCmd, Output: ovsInterfaces},
+				delPortCmd("stalesandbox"),
+				delPortCmd("stalesandbo_3"),
+				delPortCmd("stalesandbo_4"),
+				delPortCmd("enp1s0f0_1"),
+			}, clearQoSCmds(staleSandboxID, "stalesandbox", "stalesandbo_3", "stalesandbo_4")...),
+				clearQoSCmds("stalerepresentor", "enp1s0f0_1")...),
+			netLinkOpsMockHelper: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "LinkByName", OnCallMethodArgType: []string{"string"}, RetArgList: []interface{}{mockLink, nil}, CallTimes: 3},
+				{OnCallMethodName: "LinkDelete", OnCallMethodArgType: []string{"*mocks.Link"}, RetArgList: []interface{}{nil}, CallTimes: 3},
+			},
+		},
+		{
+			desc:    "deletes the ports of the network of stale sandboxes on secondary network GC",
+			netName: "net1",
+			nadName: nadName,
+			execMockCommands: append([]*ovntest.ExpectedCmd{
+				{Cmd: findCmd, Output: ovsInterfaces},
+				delPortCmd("stalesandbo_3"),
+			}, clearQoSCmds(staleSandboxID, "stalesandbo_3")...),
+			netLinkOpsMockHelper: []ovntest.TestifyMockHelper{
+				{OnCallMethodName: "LinkByName", OnCallMethodArgType: []string{"string"}, RetArgList: []interface{}{mockLink, nil}},
+				{OnCallMethodName: "LinkDelete", OnCallMethodArgType: []string{"*mocks.Link"}, RetArgList: []interface{}{nil}},
+			},
+		},
+	}
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d:%s", i, tc.desc), func(t *testing.T) {
+			ovntest.ProcessMockFnList(&mockNetLinkOps.Mock, tc.netLinkOpsMockHelper)
+			execMock := ovntest.NewFakeExec()
+			err := SetExec(execMock)
+			require.NoError(t, err)
+			execMock.AddFakeCmds(tc.execMockCommands)
+
+			validAttachments := []cnitypes.GCAttachment{{ContainerID: validSandboxID, IfName: "eth0"}}
+			err = gcPodInterfaces(tc.netName, tc.nadName, validAttachments)
+			require.NoError(t, err)
+			assert.True(t, execMock.CalledMatchesExpected(), execMock.ErrorDesc())
+			mockNetLinkOps.AssertExpectations(t)
+		})
+	}
+}
+
 func TestSetupIngressFilter(t *testing.T) {
 	nft := knftables.NewFake(knftables.NetDevFamily, "ingress_filter")
 
@@ -77,6 +77,13 @@ const CNIDel command = "DEL"
 // CNICheck is the command representing check operation on a pod
 const CNICheck command = "CHECK"
 
+// CNIStatus is the command representing the plugin readiness operation
+const CNIStatus command = "STATUS"
+
+// CNIGC is the command representing the garbage collection operation of the
+// attachments that are no longer valid
+const CNIGC command = "GC"
+
 // Request sent to the Server by the OVN CNI plugin
 type Request struct {
 	// CNI environment variables, like CNI_COMMAND and CNI_NETNS
@@ -199,4 +206,6 @@ type Server struct {
 	kubeAuth             *KubeAPIAuth
 	networkManager       networkmanager.Interface
 	ovsClient            client.Client
+	// statusChecks tell whether ovnkube-node is ready to set up pod networking
+	statusChecks []func() error
 }
@@ -29,9 +29,15 @@ type NetConf struct {
 	// for layer2 and localnet network, eg. 10.1.130.0/24
 	Subnets string `json:"subnets,omitempty"`
 	// comma-seperated list of IPs, expressed in the form of subnets, to be excluded from being allocated for Pod
-	// valid for layer2 and localnet network topology
+	// valid for layer3, layer2 and localnet network topology
 	// eg. "10.1.130.0/27, 10.1.130.122/32"
 	ExcludeSubnets string `json:"excludeSubnets,omitempty"`
+	// comma-seperated list of IPs, expressed in the form of subnets, that are
+	// never handed out dynamically but can still be statically requested by
+	// a Pod, eg. to keep the historic addresses of migrated workloads
+	// valid for layer3 and layer2 network topology
+	// eg. "10.1.130.64/28, 10.1.130.200/32"
+	ReservedSubnets string `json:"reservedSubnets,omitempty"`
 	// join subnet cidr is required for supporting
 	// services and ingress for user defined networks
 	// in case of dualstack cluster, please do a comma-seperated list
@@ -44,11 +50,34 @@ type NetConf struct {
 	JoinSubnet string `json:"joinSubnet,omitempty"`
 	// VLANID, valid in localnet topology network only
 	VLANID int `json:"vlanID,omitempty"`
+	// VLANTrunk is a comma-separated list of VLAN IDs and VLAN ID ranges
+	// that pods are allowed to send and receive tagged traffic for, eg.
+	// "100,200-210". Valid in localnet topology network only and mutually
+	// exclusive with VLANID.
+	VLANTrunk string `json:"vlanTrunk,omitempty"`
+	// NativeVLANID is the VLAN untagged traffic belongs to when VLANTrunk is
+	// set. When omitted, untagged traffic is dropped. OVN-Kubernetes doesn't
+	// tag the untagged traffic with this ID, it is forwarded as is and it must
+	// match the native VLAN of the physical network.
+	NativeVLANID int `json:"nativeVlanID,omitempty"`
 	// AllowPersistentIPs is valid on both localnet / layer topologies.
 	// It allows for having IP allocations that outlive the pod for which
 	// they are originally created - e.g. a KubeVirt VM's migration, or
 	// restart.
 	AllowPersistentIPs bool `json:"allowPersistentIPs,omitempty"`
+	// Multicast enables IGMP/MLD snooping and the multicast ACLs on a
+	// secondary network, valid on layer3, layer2 and localnet topologies.
+	// Multicast traffic is only allowed for namespaces annotated with
+	// k8s.ovn.org/multicast-enabled, as on the default network. Primary
+	// networks follow the cluster wide multicast setting instead.
+	Multicast bool `json:"multicast,omitempty"`
+	// Bandwidth is the default bandwidth limit of the pod interfaces
+	// attached to this network, valid on layer3, layer2 and localnet
+	// topologies. It takes precedence over the pod's
+	// kubernetes.io/ingress-bandwidth and kubernetes.io/egress-bandwidth
+	// annotations and can be overridden per interface through the
+	// bandwidth of the network selection element.
+	Bandwidth *BandwidthConf `json:"bandwidth,omitempty"`
 
 	// PhysicalNetworkName indicates the name of the physical network to which
 	// the OVN overlay will connect. Only applies to `localnet` topologies.
@@ -77,9 +106,20 @@ type NetConf struct {
 	RuntimeConfig struct {
 		// see https://github.com/k8snetworkplumbingwg/device-info-spec
 		CNIDeviceInfoFile string `json:"CNIDeviceInfoFile,omitempty"`
+		// Bandwidth of the network selection element, set by multus when
+		// the network configuration has the bandwidth capability
+		Bandwidth *BandwidthConf `json:"bandwidth,omitempty"`
 	} `json:"runtimeConfig,omitempty"`
 }
 
+// BandwidthConf is the bandwidth limit of a pod interface, in the format of
+// the bandwidth CNI plugin. Rates are in bits per second; bursts are not
+// supported and derived from the rates instead.
+type BandwidthConf struct {
+	IngressRate int64 `json:"ingressRate,omitempty"`
+	EgressRate  int64 `json:"egressRate,omitempty"`
+}
+
 // NetworkSelectionElement represents one element of the JSON format
 // Network Attachment Selection Annotation as described in section 4.1.2
 // of the CRD specification.
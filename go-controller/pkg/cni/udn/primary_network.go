@@ -5,6 +5,7 @@ import (
 
 	"k8s.io/klog/v2"
 
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -26,7 +27,7 @@ func NewPrimaryNetwork(networkManager networkmanager.Interface) *UserDefinedPrim
 }
 
 func (p *UserDefinedPrimaryNetwork) InterfaceName() string {
-	return "ovn-udn1"
+	return types.PrimaryUDNInterfaceName
 }
 
 func (p *UserDefinedPrimaryNetwork) NetworkDevice() string {
@@ -63,6 +64,13 @@ func (p *UserDefinedPrimaryNetwork) MTU() int {
 	return p.activeNetwork.MTU()
 }
 
+func (p *UserDefinedPrimaryNetwork) Bandwidth() *ovncnitypes.BandwidthConf {
+	if p.activeNetwork == nil {
+		return nil
+	}
+	return p.activeNetwork.Bandwidth()
+}
+
 func (p *UserDefinedPrimaryNetwork) Found() bool {
 	return p.annotation != nil && p.activeNetwork != nil
 }
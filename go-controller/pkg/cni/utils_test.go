@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
 
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	mocks "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/mocks/k8s.io/client-go/listers/core/v1"
 	ovntypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
@@ -281,4 +282,53 @@ var _ = Describe("CNI Utils tests", func() {
 			Expect(pif.EnableUDPAggregation).To(BeFalse())
 		})
 	})
+
+	Context("buildPodInterfaceInfo bandwidth", func() {
+		podAnnot := map[string]string{
+			util.OvnPodAnnotationName: `{
+"ns1/nad1":{"ip_addresses":["192.168.2.3/24"],
+"mac_address":"0a:58:c0:a8:02:03"}}`,
+			"kubernetes.io/ingress-bandwidth": "1M",
+			"kubernetes.io/egress-bandwidth":  "2M",
+		}
+		newPodRequest := func(conf *ovncnitypes.NetConf) *PodRequest {
+			return &PodRequest{
+				PodUID:  "4d06bae8-9c38-41f6-945c-f92320e782e4",
+				CNIConf: conf,
+				netName: "net1",
+				nadName: "ns1/nad1",
+			}
+		}
+
+		It("uses the pod annotations when the network has no bandwidth", func() {
+			pif, err := newPodRequest(&ovncnitypes.NetConf{}).buildPodInterfaceInfo(podAnnot, nil, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pif.Ingress).To(BeEquivalentTo(1000000))
+			Expect(pif.Egress).To(BeEquivalentTo(2000000))
+		})
+
+		It("prefers the network default over the pod annotations", func() {
+			conf := &ovncnitypes.NetConf{Bandwidth: &ovncnitypes.BandwidthConf{IngressRate: 3000000}}
+			pif, err := newPodRequest(conf).buildPodInterfaceInfo(podAnnot, nil, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pif.Ingress).To(BeEquivalentTo(3000000))
+			Expect(pif.Egress).To(BeEquivalentTo(2000000))
+		})
+
+		It("prefers the network selection element bandwidth over the network default", func() {
+			conf := &ovncnitypes.NetConf{Bandwidth: &ovncnitypes.BandwidthConf{IngressRate: 3000000, EgressRate: 4000000}}
+			conf.RuntimeConfig.Bandwidth = &ovncnitypes.BandwidthConf{EgressRate: 5000000}
+			pif, err := newPodRequest(conf).buildPodInterfaceInfo(podAnnot, nil, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pif.Ingress).To(BeEquivalentTo(3000000))
+			Expect(pif.Egress).To(BeEquivalentTo(5000000))
+		})
+
+		It("fails when the requested bandwidth is unreasonable", func() {
+			conf := &ovncnitypes.NetConf{}
+			conf.RuntimeConfig.Bandwidth = &ovncnitypes.BandwidthConf{IngressRate: 10}
+			_, err := newPodRequest(conf).buildPodInterfaceInfo(podAnnot, nil, "")
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
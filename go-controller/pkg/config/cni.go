@@ -25,7 +25,7 @@ var ErrorChainingNotSupported = errors.New("CNI plugin chaining is not supported
 func WriteCNIConfig() error {
 	netConf := &ovncnitypes.NetConf{
 		NetConf: types.NetConf{
-			CNIVersion: "0.4.0",
+			CNIVersion: "1.1.0",
 			Name:       "ovn-kubernetes",
 			Type:       CNI.Plugin,
 		},
@@ -106,8 +106,9 @@ var (
 
 	// CNI holds CNI-related parsed config file parameters and command-line overrides
 	CNI = CNIConfig{
-		ConfDir: "/etc/cni/net.d",
-		Plugin:  "ovn-k8s-cni-overlay",
+		ConfDir:   "/etc/cni/net.d",
+		Plugin:    "ovn-k8s-cni-overlay",
+		CheckMode: CNICheckModeDisabled,
 	}
 
 	// Kubernetes holds Kubernetes-related parsed config file parameters and command-line overrides
@@ -199,8 +200,9 @@ var (
 	}
 
 	ClusterManager = ClusterManagerConfig{
-		V4TransitSwitchSubnet: "100.88.0.0/16",
-		V6TransitSwitchSubnet: "fd97::/64",
+		V4TransitSwitchSubnet:           "100.88.0.0/16",
+		V6TransitSwitchSubnet:           "fd97::/64",
+		NetworkCapacityWarningThreshold: 80,
 	}
 )
 
@@ -355,8 +357,22 @@ type CNIConfig struct {
 	ConfDir string `gcfg:"conf-dir"`
 	// Plugin specifies the name of the CNI plugin
 	Plugin string `gcfg:"plugin"`
+	// CheckMode is how thoroughly CNI CHECK requests verify the pod networking;
+	// it may be either "disabled", "ovs" or "full"
+	CheckMode string `gcfg:"check-mode"`
 }
 
+const (
+	// CNICheckModeDisabled indicates CNI CHECK requests always succeed
+	CNICheckModeDisabled = "disabled"
+	// CNICheckModeOVS indicates CNI CHECK requests verify that the pod OVS
+	// interface is bound to its OVN logical switch port
+	CNICheckModeOVS = "ovs"
+	// CNICheckModeFull indicates CNI CHECK requests additionally verify the
+	// pod interface addresses and routes in the pod network namespace
+	CNICheckModeFull = "full"
+)
+
 // KubernetesConfig holds Kubernetes-related parsed config file parameters and command-line overrides
 type KubernetesConfig struct {
 	BootstrapKubeconfig     string        `gcfg:"bootstrap-kubeconfig"`
@@ -433,6 +449,11 @@ type OVNKubernetesFeatureConfig struct {
 	EnableServiceTemplateSupport bool `gcfg:"enable-svc-template-support"`
 	EnableObservability          bool `gcfg:"enable-observability"`
 	EnableNetworkQoS             bool `gcfg:"enable-network-qos"`
+
+	// EnableStatefulSetPersistentIPs makes ovnkube create and honour IPAMClaims
+	// for StatefulSet pods on layer2 primary user defined networks that allow
+	// persistent IPs. Requires EnablePersistentIPs and EnableInterconnect.
+	EnableStatefulSetPersistentIPs bool `gcfg:"enable-statefulset-persistent-ips"`
 }
 
 // GatewayMode holds the node gateway mode
@@ -549,6 +570,24 @@ type ClusterManagerConfig struct {
 	V4TransitSwitchSubnet string `gcfg:"v4-transit-switch-subnet"`
 	// V6TransitSwitchSubnet to be used in the cluster for interconnecting multiple zones
 	V6TransitSwitchSubnet string `gcfg:"v6-transit-switch-subnet"`
+	// RawUDNAllowedLocalnets holds the unparsed UDNAllowedLocalnets. Should only be
+	// used inside config module.
+	RawUDNAllowedLocalnets string `gcfg:"udn-allowed-localnets"`
+	// UDNAllowedLocalnets holds the physical networks and VLANs that namespace-scoped
+	// UserDefinedNetworks are allowed to use with the Localnet topology
+	UDNAllowedLocalnets []LocalnetAllowListEntry
+	// NetworkCapacityWarningThreshold is the percentage of host subnets or pod
+	// IPs of a network subnet in use above which a UserDefinedNetwork reports
+	// its capacity as insufficient
+	NetworkCapacityWarningThreshold int `gcfg:"network-capacity-warning-threshold"`
+}
+
+// LocalnetAllowListEntry is a physical network name and VLAN ID pair.
+// VLANID 0 means the physical network may be used untagged, that is without
+// a VLAN or as the native VLAN of a trunk.
+type LocalnetAllowListEntry struct {
+	PhysicalNetworkName string
+	VLANID              int
 }
 
 // OvnDBScheme describes the OVN database connection transport method
@@ -1025,6 +1064,12 @@ var CNIFlags = []cli.Flag{
 		Destination: &cliConfig.CNI.Plugin,
 		Value:       CNI.Plugin,
 	},
+	&cli.StringFlag{
+		Name:        "cni-check-mode",
+		Usage:       "how CNI CHECK requests verify the pod networking: \"disabled\", \"ovs\" to verify the pod OVS interface is bound to OVN, or \"full\" to also verify the pod interface addresses and routes (default: disabled)",
+		Destination: &cliConfig.CNI.CheckMode,
+		Value:       CNI.CheckMode,
+	},
 }
 
 // OVNK8sFeatureFlags capture OVN-Kubernetes feature related options
@@ -1124,6 +1169,12 @@ var OVNK8sFeatureFlags = []cli.Flag{
 		Destination: &cliConfig.OVNKubernetesFeature.EnablePersistentIPs,
 		Value:       OVNKubernetesFeature.EnablePersistentIPs,
 	},
+	&cli.BoolFlag{
+		Name:        "enable-statefulset-persistent-ips",
+		Usage:       "Configure to keep the IPs of StatefulSet pods on layer2 primary user defined networks across rescheduling. Requires --enable-persistent-ips and --enable-interconnect.",
+		Destination: &cliConfig.OVNKubernetesFeature.EnableStatefulSetPersistentIPs,
+		Value:       OVNKubernetesFeature.EnableStatefulSetPersistentIPs,
+	},
 	&cli.BoolFlag{
 		Name:        "enable-dns-name-resolver",
 		Usage:       "Configure to use DNSNameResolver CRD feature with ovn-kubernetes.",
@@ -1636,6 +1687,23 @@ var ClusterManagerFlags = []cli.Flag{
 		Destination: &cliConfig.ClusterManager.V6TransitSwitchSubnet,
 		Value:       ClusterManager.V6TransitSwitchSubnet,
 	},
+	&cli.StringFlag{
+		Name: "cluster-manager-udn-allowed-localnets",
+		Usage: "A comma separated list of physical networks that namespace-scoped UserDefinedNetworks are allowed " +
+			"to use with the Localnet topology. Each entry is given in the form physicalNetworkName[:vlanID], " +
+			"an entry without VLAN ID (or with VLAN ID 0) allows untagged access to the physical network, without a " +
+			"VLAN or as the native VLAN of a trunk " +
+			"(eg, \"physnet1,physnet2:100,physnet2:200\"). If not specified, Localnet UserDefinedNetworks are not allowed.",
+		Destination: &cliConfig.ClusterManager.RawUDNAllowedLocalnets,
+		Value:       ClusterManager.RawUDNAllowedLocalnets,
+	},
+	&cli.IntFlag{
+		Name: "cluster-manager-network-capacity-warning-threshold",
+		Usage: "The percentage of host subnets or pod IPs of a network subnet in use above which " +
+			"the NetworkCapacitySufficient condition of a UserDefinedNetwork is set to false (1-100)",
+		Destination: &cliConfig.ClusterManager.NetworkCapacityWarningThreshold,
+		Value:       ClusterManager.NetworkCapacityWarningThreshold,
+	},
 }
 
 // Flags are general command-line flags. Apps should add these flags to their
@@ -2144,9 +2212,43 @@ func completeClusterManagerConfig(allSubnets *ConfigSubnets) error {
 	}
 	allSubnets.Append(ConfigSubnetTransit, v4TransitCIDR)
 	allSubnets.Append(ConfigSubnetTransit, v6TransitCIDR)
+
+	ClusterManager.UDNAllowedLocalnets, err = parseLocalnetAllowList(ClusterManager.RawUDNAllowedLocalnets)
+	if err != nil {
+		return fmt.Errorf("UDN allowed localnets field is invalid: %v", err)
+	}
+
+	if ClusterManager.NetworkCapacityWarningThreshold < 1 || ClusterManager.NetworkCapacityWarningThreshold > 100 {
+		return fmt.Errorf("invalid network capacity warning threshold %d: must be between 1 and 100",
+			ClusterManager.NetworkCapacityWarningThreshold)
+	}
 	return nil
 }
 
+func parseLocalnetAllowList(allowListRaw string) ([]LocalnetAllowListEntry, error) {
+	var entries []LocalnetAllowListEntry
+	if strings.TrimSpace(allowListRaw) == "" {
+		return entries, nil
+	}
+	for _, rawEntry := range strings.Split(allowListRaw, ",") {
+		rawEntry = strings.TrimSpace(rawEntry)
+		physicalNetworkName, rawVLANID, hasVLAN := strings.Cut(rawEntry, ":")
+		if physicalNetworkName == "" {
+			return nil, fmt.Errorf("localnet %q has no physical network name set", rawEntry)
+		}
+		entry := LocalnetAllowListEntry{PhysicalNetworkName: physicalNetworkName}
+		if hasVLAN {
+			vlanID, err := strconv.Atoi(rawVLANID)
+			if err != nil || vlanID < 0 || vlanID > 4094 {
+				return nil, fmt.Errorf("localnet %q has an invalid VLAN ID, must be between 0 and 4094", rawEntry)
+			}
+			entry.VLANID = vlanID
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
 func buildDefaultConfig(cli, file *config) error {
 	if err := overrideFields(&Default, &file.Default, &savedDefault); err != nil {
 		return err
@@ -2316,6 +2418,12 @@ func initConfigWithPath(ctx *cli.Context, exec kexec.Interface, saPath string, d
 	if err = overrideFields(&CNI, &cliConfig.CNI, &savedCNI); err != nil {
 		return "", err
 	}
+	switch CNI.CheckMode {
+	case CNICheckModeDisabled, CNICheckModeOVS, CNICheckModeFull:
+	default:
+		return "", fmt.Errorf("invalid CNI check mode %q: expected one of %q, %q or %q",
+			CNI.CheckMode, CNICheckModeDisabled, CNICheckModeOVS, CNICheckModeFull)
+	}
 
 	// Logging setup
 	if err = overrideFields(&Logging, &cfg.Logging, &savedLogging); err != nil {
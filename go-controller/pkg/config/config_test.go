@@ -184,6 +184,7 @@ cache-active-timeout=789
 [cni]
 conf-dir=/etc/cni/net.d22
 plugin=ovn-k8s-cni-overlay22
+check-mode=ovs
 
 [ovnnorth]
 address=ssl:1.2.3.4:6641
@@ -304,6 +305,7 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(IPFIX.CacheActiveTimeout).To(gomega.Equal(uint(60)))
 			gomega.Expect(CNI.ConfDir).To(gomega.Equal("/etc/cni/net.d"))
 			gomega.Expect(CNI.Plugin).To(gomega.Equal("ovn-k8s-cni-overlay"))
+			gomega.Expect(CNI.CheckMode).To(gomega.Equal(CNICheckModeDisabled))
 			gomega.Expect(Kubernetes.Kubeconfig).To(gomega.Equal(""))
 			gomega.Expect(Kubernetes.BootstrapKubeconfig).To(gomega.Equal(""))
 			gomega.Expect(Kubernetes.CertDir).To(gomega.Equal(""))
@@ -628,6 +630,7 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(IPFIX.CacheActiveTimeout).To(gomega.Equal(uint(789)))
 			gomega.Expect(CNI.ConfDir).To(gomega.Equal("/etc/cni/net.d22"))
 			gomega.Expect(CNI.Plugin).To(gomega.Equal("ovn-k8s-cni-overlay22"))
+			gomega.Expect(CNI.CheckMode).To(gomega.Equal(CNICheckModeOVS))
 			gomega.Expect(Kubernetes.Kubeconfig).To(gomega.Equal(kubeconfigFile))
 			gomega.Expect(Kubernetes.BootstrapKubeconfig).To(gomega.Equal(bootstrapKubeconfigFile))
 			gomega.Expect(Kubernetes.CertDir).To(gomega.Equal(certDir))
@@ -735,6 +738,7 @@ var _ = Describe("Config Operations", func() {
 			gomega.Expect(Logging.ACLLoggingRateLimit).To(gomega.Equal(30))
 			gomega.Expect(CNI.ConfDir).To(gomega.Equal("/some/cni/dir"))
 			gomega.Expect(CNI.Plugin).To(gomega.Equal("a-plugin"))
+			gomega.Expect(CNI.CheckMode).To(gomega.Equal(CNICheckModeFull))
 			gomega.Expect(Kubernetes.Kubeconfig).To(gomega.Equal(kubeconfigFile))
 			gomega.Expect(Kubernetes.BootstrapKubeconfig).To(gomega.Equal(bootstrapKubeconfigFile))
 			gomega.Expect(Kubernetes.CertDir).To(gomega.Equal(certDir))
@@ -822,6 +826,7 @@ var _ = Describe("Config Operations", func() {
 			"-acl-logging-rate-limit=30",
 			"-cni-conf-dir=/some/cni/dir",
 			"-cni-plugin=a-plugin",
+			"-cni-check-mode=full",
 			"-cluster-subnets=10.130.0.0/15/24",
 			"-k8s-kubeconfig=" + kubeconfigFile,
 			"-bootstrap-kubeconfig=" + bootstrapKubeconfigFile,
@@ -1119,6 +1124,20 @@ enable-pprof=true
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
 	})
 
+	It("returns an error when the CNI check mode is invalid", func() {
+		app.Action = func(ctx *cli.Context) error {
+			_, err := InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.MatchError("invalid CNI check mode \"cheap\": expected one of \"disabled\", \"ovs\" or \"full\""))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-cni-check-mode=cheap",
+		}
+		err := app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
 	It("returns an error when the vlan-id is specified for mode other than shared gateway mode", func() {
 		app.Action = func(ctx *cli.Context) error {
 			_, err := InitConfig(ctx, kexec.New(), nil)
@@ -1390,6 +1409,7 @@ enable-pprof=true
 			gomega.Expect(Logging.Level).To(gomega.Equal(5))
 			gomega.Expect(CNI.ConfDir).To(gomega.Equal("/etc/cni/net.d22"))
 			gomega.Expect(CNI.Plugin).To(gomega.Equal("ovn-k8s-cni-overlay22"))
+			gomega.Expect(CNI.CheckMode).To(gomega.Equal(CNICheckModeOVS))
 			gomega.Expect(Kubernetes.Kubeconfig).To(gomega.Equal(kubeconfigFile))
 			gomega.Expect(Kubernetes.BootstrapKubeconfig).To(gomega.Equal(bootstrapKubeconfigFile))
 			gomega.Expect(Kubernetes.CertDir).To(gomega.Equal(certDir))
@@ -1649,6 +1669,49 @@ udn-allowed-default-services= ns/svc, ns1/svc1
 		err = app.Run(cliArgs)
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
 	})
+
+	It("accepts a config with valid udn allowed localnets", func() {
+		err := os.WriteFile(cfgFile.Name(), []byte(`[clustermanager]
+udn-allowed-localnets=physnet1, physnet2:100, physnet2:0
+`), 0o644)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		app.Action = func(ctx *cli.Context) error {
+			_, err = InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).ToNot(gomega.HaveOccurred())
+			gomega.Expect(ClusterManager.UDNAllowedLocalnets).To(gomega.Equal([]LocalnetAllowListEntry{
+				{PhysicalNetworkName: "physnet1"},
+				{PhysicalNetworkName: "physnet2", VLANID: 100},
+				{PhysicalNetworkName: "physnet2"},
+			}))
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-config-file=" + cfgFile.Name(),
+		}
+		err = app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	It("rejects a config with invalid udn allowed localnets", func() {
+		err := os.WriteFile(cfgFile.Name(), []byte(`[clustermanager]
+udn-allowed-localnets=physnet1:5000
+`), 0o644)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		app.Action = func(ctx *cli.Context) error {
+			_, err = InitConfig(ctx, kexec.New(), nil)
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			return nil
+		}
+		cliArgs := []string{
+			app.Name,
+			"-config-file=" + cfgFile.Name(),
+		}
+		err = app.Run(cliArgs)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
 	Describe("OvnDBAuth operations", func() {
 		var certFile, keyFile, caFile string
 
@@ -63,6 +63,8 @@ type ControllerManager struct {
 
 	// eIPController programs OVN to support EgressIP
 	eIPController *ovn.EgressIPController
+	// observabilityManager configures the sampling of the OVN features from the ObservabilityConfigs
+	observabilityManager *observability.Manager
 }
 
 func (cm *ControllerManager) NewNetworkController(nInfo util.NetInfo) (networkmanager.NetworkController, error) {
@@ -423,11 +425,11 @@ func (cm *ControllerManager) Start(ctx context.Context) error {
 		}
 	}
 
-	var observabilityManager *observability.Manager
 	if config.OVNKubernetesFeature.EnableObservability {
-		observabilityManager = observability.NewManager(cm.nbClient)
-		if err = observabilityManager.Init(); err != nil {
-			return fmt.Errorf("failed to init observability manager: %w", err)
+		cm.observabilityManager = observability.NewManager(cm.nbClient)
+		if err = cm.observabilityManager.Start(cm.watchFactory.ObservabilityConfigInformer(),
+			cm.watchFactory.NamespaceCoreInformer()); err != nil {
+			return fmt.Errorf("failed to start observability manager: %w", err)
 		}
 	} else {
 		err = observability.Cleanup(cm.nbClient)
@@ -446,7 +448,7 @@ func (cm *ControllerManager) Start(ctx context.Context) error {
 		}()
 	}
 
-	err = cm.initDefaultNetworkController(observabilityManager)
+	err = cm.initDefaultNetworkController(cm.observabilityManager)
 	if err != nil {
 		return fmt.Errorf("failed to init default network controller: %v", err)
 	}
@@ -490,6 +492,10 @@ func (cm *ControllerManager) Stop() {
 	if cm.routeImportManager != nil {
 		cm.routeImportManager.Stop()
 	}
+
+	if cm.observabilityManager != nil {
+		cm.observabilityManager.Stop()
+	}
 }
 
 func (cm *ControllerManager) Reconcile(_ string, _, _ util.NetInfo) error {
@@ -20,14 +20,17 @@ package v1
 import (
 	adminpolicybasedroutev1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	applyconfigurationsmetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // AdminPolicyBasedRouteStatusApplyConfiguration represents a declarative configuration of the AdminPolicyBasedRouteStatus type for use
 // with apply.
 type AdminPolicyBasedRouteStatusApplyConfiguration struct {
-	LastTransitionTime *metav1.Time                        `json:"lastTransitionTime,omitempty"`
-	Messages           []string                            `json:"messages,omitempty"`
-	Status             *adminpolicybasedroutev1.StatusType `json:"status,omitempty"`
+	LastTransitionTime *metav1.Time                                            `json:"lastTransitionTime,omitempty"`
+	Messages           []string                                                `json:"messages,omitempty"`
+	Status             *adminpolicybasedroutev1.StatusType                     `json:"status,omitempty"`
+	NextHops           []NextHopStatusApplyConfiguration                       `json:"nextHops,omitempty"`
+	Conditions         []applyconfigurationsmetav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 }
 
 // AdminPolicyBasedRouteStatusApplyConfiguration constructs a declarative configuration of the AdminPolicyBasedRouteStatus type for use with
@@ -61,3 +64,29 @@ func (b *AdminPolicyBasedRouteStatusApplyConfiguration) WithStatus(value adminpo
 	b.Status = &value
 	return b
 }
+
+// WithNextHops adds the given value to the NextHops field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NextHops field.
+func (b *AdminPolicyBasedRouteStatusApplyConfiguration) WithNextHops(values ...*NextHopStatusApplyConfiguration) *AdminPolicyBasedRouteStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNextHops")
+		}
+		b.NextHops = append(b.NextHops, *values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *AdminPolicyBasedRouteStatusApplyConfiguration) WithConditions(values ...*applyconfigurationsmetav1.ConditionApplyConfiguration) *AdminPolicyBasedRouteStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
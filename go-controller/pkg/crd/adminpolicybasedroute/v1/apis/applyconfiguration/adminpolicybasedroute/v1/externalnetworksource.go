@@ -25,6 +25,7 @@ import (
 // with apply.
 type ExternalNetworkSourceApplyConfiguration struct {
 	NamespaceSelector *metav1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
+	PodSelector       *metav1.LabelSelectorApplyConfiguration `json:"podSelector,omitempty"`
 }
 
 // ExternalNetworkSourceApplyConfiguration constructs a declarative configuration of the ExternalNetworkSource type for use with
@@ -40,3 +41,11 @@ func (b *ExternalNetworkSourceApplyConfiguration) WithNamespaceSelector(value *m
 	b.NamespaceSelector = value
 	return b
 }
+
+// WithPodSelector sets the PodSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodSelector field is set to the value of the last call.
+func (b *ExternalNetworkSourceApplyConfiguration) WithPodSelector(value *metav1.LabelSelectorApplyConfiguration) *ExternalNetworkSourceApplyConfiguration {
+	b.PodSelector = value
+	return b
+}
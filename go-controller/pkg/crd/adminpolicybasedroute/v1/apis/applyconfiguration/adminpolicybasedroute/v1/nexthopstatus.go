@@ -0,0 +1,96 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	adminpolicybasedroutev1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
+)
+
+// NextHopStatusApplyConfiguration represents a declarative configuration of the NextHopStatus type for use
+// with apply.
+type NextHopStatusApplyConfiguration struct {
+	Zone             *string                                    `json:"zone,omitempty"`
+	IP               *string                                    `json:"ip,omitempty"`
+	Source           *adminpolicybasedroutev1.NextHopSourceType `json:"source,omitempty"`
+	Pod              *string                                    `json:"pod,omitempty"`
+	BFDEnabled       *bool                                      `json:"bfdEnabled,omitempty"`
+	BFDState         *adminpolicybasedroutev1.BFDStateType      `json:"bfdState,omitempty"`
+	ServedNamespaces *int32                                     `json:"servedNamespaces,omitempty"`
+}
+
+// NextHopStatusApplyConfiguration constructs a declarative configuration of the NextHopStatus type for use with
+// apply.
+func NextHopStatus() *NextHopStatusApplyConfiguration {
+	return &NextHopStatusApplyConfiguration{}
+}
+
+// WithZone sets the Zone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Zone field is set to the value of the last call.
+func (b *NextHopStatusApplyConfiguration) WithZone(value string) *NextHopStatusApplyConfiguration {
+	b.Zone = &value
+	return b
+}
+
+// WithIP sets the IP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IP field is set to the value of the last call.
+func (b *NextHopStatusApplyConfiguration) WithIP(value string) *NextHopStatusApplyConfiguration {
+	b.IP = &value
+	return b
+}
+
+// WithSource sets the Source field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Source field is set to the value of the last call.
+func (b *NextHopStatusApplyConfiguration) WithSource(value adminpolicybasedroutev1.NextHopSourceType) *NextHopStatusApplyConfiguration {
+	b.Source = &value
+	return b
+}
+
+// WithPod sets the Pod field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Pod field is set to the value of the last call.
+func (b *NextHopStatusApplyConfiguration) WithPod(value string) *NextHopStatusApplyConfiguration {
+	b.Pod = &value
+	return b
+}
+
+// WithBFDEnabled sets the BFDEnabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BFDEnabled field is set to the value of the last call.
+func (b *NextHopStatusApplyConfiguration) WithBFDEnabled(value bool) *NextHopStatusApplyConfiguration {
+	b.BFDEnabled = &value
+	return b
+}
+
+// WithBFDState sets the BFDState field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BFDState field is set to the value of the last call.
+func (b *NextHopStatusApplyConfiguration) WithBFDState(value adminpolicybasedroutev1.BFDStateType) *NextHopStatusApplyConfiguration {
+	b.BFDState = &value
+	return b
+}
+
+// WithServedNamespaces sets the ServedNamespaces field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServedNamespaces field is set to the value of the last call.
+func (b *NextHopStatusApplyConfiguration) WithServedNamespaces(value int32) *NextHopStatusApplyConfiguration {
+	b.ServedNamespaces = &value
+	return b
+}
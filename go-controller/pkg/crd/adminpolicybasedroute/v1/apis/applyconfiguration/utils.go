@@ -43,6 +43,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &adminpolicybasedroutev1.ExternalNetworkSourceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ExternalNextHops"):
 		return &adminpolicybasedroutev1.ExternalNextHopsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("NextHopStatus"):
+		return &adminpolicybasedroutev1.NextHopStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("StaticHop"):
 		return &adminpolicybasedroutev1.StaticHopApplyConfiguration{}
 
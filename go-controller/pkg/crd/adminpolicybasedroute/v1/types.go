@@ -42,16 +42,21 @@ type AdminPolicyBasedExternalRoute struct {
 
 // AdminPolicyBasedExternalRouteSpec defines the desired state of AdminPolicyBasedExternalRoute
 type AdminPolicyBasedExternalRouteSpec struct {
-	// From defines the selectors that will determine the target namespaces to this CR.
+	// From defines the selectors that will determine the target namespaces and pods to this CR.
 	From ExternalNetworkSource `json:"from"`
 	// NextHops defines two types of hops: Static and Dynamic. Each hop defines at least one external gateway IP.
 	NextHops ExternalNextHops `json:"nextHops"`
 }
 
-// ExternalNetworkSource contains the selectors used to determine the namespaces where the policy will be applied to
+// ExternalNetworkSource contains the selectors used to determine the namespaces and pods where the policy will be applied to
 type ExternalNetworkSource struct {
 	// NamespaceSelector defines a selector to be used to determine which namespaces will be targeted by this CR
 	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+	// PodSelector defines a selector to be used to determine which pods, in the namespaces selected by the
+	// NamespaceSelector, will be targeted by this CR. This field is optional, and in case it is not set all the pods
+	// in the selected namespaces are targeted.
+	// +optional
+	PodSelector metav1.LabelSelector `json:"podSelector,omitempty"`
 }
 
 // +kubebuilder:validation:MinProperties:=1
@@ -136,8 +141,75 @@ type AdminPolicyBasedRouteStatus struct {
 	// A concise indication of whether the AdminPolicyBasedRoute resource is applied with success
 	// +optional
 	Status StatusType `json:"status,omitempty"`
+	// NextHops reports the state of every next hop applied by the policy, per zone.
+	// +listType=map
+	// +listMapKey=zone
+	// +listMapKey=ip
+	// +optional
+	NextHops []NextHopStatus `json:"nextHops,omitempty"`
+	// Conditions aggregates the state of the next hops reported by all the zones.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// NextHopStatus reports the state of a next hop of the policy as applied by a zone.
+type NextHopStatus struct {
+	// Zone is the name of the zone that applied the next hop.
+	// +required
+	Zone string `json:"zone"`
+	// IP is the IP address of the next hop.
+	// +required
+	IP string `json:"ip"`
+	// Source tells whether the next hop is defined by a static hop or by a pod selected by a dynamic hop.
+	// +required
+	Source NextHopSourceType `json:"source"`
+	// Pod is the namespaced name of the pod providing the next hop, formatted as <namespace>/<name>, when the
+	// source is DynamicPod.
+	// +optional
+	Pod string `json:"pod,omitempty"`
+	// BFDEnabled tells whether BFD is enabled for the next hop.
+	// +optional
+	BFDEnabled bool `json:"bfdEnabled,omitempty"`
+	// BFDState is the state of the BFD sessions established with the next hop by the gateway routers of the zone.
+	// It is only set when BFD is enabled.
+	// +optional
+	BFDState BFDStateType `json:"bfdState,omitempty"`
+	// ServedNamespaces is the number of target namespaces whose traffic is routed through the next hop.
+	// +optional
+	ServedNamespaces int32 `json:"servedNamespaces,omitempty"`
 }
 
+// NextHopSourceType defines the types of next hop sources used in the Source field of the NextHopStatus.
+// +kubebuilder:validation:Enum=Static;DynamicPod
+type NextHopSourceType string
+
+const (
+	StaticNextHopSource     NextHopSourceType = "Static"
+	DynamicPodNextHopSource NextHopSourceType = "DynamicPod"
+)
+
+// BFDStateType defines the states of the BFD sessions used in the BFDState field of the NextHopStatus.
+// When a zone has several BFD sessions with the same next hop, the worst state is reported.
+// +kubebuilder:validation:Enum=Up;Init;Down;AdminDown;Unknown
+type BFDStateType string
+
+const (
+	BFDStateUp        BFDStateType = "Up"
+	BFDStateInit      BFDStateType = "Init"
+	BFDStateDown      BFDStateType = "Down"
+	BFDStateAdminDown BFDStateType = "AdminDown"
+	// BFDStateUnknown is reported while the BFD session is not established or its state is not known yet.
+	BFDStateUnknown BFDStateType = "Unknown"
+)
+
+// NextHopsReadyCondition is the condition type, set by the cluster manager, that tells whether all the next hops
+// with BFD enabled are up in all the zones.
+const NextHopsReadyCondition = "NextHopsReady"
+
 // StatusType defines the types of status used in the Status field. The value determines if the
 // deployment of the CR was successful or if it failed.
 type StatusType string
@@ -21,6 +21,7 @@ limitations under the License.
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -112,6 +113,18 @@ func (in *AdminPolicyBasedRouteStatus) DeepCopyInto(out *AdminPolicyBasedRouteSt
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NextHops != nil {
+		in, out := &in.NextHops, &out.NextHops
+		*out = make([]NextHopStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -147,6 +160,7 @@ func (in *DynamicHop) DeepCopy() *DynamicHop {
 func (in *ExternalNetworkSource) DeepCopyInto(out *ExternalNetworkSource) {
 	*out = *in
 	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	in.PodSelector.DeepCopyInto(&out.PodSelector)
 	return
 }
 
@@ -198,6 +212,22 @@ func (in *ExternalNextHops) DeepCopy() *ExternalNextHops {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NextHopStatus) DeepCopyInto(out *NextHopStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NextHopStatus.
+func (in *NextHopStatus) DeepCopy() *NextHopStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NextHopStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StaticHop) DeepCopyInto(out *StaticHop) {
 	*out = *in
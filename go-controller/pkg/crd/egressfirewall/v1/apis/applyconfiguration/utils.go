@@ -31,6 +31,10 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=k8s.ovn.org, Version=v1
+	case v1.SchemeGroupVersion.WithKind("ClusterEgressFirewall"):
+		return &egressfirewallv1.ClusterEgressFirewallApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ClusterEgressFirewallSpec"):
+		return &egressfirewallv1.ClusterEgressFirewallSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressFirewall"):
 		return &egressfirewallv1.EgressFirewallApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressFirewallDestination"):
@@ -27,6 +27,7 @@ import (
 
 type K8sV1Interface interface {
 	RESTClient() rest.Interface
+	ClusterEgressFirewallsGetter
 	EgressFirewallsGetter
 }
 
@@ -35,6 +36,10 @@ type K8sV1Client struct {
 	restClient rest.Interface
 }
 
+func (c *K8sV1Client) ClusterEgressFirewalls() ClusterEgressFirewallInterface {
+	return newClusterEgressFirewalls(c)
+}
+
 func (c *K8sV1Client) EgressFirewalls(namespace string) EgressFirewallInterface {
 	return newEgressFirewalls(c, namespace)
 }
@@ -27,6 +27,10 @@ type FakeK8sV1 struct {
 	*testing.Fake
 }
 
+func (c *FakeK8sV1) ClusterEgressFirewalls() v1.ClusterEgressFirewallInterface {
+	return newFakeClusterEgressFirewalls(c)
+}
+
 func (c *FakeK8sV1) EgressFirewalls(namespace string) v1.EgressFirewallInterface {
 	return newFakeEgressFirewalls(c, namespace)
 }
@@ -23,6 +23,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// ClusterEgressFirewalls returns a ClusterEgressFirewallInformer.
+	ClusterEgressFirewalls() ClusterEgressFirewallInformer
 	// EgressFirewalls returns a EgressFirewallInformer.
 	EgressFirewalls() EgressFirewallInformer
 }
@@ -38,6 +40,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// ClusterEgressFirewalls returns a ClusterEgressFirewallInformer.
+func (v *version) ClusterEgressFirewalls() ClusterEgressFirewallInformer {
+	return &clusterEgressFirewallInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // EgressFirewalls returns a EgressFirewallInformer.
 func (v *version) EgressFirewalls() EgressFirewallInformer {
 	return &egressFirewallInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
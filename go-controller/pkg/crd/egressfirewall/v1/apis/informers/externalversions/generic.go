@@ -52,6 +52,8 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=k8s.ovn.org, Version=v1
+	case v1.SchemeGroupVersion.WithResource("clusteregressfirewalls"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.K8s().V1().ClusterEgressFirewalls().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("egressfirewalls"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.K8s().V1().EgressFirewalls().Informer()}, nil
 
@@ -61,14 +61,37 @@ type EgressFirewallRule struct {
 }
 
 // EgressFirewallPort specifies the port to allow or deny traffic to
+// +kubebuilder:validation:XValidation:rule="!has(self.endPort) || (has(self.port) && self.endPort >= self.port)",message="endPort requires port and must be greater than or equal to port"
+// +kubebuilder:validation:XValidation:rule="!(self.protocol == 'ICMP' || self.protocol == 'ICMPv6') || (!has(self.port) && !has(self.endPort))",message="port and endPort are not allowed for ICMP and ICMPv6"
+// +kubebuilder:validation:XValidation:rule="self.protocol == 'ICMP' || self.protocol == 'ICMPv6' || (!has(self.icmpType) && !has(self.icmpCode))",message="icmpType and icmpCode are only allowed for ICMP and ICMPv6"
+// +kubebuilder:validation:XValidation:rule="!has(self.icmpCode) || has(self.icmpType)",message="icmpCode requires icmpType"
 type EgressFirewallPort struct {
-	// protocol (tcp, udp, sctp) that the traffic must match.
-	// +kubebuilder:validation:Pattern=^TCP|UDP|SCTP$
+	// protocol (tcp, udp, sctp, icmp, icmpv6) that the traffic must match.
+	// +kubebuilder:validation:Pattern=^TCP|UDP|SCTP|ICMP|ICMPv6$
 	Protocol string `json:"protocol"`
-	// port that the traffic must match
+	// port that the traffic must match. If endPort is also set, this is the first port of the range.
+	// If unset, all ports of the protocol are matched. Not allowed for ICMP and ICMPv6.
 	// +kubebuilder:validation:Minimum:=1
 	// +kubebuilder:validation:Maximum:=65535
-	Port int32 `json:"port"`
+	// +optional
+	Port int32 `json:"port,omitempty"`
+	// endPort indicates that the range of ports from port to endPort, inclusive, must be matched.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=65535
+	// +optional
+	EndPort *int32 `json:"endPort,omitempty"`
+	// icmpType is the ICMP or ICMPv6 type that the traffic must match.
+	// If unset, all ICMP or ICMPv6 traffic is matched.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=255
+	// +optional
+	ICMPType *int32 `json:"icmpType,omitempty"`
+	// icmpCode is the ICMP or ICMPv6 code that the traffic must match, together with icmpType.
+	// If unset, all codes of the given icmpType are matched.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=255
+	// +optional
+	ICMPCode *int32 `json:"icmpCode,omitempty"`
 }
 
 // +kubebuilder:validation:MinProperties:=1
@@ -99,3 +122,71 @@ type EgressFirewallList struct {
 	// List of EgressFirewalls.
 	Items []EgressFirewall `json:"items"`
 }
+
+// ClusterEgressFirewallPlacement defines where the rules of a ClusterEgressFirewall are evaluated
+// relative to the rules of the EgressFirewall of a selected namespace.
+// +kubebuilder:validation:Enum=BeforeNamespace;AfterNamespace
+type ClusterEgressFirewallPlacement string
+
+const (
+	// ClusterEgressFirewallPlacementBeforeNamespace evaluates the rules before the namespace EgressFirewall rules,
+	// so they can't be overridden by namespace owners.
+	ClusterEgressFirewallPlacementBeforeNamespace ClusterEgressFirewallPlacement = "BeforeNamespace"
+	// ClusterEgressFirewallPlacementAfterNamespace evaluates the rules after the namespace EgressFirewall rules,
+	// so they only apply to traffic that is not matched by the namespace EgressFirewall.
+	ClusterEgressFirewallPlacementAfterNamespace ClusterEgressFirewallPlacement = "AfterNamespace"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +resource:path=clusteregressfirewall
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=clusteregressfirewalls,scope=Cluster
+// +kubebuilder:singular=clusteregressfirewall
+// +kubebuilder:printcolumn:name="ClusterEgressFirewall Status",type=string,JSONPath=".status.status"
+// +kubebuilder:subresource:status
+// ClusterEgressFirewall describes an egress firewall that applies to all namespaces
+// selected by its namespaceSelector. Its rules are evaluated either before or after the
+// rules of the EgressFirewall of every selected namespace, depending on the placement.
+type ClusterEgressFirewall struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired behavior of ClusterEgressFirewall.
+	Spec ClusterEgressFirewallSpec `json:"spec"`
+	// Observed status of ClusterEgressFirewall
+	// +optional
+	Status EgressFirewallStatus `json:"status,omitempty"`
+}
+
+// ClusterEgressFirewallSpec is a desired state description of ClusterEgressFirewall.
+type ClusterEgressFirewallSpec struct {
+	// namespaceSelector selects the namespaces the rules apply to.
+	// An empty selector selects all namespaces.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+	// placement defines whether the rules are evaluated before or after the rules
+	// of the EgressFirewall in the selected namespaces.
+	Placement ClusterEgressFirewallPlacement `json:"placement"`
+	// priority orders ClusterEgressFirewalls with the same placement selecting the same namespace.
+	// Lower values are evaluated first. Two ClusterEgressFirewalls with the same placement and
+	// priority must not select the same namespace.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=9
+	Priority int32 `json:"priority"`
+	// a collection of egress firewall rule objects. dnsName destinations are not supported,
+	// as DNS names are only resolved for the namespace of an EgressFirewall.
+	// +kubebuilder:validation:MaxItems:=90
+	// +kubebuilder:validation:XValidation:rule="self.all(r, !has(r.to.dnsName))",message="dnsName is not supported in ClusterEgressFirewall rules"
+	Egress []EgressFirewallRule `json:"egress"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +resource:path=clusteregressfirewall
+// ClusterEgressFirewallList is the list of ClusterEgressFirewalls.
+type ClusterEgressFirewallList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of ClusterEgressFirewalls.
+	Items []ClusterEgressFirewall `json:"items"`
+}
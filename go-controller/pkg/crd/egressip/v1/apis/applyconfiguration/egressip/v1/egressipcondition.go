@@ -0,0 +1,88 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EgressIPConditionApplyConfiguration represents a declarative configuration of the EgressIPCondition type for use
+// with apply.
+type EgressIPConditionApplyConfiguration struct {
+	EgressIP           *string                           `json:"egressIP,omitempty"`
+	Type               *egressipv1.EgressIPConditionType `json:"type,omitempty"`
+	Status             *metav1.ConditionStatus           `json:"status,omitempty"`
+	Reason             *string                           `json:"reason,omitempty"`
+	Message            *string                           `json:"message,omitempty"`
+	LastTransitionTime *metav1.Time                      `json:"lastTransitionTime,omitempty"`
+}
+
+// EgressIPConditionApplyConfiguration constructs a declarative configuration of the EgressIPCondition type for use with
+// apply.
+func EgressIPCondition() *EgressIPConditionApplyConfiguration {
+	return &EgressIPConditionApplyConfiguration{}
+}
+
+// WithEgressIP sets the EgressIP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EgressIP field is set to the value of the last call.
+func (b *EgressIPConditionApplyConfiguration) WithEgressIP(value string) *EgressIPConditionApplyConfiguration {
+	b.EgressIP = &value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *EgressIPConditionApplyConfiguration) WithType(value egressipv1.EgressIPConditionType) *EgressIPConditionApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *EgressIPConditionApplyConfiguration) WithStatus(value metav1.ConditionStatus) *EgressIPConditionApplyConfiguration {
+	b.Status = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *EgressIPConditionApplyConfiguration) WithReason(value string) *EgressIPConditionApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *EgressIPConditionApplyConfiguration) WithMessage(value string) *EgressIPConditionApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithLastTransitionTime sets the LastTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastTransitionTime field is set to the value of the last call.
+func (b *EgressIPConditionApplyConfiguration) WithLastTransitionTime(value metav1.Time) *EgressIPConditionApplyConfiguration {
+	b.LastTransitionTime = &value
+	return b
+}
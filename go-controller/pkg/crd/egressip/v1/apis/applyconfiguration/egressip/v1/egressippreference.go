@@ -0,0 +1,56 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// EgressIPPreferenceApplyConfiguration represents a declarative configuration of the EgressIPPreference type for use
+// with apply.
+type EgressIPPreferenceApplyConfiguration struct {
+	EgressIP              *string                                 `json:"egressIP,omitempty"`
+	Weight                *int32                                  `json:"weight,omitempty"`
+	PreferredNodeSelector *EgressIPNodeSelectorApplyConfiguration `json:"preferredNodeSelector,omitempty"`
+}
+
+// EgressIPPreferenceApplyConfiguration constructs a declarative configuration of the EgressIPPreference type for use with
+// apply.
+func EgressIPPreference() *EgressIPPreferenceApplyConfiguration {
+	return &EgressIPPreferenceApplyConfiguration{}
+}
+
+// WithEgressIP sets the EgressIP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EgressIP field is set to the value of the last call.
+func (b *EgressIPPreferenceApplyConfiguration) WithEgressIP(value string) *EgressIPPreferenceApplyConfiguration {
+	b.EgressIP = &value
+	return b
+}
+
+// WithWeight sets the Weight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Weight field is set to the value of the last call.
+func (b *EgressIPPreferenceApplyConfiguration) WithWeight(value int32) *EgressIPPreferenceApplyConfiguration {
+	b.Weight = &value
+	return b
+}
+
+// WithPreferredNodeSelector sets the PreferredNodeSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreferredNodeSelector field is set to the value of the last call.
+func (b *EgressIPPreferenceApplyConfiguration) WithPreferredNodeSelector(value *EgressIPNodeSelectorApplyConfiguration) *EgressIPPreferenceApplyConfiguration {
+	b.PreferredNodeSelector = value
+	return b
+}
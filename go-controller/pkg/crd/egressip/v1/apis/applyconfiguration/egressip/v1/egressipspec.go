@@ -27,6 +27,7 @@ type EgressIPSpecApplyConfiguration struct {
 	EgressIPs         []string                                `json:"egressIPs,omitempty"`
 	NamespaceSelector *metav1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
 	PodSelector       *metav1.LabelSelectorApplyConfiguration `json:"podSelector,omitempty"`
+	Strategy          *EgressIPStrategyApplyConfiguration     `json:"strategy,omitempty"`
 }
 
 // EgressIPSpecApplyConfiguration constructs a declarative configuration of the EgressIPSpec type for use with
@@ -60,3 +61,11 @@ func (b *EgressIPSpecApplyConfiguration) WithPodSelector(value *metav1.LabelSele
 	b.PodSelector = value
 	return b
 }
+
+// WithStrategy sets the Strategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Strategy field is set to the value of the last call.
+func (b *EgressIPSpecApplyConfiguration) WithStrategy(value *EgressIPStrategyApplyConfiguration) *EgressIPSpecApplyConfiguration {
+	b.Strategy = value
+	return b
+}
@@ -20,7 +20,8 @@ package v1
 // EgressIPStatusApplyConfiguration represents a declarative configuration of the EgressIPStatus type for use
 // with apply.
 type EgressIPStatusApplyConfiguration struct {
-	Items []EgressIPStatusItemApplyConfiguration `json:"items,omitempty"`
+	Items      []EgressIPStatusItemApplyConfiguration `json:"items,omitempty"`
+	Conditions []EgressIPConditionApplyConfiguration  `json:"conditions,omitempty"`
 }
 
 // EgressIPStatusApplyConfiguration constructs a declarative configuration of the EgressIPStatus type for use with
@@ -41,3 +42,16 @@ func (b *EgressIPStatusApplyConfiguration) WithItems(values ...*EgressIPStatusIt
 	}
 	return b
 }
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *EgressIPStatusApplyConfiguration) WithConditions(values ...*EgressIPConditionApplyConfiguration) *EgressIPStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
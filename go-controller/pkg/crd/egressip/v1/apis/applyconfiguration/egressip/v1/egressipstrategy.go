@@ -0,0 +1,56 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+)
+
+// EgressIPStrategyApplyConfiguration represents a declarative configuration of the EgressIPStrategy type for use
+// with apply.
+type EgressIPStrategyApplyConfiguration struct {
+	Type      *egressipv1.EgressIPStrategyType       `json:"type,omitempty"`
+	EgressIPs []EgressIPPreferenceApplyConfiguration `json:"egressIPs,omitempty"`
+}
+
+// EgressIPStrategyApplyConfiguration constructs a declarative configuration of the EgressIPStrategy type for use with
+// apply.
+func EgressIPStrategy() *EgressIPStrategyApplyConfiguration {
+	return &EgressIPStrategyApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *EgressIPStrategyApplyConfiguration) WithType(value egressipv1.EgressIPStrategyType) *EgressIPStrategyApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithEgressIPs adds the given value to the EgressIPs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EgressIPs field.
+func (b *EgressIPStrategyApplyConfiguration) WithEgressIPs(values ...*EgressIPPreferenceApplyConfiguration) *EgressIPStrategyApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEgressIPs")
+		}
+		b.EgressIPs = append(b.EgressIPs, *values[i])
+	}
+	return b
+}
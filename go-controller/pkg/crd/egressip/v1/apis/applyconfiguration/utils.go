@@ -33,12 +33,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 	// Group=k8s.ovn.org, Version=v1
 	case v1.SchemeGroupVersion.WithKind("EgressIP"):
 		return &egressipv1.EgressIPApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressIPCondition"):
+		return &egressipv1.EgressIPConditionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressIPNodeSelector"):
+		return &egressipv1.EgressIPNodeSelectorApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressIPPreference"):
+		return &egressipv1.EgressIPPreferenceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressIPSpec"):
 		return &egressipv1.EgressIPSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressIPStatus"):
 		return &egressipv1.EgressIPStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EgressIPStatusItem"):
 		return &egressipv1.EgressIPStatusItemApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("EgressIPStrategy"):
+		return &egressipv1.EgressIPStrategyApplyConfiguration{}
 
 	}
 	return nil
@@ -33,6 +33,61 @@ type EgressIP struct {
 type EgressIPStatus struct {
 	// The list of assigned egress IPs and their corresponding node assignment.
 	Items []EgressIPStatusItem `json:"items"`
+	// Conditions reports, for every requested egress IP, whether it has been
+	// assigned to a node and, if it has not, the reason why.
+	// +optional
+	// +listType=map
+	// +listMapKey=egressIP
+	Conditions []EgressIPCondition `json:"conditions,omitempty"`
+}
+
+// EgressIPConditionType is the type of an EgressIPCondition.
+type EgressIPConditionType string
+
+const (
+	// EgressIPConditionAssigned indicates whether the egress IP is assigned to a node.
+	EgressIPConditionAssigned EgressIPConditionType = "Assigned"
+)
+
+// Reasons reported by EgressIPCondition.
+const (
+	// EgressIPReasonAssigned is set when the egress IP is assigned to a node.
+	EgressIPReasonAssigned = "Assigned"
+	// EgressIPReasonPending is set when the egress IP has not been processed yet,
+	// or its assignment is waiting for the cloud to confirm it.
+	EgressIPReasonPending = "Pending"
+	// EgressIPReasonNoAssignableNodes is set when no node is labeled and healthy
+	// for egress IP assignment.
+	EgressIPReasonNoAssignableNodes = "NoAssignableNodes"
+	// EgressIPReasonNoHostingNetwork is set when none of the assignable nodes
+	// has a network containing the egress IP.
+	EgressIPReasonNoHostingNetwork = "NoHostingNetwork"
+	// EgressIPReasonNodesInUse is set when every node able to host the egress IP
+	// already hosts another egress IP of the same EgressIP object.
+	EgressIPReasonNodesInUse = "NodesInUse"
+	// EgressIPReasonCapacityExhausted is set when every node able to host the
+	// egress IP has exhausted its egress IP capacity.
+	EgressIPReasonCapacityExhausted = "CapacityExhausted"
+	// EgressIPReasonConflict is set when the egress IP is already in use by a
+	// host interface or by another EgressIP object.
+	EgressIPReasonConflict = "Conflict"
+)
+
+// EgressIPCondition describes the assignment state of a single requested egress IP.
+type EgressIPCondition struct {
+	// EgressIP is the requested egress IP this condition refers to.
+	EgressIP string `json:"egressIP"`
+	// Type of the condition.
+	Type EgressIPConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status metav1.ConditionStatus `json:"status"`
+	// Reason is a CamelCase reason for the condition's last transition.
+	Reason string `json:"reason"`
+	// Message is a human readable message indicating details about the transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime is the last time the condition transitioned from one status to another.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
 }
 
 // The per node status, for those egress IPs who have been assigned.
@@ -44,9 +99,11 @@ type EgressIPStatusItem struct {
 }
 
 // EgressIPSpec is a desired state description of EgressIP.
+// +kubebuilder:validation:XValidation:rule="!has(self.strategy) || !has(self.strategy.egressIPs) || self.strategy.egressIPs.all(e, e.egressIP in self.egressIPs)",message="egress IPs of the strategy must be listed in spec.egressIPs"
 type EgressIPSpec struct {
 	// EgressIPs is the list of egress IP addresses requested. Can be IPv4 and/or IPv6.
 	// This field is mandatory.
+	// +kubebuilder:validation:MaxItems=256
 	EgressIPs []string `json:"egressIPs"`
 	// NamespaceSelector applies the egress IP only to the namespace(s) whose label
 	// matches this definition. This field is mandatory.
@@ -60,6 +117,84 @@ type EgressIPSpec struct {
 	// match this pod selector.
 	// +optional
 	PodSelector metav1.LabelSelector `json:"podSelector,omitempty"`
+	// Strategy defines how the egress IPs are used by the selected pods and
+	// which nodes are preferred to host them. This field is optional, and in
+	// case it is not set: traffic of each selected pod is balanced across all
+	// assigned egress IPs with equal cost, and egress IPs are assigned to the
+	// nodes with the fewest assignments.
+	// +optional
+	Strategy *EgressIPStrategy `json:"strategy,omitempty"`
+}
+
+// EgressIPStrategyType is the type of an EgressIPStrategy.
+// +kubebuilder:validation:Enum=ActiveStandby;Weighted
+type EgressIPStrategyType string
+
+const (
+	// EgressIPStrategyActiveStandby sends the traffic of all the selected pods
+	// through the most preferred egress IP that is assigned, the others being
+	// used only when it is not assigned anymore.
+	EgressIPStrategyActiveStandby EgressIPStrategyType = "ActiveStandby"
+	// EgressIPStrategyWeighted sends the traffic of each selected pod through
+	// a single assigned egress IP, chosen so that the share of pods using each
+	// egress IP follows its weight.
+	EgressIPStrategyWeighted EgressIPStrategyType = "Weighted"
+)
+
+// EgressIPStrategy defines how the egress IPs of an EgressIP are used.
+// +kubebuilder:validation:XValidation:rule="self.type == 'Weighted' || !has(self.egressIPs) || self.egressIPs.all(e, !has(e.weight))",message="weight is only supported with the Weighted strategy"
+type EgressIPStrategy struct {
+	// Type of the strategy.
+	Type EgressIPStrategyType `json:"type"`
+	// EgressIPs configures individual egress IPs. The order of this list is the
+	// order of preference used by the ActiveStandby strategy: the first egress
+	// IP is the active one. Egress IPs of spec.egressIPs that are not listed
+	// are the least preferred, in the order of spec.egressIPs, and have a
+	// weight of 1.
+	// +optional
+	// +listType=map
+	// +listMapKey=egressIP
+	// +kubebuilder:validation:MaxItems=64
+	EgressIPs []EgressIPPreference `json:"egressIPs,omitempty"`
+}
+
+// EgressIPPreference configures how a single egress IP is used.
+type EgressIPPreference struct {
+	// EgressIP is one of the egress IPs listed in spec.egressIPs.
+	EgressIP string `json:"egressIP"`
+	// Weight is the relative share of the selected pods sending their traffic
+	// through this egress IP. Only supported with the Weighted strategy.
+	// Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Weight *int32 `json:"weight,omitempty"`
+	// PreferredNodeSelector selects the nodes that are preferred to host this
+	// egress IP. When none of the nodes it selects can host the egress IP, any
+	// other egress assignable node may be used. An egress IP already assigned
+	// is not moved to a preferred node once one becomes available.
+	// +optional
+	PreferredNodeSelector *EgressIPNodeSelector `json:"preferredNodeSelector,omitempty"`
+}
+
+// EgressIPNodeSelector selects nodes by their labels. It has the semantics of
+// a metav1.LabelSelector with a bounded number of requirements.
+// +kubebuilder:validation:XValidation:rule="!has(self.matchExpressions) || self.matchExpressions.all(r, r.operator in ['In', 'NotIn'] ? has(r.values) && size(r.values) > 0 : r.operator in ['Exists', 'DoesNotExist'] && (!has(r.values) || size(r.values) == 0))",message="operators In and NotIn require values, Exists and DoesNotExist don't allow them"
+// +structType=atomic
+type EgressIPNodeSelector struct {
+	// matchLabels is a map of {key,value} pairs. A single {key,value} in the
+	// matchLabels map is equivalent to an element of matchExpressions, whose
+	// key field is "key", the operator is "In", and the values array contains
+	// only "value". The requirements are ANDed.
+	// +optional
+	// +kubebuilder:validation:MaxProperties=16
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+	// matchExpressions is a list of label selector requirements. The
+	// requirements are ANDed.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	MatchExpressions []metav1.LabelSelectorRequirement `json:"matchExpressions,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -21,6 +21,7 @@ limitations under the License.
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -52,6 +53,23 @@ func (in *EgressIP) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressIPCondition) DeepCopyInto(out *EgressIPCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressIPCondition.
+func (in *EgressIPCondition) DeepCopy() *EgressIPCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressIPCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressIPList) DeepCopyInto(out *EgressIPList) {
 	*out = *in
@@ -85,6 +103,62 @@ func (in *EgressIPList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressIPNodeSelector) DeepCopyInto(out *EgressIPNodeSelector) {
+	*out = *in
+	if in.MatchLabels != nil {
+		in, out := &in.MatchLabels, &out.MatchLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MatchExpressions != nil {
+		in, out := &in.MatchExpressions, &out.MatchExpressions
+		*out = make([]metav1.LabelSelectorRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressIPNodeSelector.
+func (in *EgressIPNodeSelector) DeepCopy() *EgressIPNodeSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressIPNodeSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressIPPreference) DeepCopyInto(out *EgressIPPreference) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PreferredNodeSelector != nil {
+		in, out := &in.PreferredNodeSelector, &out.PreferredNodeSelector
+		*out = new(EgressIPNodeSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressIPPreference.
+func (in *EgressIPPreference) DeepCopy() *EgressIPPreference {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressIPPreference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressIPSpec) DeepCopyInto(out *EgressIPSpec) {
 	*out = *in
@@ -95,6 +169,11 @@ func (in *EgressIPSpec) DeepCopyInto(out *EgressIPSpec) {
 	}
 	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
 	in.PodSelector.DeepCopyInto(&out.PodSelector)
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(EgressIPStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -116,6 +195,13 @@ func (in *EgressIPStatus) DeepCopyInto(out *EgressIPStatus) {
 		*out = make([]EgressIPStatusItem, len(*in))
 		copy(*out, *in)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]EgressIPCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -144,3 +230,26 @@ func (in *EgressIPStatusItem) DeepCopy() *EgressIPStatusItem {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressIPStrategy) DeepCopyInto(out *EgressIPStrategy) {
+	*out = *in
+	if in.EgressIPs != nil {
+		in, out := &in.EgressIPs, &out.EgressIPs
+		*out = make([]EgressIPPreference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressIPStrategy.
+func (in *EgressIPStrategy) DeepCopy() *EgressIPStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressIPStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
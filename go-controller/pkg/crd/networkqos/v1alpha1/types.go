@@ -45,6 +45,9 @@ type NetworkQoS struct {
 }
 
 // Spec defines the desired state of NetworkQoS
+// +kubebuilder:validation:XValidation:rule="has(self.egress) || has(self.ingress)", message="at least one of egress or ingress rules must be specified"
+// +kubebuilder:validation:XValidation:rule="!has(self.egress) || self.egress.all(r, !has(r.classifier) || !has(r.classifier.from))", message="classifier.from is not allowed in egress rules"
+// +kubebuilder:validation:XValidation:rule="!has(self.ingress) || self.ingress.all(r, !has(r.classifier) || !has(r.classifier.to))", message="classifier.to is not allowed in ingress rules"
 type Spec struct {
 	// networkSelector selects the networks on which the pod IPs need to be added to the source address set.
 	// NetworkQoS controller currently supports `NetworkAttachmentDefinitions` type only.
@@ -71,7 +74,17 @@ type Spec struct {
 	// determined by the order in which the rule is written. Thus, a rule that appears
 	// first in the list of egress rules would take the lower precedence.
 	// +kubebuilder:validation:MaxItems=20
-	Egress []Rule `json:"egress"`
+	// +optional
+	Egress []Rule `json:"egress,omitempty"`
+
+	// ingress a collection of Ingress NetworkQoS rule objects. A total of 20 rules will
+	// be allowed in each NetworkQoS instance. Ingress rules apply to the traffic
+	// entering the pods selected by podSelector, and their classifier matches the
+	// source of the traffic with `from` instead of `to`. The relative precedence of
+	// ingress rules follows the same ordering semantics as egress rules.
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	Ingress []Rule `json:"ingress,omitempty"`
 }
 
 type Rule struct {
@@ -83,7 +96,8 @@ type Rule struct {
 	// classifier The classifier on which packets should match
 	// to apply the NetworkQoS Rule.
 	// This field is optional, and in case it is not set the rule is applied
-	// to all egress traffic regardless of the destination.
+	// to all egress traffic regardless of the destination, or to all ingress
+	// traffic regardless of the source.
 	// +optional
 	Classifier Classifier `json:"classifier"`
 
@@ -92,9 +106,14 @@ type Rule struct {
 }
 
 type Classifier struct {
+	// to matches the destination of the traffic. Only valid in egress rules.
 	// +optional
 	To []Destination `json:"to"`
 
+	// from matches the source of the traffic. Only valid in ingress rules.
+	// +optional
+	From []Destination `json:"from,omitempty"`
+
 	// +optional
 	Ports []*Port `json:"ports"`
 }
@@ -132,7 +151,8 @@ type Port struct {
 	Port *int32 `json:"port"`
 }
 
-// Destination describes a peer to apply NetworkQoS configuration for the outgoing traffic.
+// Destination describes a peer to apply NetworkQoS configuration for the outgoing traffic,
+// or for the incoming traffic when used in the `from` field of an ingress rule.
 // Only certain combinations of fields are allowed.
 // +kubebuilder:validation:XValidation:rule="!(has(self.ipBlock) && (has(self.podSelector) || has(self.namespaceSelector)))",message="Can't specify both podSelector/namespaceSelector and ipBlock"
 type Destination struct {
@@ -0,0 +1,61 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	observabilityconfigv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// FeatureSamplingApplyConfiguration represents a declarative configuration of the FeatureSampling type for use
+// with apply.
+type FeatureSamplingApplyConfiguration struct {
+	Feature           *observabilityconfigv1.SampleFeature    `json:"feature,omitempty"`
+	Probability       *int32                                  `json:"probability,omitempty"`
+	NamespaceSelector *metav1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
+}
+
+// FeatureSamplingApplyConfiguration constructs a declarative configuration of the FeatureSampling type for use with
+// apply.
+func FeatureSampling() *FeatureSamplingApplyConfiguration {
+	return &FeatureSamplingApplyConfiguration{}
+}
+
+// WithFeature sets the Feature field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Feature field is set to the value of the last call.
+func (b *FeatureSamplingApplyConfiguration) WithFeature(value observabilityconfigv1.SampleFeature) *FeatureSamplingApplyConfiguration {
+	b.Feature = &value
+	return b
+}
+
+// WithProbability sets the Probability field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Probability field is set to the value of the last call.
+func (b *FeatureSamplingApplyConfiguration) WithProbability(value int32) *FeatureSamplingApplyConfiguration {
+	b.Probability = &value
+	return b
+}
+
+// WithNamespaceSelector sets the NamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NamespaceSelector field is set to the value of the last call.
+func (b *FeatureSamplingApplyConfiguration) WithNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *FeatureSamplingApplyConfiguration {
+	b.NamespaceSelector = value
+	return b
+}
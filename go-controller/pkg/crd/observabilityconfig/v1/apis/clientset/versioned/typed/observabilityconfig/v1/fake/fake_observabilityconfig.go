@@ -0,0 +1,52 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1"
+	observabilityconfigv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1/apis/applyconfiguration/observabilityconfig/v1"
+	typedobservabilityconfigv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1/apis/clientset/versioned/typed/observabilityconfig/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeObservabilityConfigs implements ObservabilityConfigInterface
+type fakeObservabilityConfigs struct {
+	*gentype.FakeClientWithListAndApply[*v1.ObservabilityConfig, *v1.ObservabilityConfigList, *observabilityconfigv1.ObservabilityConfigApplyConfiguration]
+	Fake *FakeK8sV1
+}
+
+func newFakeObservabilityConfigs(fake *FakeK8sV1) typedobservabilityconfigv1.ObservabilityConfigInterface {
+	return &fakeObservabilityConfigs{
+		gentype.NewFakeClientWithListAndApply[*v1.ObservabilityConfig, *v1.ObservabilityConfigList, *observabilityconfigv1.ObservabilityConfigApplyConfiguration](
+			fake.Fake,
+			"",
+			v1.SchemeGroupVersion.WithResource("observabilityconfigs"),
+			v1.SchemeGroupVersion.WithKind("ObservabilityConfig"),
+			func() *v1.ObservabilityConfig { return &v1.ObservabilityConfig{} },
+			func() *v1.ObservabilityConfigList { return &v1.ObservabilityConfigList{} },
+			func(dst, src *v1.ObservabilityConfigList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.ObservabilityConfigList) []*v1.ObservabilityConfig {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1.ObservabilityConfigList, items []*v1.ObservabilityConfig) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
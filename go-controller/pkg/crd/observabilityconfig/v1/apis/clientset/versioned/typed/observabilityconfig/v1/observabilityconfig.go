@@ -0,0 +1,71 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	context "context"
+
+	observabilityconfigv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1"
+	applyconfigurationobservabilityconfigv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1/apis/applyconfiguration/observabilityconfig/v1"
+	scheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1/apis/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// ObservabilityConfigsGetter has a method to return a ObservabilityConfigInterface.
+// A group's client should implement this interface.
+type ObservabilityConfigsGetter interface {
+	ObservabilityConfigs() ObservabilityConfigInterface
+}
+
+// ObservabilityConfigInterface has methods to work with ObservabilityConfig resources.
+type ObservabilityConfigInterface interface {
+	Create(ctx context.Context, observabilityConfig *observabilityconfigv1.ObservabilityConfig, opts metav1.CreateOptions) (*observabilityconfigv1.ObservabilityConfig, error)
+	Update(ctx context.Context, observabilityConfig *observabilityconfigv1.ObservabilityConfig, opts metav1.UpdateOptions) (*observabilityconfigv1.ObservabilityConfig, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*observabilityconfigv1.ObservabilityConfig, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*observabilityconfigv1.ObservabilityConfigList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *observabilityconfigv1.ObservabilityConfig, err error)
+	Apply(ctx context.Context, observabilityConfig *applyconfigurationobservabilityconfigv1.ObservabilityConfigApplyConfiguration, opts metav1.ApplyOptions) (result *observabilityconfigv1.ObservabilityConfig, err error)
+	ObservabilityConfigExpansion
+}
+
+// observabilityConfigs implements ObservabilityConfigInterface
+type observabilityConfigs struct {
+	*gentype.ClientWithListAndApply[*observabilityconfigv1.ObservabilityConfig, *observabilityconfigv1.ObservabilityConfigList, *applyconfigurationobservabilityconfigv1.ObservabilityConfigApplyConfiguration]
+}
+
+// newObservabilityConfigs returns a ObservabilityConfigs
+func newObservabilityConfigs(c *K8sV1Client) *observabilityConfigs {
+	return &observabilityConfigs{
+		gentype.NewClientWithListAndApply[*observabilityconfigv1.ObservabilityConfig, *observabilityconfigv1.ObservabilityConfigList, *applyconfigurationobservabilityconfigv1.ObservabilityConfigApplyConfiguration](
+			"observabilityconfigs",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *observabilityconfigv1.ObservabilityConfig { return &observabilityconfigv1.ObservabilityConfig{} },
+			func() *observabilityconfigv1.ObservabilityConfigList {
+				return &observabilityconfigv1.ObservabilityConfigList{}
+			},
+		),
+	}
+}
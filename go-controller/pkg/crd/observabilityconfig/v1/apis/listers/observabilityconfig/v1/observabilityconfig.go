@@ -0,0 +1,47 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	observabilityconfigv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ObservabilityConfigLister helps list ObservabilityConfigs.
+// All objects returned here must be treated as read-only.
+type ObservabilityConfigLister interface {
+	// List lists all ObservabilityConfigs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*observabilityconfigv1.ObservabilityConfig, err error)
+	// Get retrieves the ObservabilityConfig from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*observabilityconfigv1.ObservabilityConfig, error)
+	ObservabilityConfigListerExpansion
+}
+
+// observabilityConfigLister implements the ObservabilityConfigLister interface.
+type observabilityConfigLister struct {
+	listers.ResourceIndexer[*observabilityconfigv1.ObservabilityConfig]
+}
+
+// NewObservabilityConfigLister returns a new ObservabilityConfigLister.
+func NewObservabilityConfigLister(indexer cache.Indexer) ObservabilityConfigLister {
+	return &observabilityConfigLister{listers.New[*observabilityconfigv1.ObservabilityConfig](indexer, observabilityconfigv1.Resource("observabilityconfig"))}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=observabilityconfigs,scope=Cluster,shortName=observ
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="Collector Set",type=integer,JSONPath=".spec.collectorSetID"
+// ObservabilityConfig configures the sampling of the OVN-Kubernetes features for a consumer of the samples,
+// such as ovnkube-observ. Every ObservabilityConfig sends the samples of the selected features to its own
+// collector set, with its own probability, so that different consumers can sample different features.
+// When no ObservabilityConfig exists, all the features are sampled with 100% probability to the collector set 42.
+type ObservabilityConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ObservabilityConfigSpec `json:"spec"`
+}
+
+// ObservabilityConfigSpec defines the desired sampling of an ObservabilityConfig.
+type ObservabilityConfigSpec struct {
+	// CollectorSetID is the ID of the OVS collector set that receives the samples, as configured in the
+	// Flow_Sample_Collector_Set table of OVS by the consumer of the samples. Every ObservabilityConfig must
+	// use a different collector set, the ones reusing the collector set of an older ObservabilityConfig are ignored.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	CollectorSetID int64 `json:"collectorSetID"`
+
+	// Features is the list of the sampled features.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=feature
+	Features []FeatureSampling `json:"features"`
+}
+
+// FeatureSampling defines how a feature is sampled.
+// +kubebuilder:validation:XValidation:rule="!has(self.namespaceSelector) || self.feature in ['EgressFirewall', 'NetworkPolicy', 'Multicast']",message="namespaceSelector is only supported for the EgressFirewall, NetworkPolicy and Multicast features"
+type FeatureSampling struct {
+	// Feature is the sampled feature.
+	// +kubebuilder:validation:Required
+	Feature SampleFeature `json:"feature"`
+
+	// Probability is the percentage of the packets matching the feature that are sampled.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=100
+	// +optional
+	Probability int32 `json:"probability,omitempty"`
+
+	// NamespaceSelector limits the sampling to the objects of the selected namespaces, e.g. to the network
+	// policies of the namespaces. Objects that don't belong to a namespace, such as the cluster wide multicast
+	// ACLs, are not sampled when it is set. This field is optional, and in case it is not set the objects of
+	// all the namespaces are sampled.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// SampleFeature is a feature of OVN-Kubernetes that can be sampled.
+// +kubebuilder:validation:Enum=EgressFirewall;NetworkPolicy;AdminNetworkPolicy;Multicast;UDNIsolation
+type SampleFeature string
+
+const (
+	EgressFirewallSampleFeature     SampleFeature = "EgressFirewall"
+	NetworkPolicySampleFeature      SampleFeature = "NetworkPolicy"
+	AdminNetworkPolicySampleFeature SampleFeature = "AdminNetworkPolicy"
+	MulticastSampleFeature          SampleFeature = "Multicast"
+	UDNIsolationSampleFeature       SampleFeature = "UDNIsolation"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=observabilityconfigs
+// ObservabilityConfigList contains a list of ObservabilityConfig
+type ObservabilityConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ObservabilityConfig `json:"items"`
+}
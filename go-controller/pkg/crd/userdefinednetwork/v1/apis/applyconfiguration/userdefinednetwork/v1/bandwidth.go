@@ -0,0 +1,47 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// BandwidthApplyConfiguration represents a declarative configuration of the Bandwidth type for use
+// with apply.
+type BandwidthApplyConfiguration struct {
+	IngressRate *int64 `json:"ingressRate,omitempty"`
+	EgressRate  *int64 `json:"egressRate,omitempty"`
+}
+
+// BandwidthApplyConfiguration constructs a declarative configuration of the Bandwidth type for use with
+// apply.
+func Bandwidth() *BandwidthApplyConfiguration {
+	return &BandwidthApplyConfiguration{}
+}
+
+// WithIngressRate sets the IngressRate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IngressRate field is set to the value of the last call.
+func (b *BandwidthApplyConfiguration) WithIngressRate(value int64) *BandwidthApplyConfiguration {
+	b.IngressRate = &value
+	return b
+}
+
+// WithEgressRate sets the EgressRate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EgressRate field is set to the value of the last call.
+func (b *BandwidthApplyConfiguration) WithEgressRate(value int64) *BandwidthApplyConfiguration {
+	b.EgressRate = &value
+	return b
+}
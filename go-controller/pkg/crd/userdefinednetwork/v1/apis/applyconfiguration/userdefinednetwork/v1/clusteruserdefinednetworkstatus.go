@@ -25,6 +25,7 @@ import (
 // with apply.
 type ClusterUserDefinedNetworkStatusApplyConfiguration struct {
 	Conditions []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	Capacity   []SubnetCapacityApplyConfiguration   `json:"capacity,omitempty"`
 }
 
 // ClusterUserDefinedNetworkStatusApplyConfiguration constructs a declarative configuration of the ClusterUserDefinedNetworkStatus type for use with
@@ -45,3 +46,16 @@ func (b *ClusterUserDefinedNetworkStatusApplyConfiguration) WithConditions(value
 	}
 	return b
 }
+
+// WithCapacity adds the given value to the Capacity field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Capacity field.
+func (b *ClusterUserDefinedNetworkStatusApplyConfiguration) WithCapacity(values ...*SubnetCapacityApplyConfiguration) *ClusterUserDefinedNetworkStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithCapacity")
+		}
+		b.Capacity = append(b.Capacity, *values[i])
+	}
+	return b
+}
@@ -24,11 +24,14 @@ import (
 // Layer2ConfigApplyConfiguration represents a declarative configuration of the Layer2Config type for use
 // with apply.
 type Layer2ConfigApplyConfiguration struct {
-	Role        *userdefinednetworkv1.NetworkRole    `json:"role,omitempty"`
-	MTU         *int32                               `json:"mtu,omitempty"`
-	Subnets     *userdefinednetworkv1.DualStackCIDRs `json:"subnets,omitempty"`
-	JoinSubnets *userdefinednetworkv1.DualStackCIDRs `json:"joinSubnets,omitempty"`
-	IPAM        *IPAMConfigApplyConfiguration        `json:"ipam,omitempty"`
+	Role            *userdefinednetworkv1.NetworkRole    `json:"role,omitempty"`
+	MTU             *int32                               `json:"mtu,omitempty"`
+	Bandwidth       *BandwidthApplyConfiguration         `json:"bandwidth,omitempty"`
+	Subnets         *userdefinednetworkv1.DualStackCIDRs `json:"subnets,omitempty"`
+	ExcludeSubnets  []userdefinednetworkv1.CIDR          `json:"excludeSubnets,omitempty"`
+	ReservedSubnets []userdefinednetworkv1.CIDR          `json:"reservedSubnets,omitempty"`
+	JoinSubnets     *userdefinednetworkv1.DualStackCIDRs `json:"joinSubnets,omitempty"`
+	IPAM            *IPAMConfigApplyConfiguration        `json:"ipam,omitempty"`
 }
 
 // Layer2ConfigApplyConfiguration constructs a declarative configuration of the Layer2Config type for use with
@@ -53,6 +56,14 @@ func (b *Layer2ConfigApplyConfiguration) WithMTU(value int32) *Layer2ConfigApply
 	return b
 }
 
+// WithBandwidth sets the Bandwidth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bandwidth field is set to the value of the last call.
+func (b *Layer2ConfigApplyConfiguration) WithBandwidth(value *BandwidthApplyConfiguration) *Layer2ConfigApplyConfiguration {
+	b.Bandwidth = value
+	return b
+}
+
 // WithSubnets sets the Subnets field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Subnets field is set to the value of the last call.
@@ -61,6 +72,26 @@ func (b *Layer2ConfigApplyConfiguration) WithSubnets(value userdefinednetworkv1.
 	return b
 }
 
+// WithExcludeSubnets adds the given value to the ExcludeSubnets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ExcludeSubnets field.
+func (b *Layer2ConfigApplyConfiguration) WithExcludeSubnets(values ...userdefinednetworkv1.CIDR) *Layer2ConfigApplyConfiguration {
+	for i := range values {
+		b.ExcludeSubnets = append(b.ExcludeSubnets, values[i])
+	}
+	return b
+}
+
+// WithReservedSubnets adds the given value to the ReservedSubnets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ReservedSubnets field.
+func (b *Layer2ConfigApplyConfiguration) WithReservedSubnets(values ...userdefinednetworkv1.CIDR) *Layer2ConfigApplyConfiguration {
+	for i := range values {
+		b.ReservedSubnets = append(b.ReservedSubnets, values[i])
+	}
+	return b
+}
+
 // WithJoinSubnets sets the JoinSubnets field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the JoinSubnets field is set to the value of the last call.
@@ -30,6 +30,7 @@ type LocalnetConfigApplyConfiguration struct {
 	ExcludeSubnets      []userdefinednetworkv1.CIDR          `json:"excludeSubnets,omitempty"`
 	IPAM                *IPAMConfigApplyConfiguration        `json:"ipam,omitempty"`
 	MTU                 *int32                               `json:"mtu,omitempty"`
+	Bandwidth           *BandwidthApplyConfiguration         `json:"bandwidth,omitempty"`
 	VLAN                *VLANConfigApplyConfiguration        `json:"vlan,omitempty"`
 }
 
@@ -89,6 +90,14 @@ func (b *LocalnetConfigApplyConfiguration) WithMTU(value int32) *LocalnetConfigA
 	return b
 }
 
+// WithBandwidth sets the Bandwidth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bandwidth field is set to the value of the last call.
+func (b *LocalnetConfigApplyConfiguration) WithBandwidth(value *BandwidthApplyConfiguration) *LocalnetConfigApplyConfiguration {
+	b.Bandwidth = value
+	return b
+}
+
 // WithVLAN sets the VLAN field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the VLAN field is set to the value of the last call.
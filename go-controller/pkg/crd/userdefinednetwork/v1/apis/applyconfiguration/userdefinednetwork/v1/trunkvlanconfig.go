@@ -0,0 +1,49 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// TrunkVLANConfigApplyConfiguration represents a declarative configuration of the TrunkVLANConfig type for use
+// with apply.
+type TrunkVLANConfigApplyConfiguration struct {
+	AllowedVLANs []string `json:"allowedVLANs,omitempty"`
+	NativeVLAN   *int32   `json:"nativeVLAN,omitempty"`
+}
+
+// TrunkVLANConfigApplyConfiguration constructs a declarative configuration of the TrunkVLANConfig type for use with
+// apply.
+func TrunkVLANConfig() *TrunkVLANConfigApplyConfiguration {
+	return &TrunkVLANConfigApplyConfiguration{}
+}
+
+// WithAllowedVLANs adds the given value to the AllowedVLANs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowedVLANs field.
+func (b *TrunkVLANConfigApplyConfiguration) WithAllowedVLANs(values ...string) *TrunkVLANConfigApplyConfiguration {
+	for i := range values {
+		b.AllowedVLANs = append(b.AllowedVLANs, values[i])
+	}
+	return b
+}
+
+// WithNativeVLAN sets the NativeVLAN field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NativeVLAN field is set to the value of the last call.
+func (b *TrunkVLANConfigApplyConfiguration) WithNativeVLAN(value int32) *TrunkVLANConfigApplyConfiguration {
+	b.NativeVLAN = &value
+	return b
+}
@@ -27,6 +27,7 @@ type UserDefinedNetworkSpecApplyConfiguration struct {
 	Topology *userdefinednetworkv1.NetworkTopology `json:"topology,omitempty"`
 	Layer3   *Layer3ConfigApplyConfiguration       `json:"layer3,omitempty"`
 	Layer2   *Layer2ConfigApplyConfiguration       `json:"layer2,omitempty"`
+	Localnet *LocalnetConfigApplyConfiguration     `json:"localnet,omitempty"`
 }
 
 // UserDefinedNetworkSpecApplyConfiguration constructs a declarative configuration of the UserDefinedNetworkSpec type for use with
@@ -58,3 +59,11 @@ func (b *UserDefinedNetworkSpecApplyConfiguration) WithLayer2(value *Layer2Confi
 	b.Layer2 = value
 	return b
 }
+
+// WithLocalnet sets the Localnet field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Localnet field is set to the value of the last call.
+func (b *UserDefinedNetworkSpecApplyConfiguration) WithLocalnet(value *LocalnetConfigApplyConfiguration) *UserDefinedNetworkSpecApplyConfiguration {
+	b.Localnet = value
+	return b
+}
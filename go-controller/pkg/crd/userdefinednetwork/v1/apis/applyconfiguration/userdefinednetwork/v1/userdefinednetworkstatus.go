@@ -25,6 +25,7 @@ import (
 // with apply.
 type UserDefinedNetworkStatusApplyConfiguration struct {
 	Conditions []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	Capacity   []SubnetCapacityApplyConfiguration   `json:"capacity,omitempty"`
 }
 
 // UserDefinedNetworkStatusApplyConfiguration constructs a declarative configuration of the UserDefinedNetworkStatus type for use with
@@ -45,3 +46,16 @@ func (b *UserDefinedNetworkStatusApplyConfiguration) WithConditions(values ...*m
 	}
 	return b
 }
+
+// WithCapacity adds the given value to the Capacity field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Capacity field.
+func (b *UserDefinedNetworkStatusApplyConfiguration) WithCapacity(values ...*SubnetCapacityApplyConfiguration) *UserDefinedNetworkStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithCapacity")
+		}
+		b.Capacity = append(b.Capacity, *values[i])
+	}
+	return b
+}
@@ -26,6 +26,7 @@ import (
 type VLANConfigApplyConfiguration struct {
 	Mode   *userdefinednetworkv1.VLANMode      `json:"mode,omitempty"`
 	Access *AccessVLANConfigApplyConfiguration `json:"access,omitempty"`
+	Trunk  *TrunkVLANConfigApplyConfiguration  `json:"trunk,omitempty"`
 }
 
 // VLANConfigApplyConfiguration constructs a declarative configuration of the VLANConfig type for use with
@@ -49,3 +50,11 @@ func (b *VLANConfigApplyConfiguration) WithAccess(value *AccessVLANConfigApplyCo
 	b.Access = value
 	return b
 }
+
+// WithTrunk sets the Trunk field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Trunk field is set to the value of the last call.
+func (b *VLANConfigApplyConfiguration) WithTrunk(value *TrunkVLANConfigApplyConfiguration) *VLANConfigApplyConfiguration {
+	b.Trunk = value
+	return b
+}
@@ -33,6 +33,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 	// Group=k8s.ovn.org, Version=v1
 	case v1.SchemeGroupVersion.WithKind("AccessVLANConfig"):
 		return &userdefinednetworkv1.AccessVLANConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Bandwidth"):
+		return &userdefinednetworkv1.BandwidthApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("CapacityUsage"):
+		return &userdefinednetworkv1.CapacityUsageApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ClusterUserDefinedNetwork"):
 		return &userdefinednetworkv1.ClusterUserDefinedNetworkApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ClusterUserDefinedNetworkSpec"):
@@ -51,6 +55,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &userdefinednetworkv1.LocalnetConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("NetworkSpec"):
 		return &userdefinednetworkv1.NetworkSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("SubnetCapacity"):
+		return &userdefinednetworkv1.SubnetCapacityApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("TrunkVLANConfig"):
+		return &userdefinednetworkv1.TrunkVLANConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("UserDefinedNetwork"):
 		return &userdefinednetworkv1.UserDefinedNetworkApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("UserDefinedNetworkSpec"):
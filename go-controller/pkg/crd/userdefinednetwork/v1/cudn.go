@@ -33,7 +33,7 @@ type ClusterUserDefinedNetworkSpec struct {
 	// +kubebuilder:validation:XValidation:rule="has(self.topology) && self.topology == 'Layer3' ? has(self.layer3): !has(self.layer3)", message="spec.layer3 is required when topology is Layer3 and forbidden otherwise"
 	// +kubebuilder:validation:XValidation:rule="has(self.topology) && self.topology == 'Layer2' ? has(self.layer2): !has(self.layer2)", message="spec.layer2 is required when topology is Layer2 and forbidden otherwise"
 	// +kubebuilder:validation:XValidation:rule="has(self.topology) && self.topology == 'Localnet' ? has(self.localnet): !has(self.localnet)", message="spec.localnet is required when topology is Localnet and forbidden otherwise"
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="Network spec is immutable"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf || self.topology == 'Layer3' && oldSelf.topology == 'Layer3'", message="Network spec is immutable"
 	// +required
 	Network NetworkSpec `json:"network"`
 }
@@ -74,6 +74,13 @@ type ClusterUserDefinedNetworkStatus struct {
 	// +listMapKey=type
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Capacity reports the usage of each of the network subnets.
+	// +listType=map
+	// +listMapKey=cidr
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	Capacity []SubnetCapacity `json:"capacity,omitempty"`
 }
 
 // ClusterUserDefinedNetworkList contains a list of ClusterUserDefinedNetwork.
@@ -176,11 +183,22 @@ type LocalnetConfig struct {
 	// +optional
 	MTU int32 `json:"mtu,omitempty"`
 
+	// bandwidth is the default bandwidth limit of the pod interfaces attached to the network.
+	// bandwidth is optional. It takes precedence over the pod's `kubernetes.io/ingress-bandwidth` and
+	// `kubernetes.io/egress-bandwidth` annotations.
+	//
+	// +optional
+	Bandwidth *Bandwidth `json:"bandwidth,omitempty"`
+
 	// vlan configuration for the network.
 	// vlan.mode is the VLAN mode.
 	//   When "Access" is set, OVN-Kubernetes configures the network logical switch port in access mode.
+	//   When "Trunk" is set, OVN-Kubernetes lets the connected pods send and receive tagged traffic for the allowed VLANs.
 	// vlan.access is the access VLAN configuration.
 	// vlan.access.id is the VLAN ID (VID) to be set on the network logical switch port.
+	// vlan.trunk is the trunk VLAN configuration.
+	// vlan.trunk.allowedVLANs is the list of VLAN IDs and VLAN ID ranges allowed to be carried tagged.
+	// vlan.trunk.nativeVLAN is the VLAN untagged traffic belongs to.
 	// vlan is optional, when omitted the underlying network default VLAN will be used (usually `1`).
 	// When set, OVN-Kubernetes will apply VLAN configuration to the SDN infra and to the connected pods.
 	//
@@ -198,18 +216,47 @@ type AccessVLANConfig struct {
 	ID int32 `json:"id"`
 }
 
-// +kubebuilder:validation:Enum=Access
+// TrunkVLANConfig describes a trunk VLAN configuration.
+type TrunkVLANConfig struct {
+	// allowedVLANs is the list of VLANs the connected pods are allowed to send and receive tagged traffic for.
+	// Each item is either a single VLAN ID (e.g.: "100") or an inclusive range of VLAN IDs (e.g.: "200-210").
+	// VLAN IDs should be higher than 0 and lower than 4095.
+	// Tagged traffic for any other VLAN is dropped.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +kubebuilder:validation:items:Pattern=`^[0-9]{1,4}(-[0-9]{1,4})?$`
+	// +listType=set
+	AllowedVLANs []string `json:"allowedVLANs"`
+
+	// nativeVLAN is the VLAN ID (VID) untagged traffic belongs to, it should match the native VLAN configured
+	// for the physical network.
+	// nativeVLAN is optional. When omitted, untagged traffic is dropped.
+	// When set, untagged traffic is forwarded as is, and the physical network is expected to map it to the native VLAN.
+	// nativeVLAN is required when the network has subnets, since the pod IPs are configured on the untagged interface.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4094
+	NativeVLAN int32 `json:"nativeVLAN,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Access;Trunk
 type VLANMode string
 
-const VLANModeAccess VLANMode = "Access"
+const (
+	VLANModeAccess VLANMode = "Access"
+	VLANModeTrunk  VLANMode = "Trunk"
+)
 
 // VLANConfig describes the network VLAN configuration.
 // +union
 // +kubebuilder:validation:XValidation:rule="has(self.mode) && self.mode == 'Access' ? has(self.access): !has(self.access)", message="vlan access config is required when vlan mode is 'Access', and forbidden otherwise"
+// +kubebuilder:validation:XValidation:rule="has(self.mode) && self.mode == 'Trunk' ? has(self.trunk): !has(self.trunk)", message="vlan trunk config is required when vlan mode is 'Trunk', and forbidden otherwise"
 type VLANConfig struct {
 	// mode describe the network VLAN mode.
-	// Allowed value is "Access".
+	// Allowed values are "Access" and "Trunk".
 	// Access sets the network logical switch port in access mode, according to the config.
+	// Trunk lets the connected pods send and receive tagged traffic for the allowed VLANs, according to the config.
 	// +required
 	// +unionDiscriminator
 	Mode VLANMode `json:"mode"`
@@ -217,4 +264,8 @@ type VLANConfig struct {
 	// Access is the access VLAN configuration
 	// +optional
 	Access *AccessVLANConfig `json:"access"`
+
+	// Trunk is the trunk VLAN configuration
+	// +optional
+	Trunk *TrunkVLANConfig `json:"trunk,omitempty"`
 }
@@ -24,7 +24,10 @@ const (
 )
 
 // +kubebuilder:validation:XValidation:rule="!has(self.joinSubnets) || has(self.role) && self.role == 'Primary'", message="JoinSubnets is only supported for Primary network"
-// +kubebuilder:validation:XValidation:rule="!has(self.subnets) || !has(self.mtu) || !self.subnets.exists_one(i, isCIDR(i.cidr) && cidr(i.cidr).ip().family() == 6) || self.mtu >= 1280", message="MTU should be greater than or equal to 1280 when IPv6 subnet is used"
+// +kubebuilder:validation:XValidation:rule="!has(self.subnets) || !has(self.mtu) || !self.subnets.exists(i, isCIDR(i.cidr) && cidr(i.cidr).ip().family() == 6) || self.mtu >= 1280", message="MTU should be greater than or equal to 1280 when IPv6 subnet is used"
+// +kubebuilder:validation:XValidation:rule="self.role == oldSelf.role && has(self.mtu) == has(oldSelf.mtu) && (!has(self.mtu) || self.mtu == oldSelf.mtu) && has(self.excludeSubnets) == has(oldSelf.excludeSubnets) && (!has(self.excludeSubnets) || self.excludeSubnets == oldSelf.excludeSubnets) && has(self.reservedSubnets) == has(oldSelf.reservedSubnets) && (!has(self.reservedSubnets) || self.reservedSubnets == oldSelf.reservedSubnets) && has(self.joinSubnets) == has(oldSelf.joinSubnets) && (!has(self.joinSubnets) || self.joinSubnets == oldSelf.joinSubnets) && has(self.bandwidth) == has(oldSelf.bandwidth) && (!has(self.bandwidth) || self.bandwidth == oldSelf.bandwidth)", message="Layer3 configuration is immutable, except for appending subnets"
+// +kubebuilder:validation:XValidation:rule="oldSelf.subnets.all(s, s in self.subnets)", message="Subnets can only be appended, existing subnets cannot be changed or removed"
+// +kubebuilder:validation:XValidation:rule="self.subnets.all(s, !isCIDR(s.cidr) || oldSelf.subnets.exists(o, isCIDR(o.cidr) && cidr(o.cidr).ip().family() == cidr(s.cidr).ip().family()))", message="Appended subnets must be from the IP families already in use"
 type Layer3Config struct {
 	// Role describes the network role in the pod.
 	//
@@ -46,17 +49,53 @@ type Layer3Config struct {
 	// +optional
 	MTU int32 `json:"mtu,omitempty"`
 
+	// Bandwidth is the default bandwidth limit of the pod interfaces attached to the network.
+	//
+	// Bandwidth is optional. It takes precedence over the pod's `kubernetes.io/ingress-bandwidth` and
+	// `kubernetes.io/egress-bandwidth` annotations.
+	//
+	// +optional
+	Bandwidth *Bandwidth `json:"bandwidth,omitempty"`
+
 	// Subnets are used for the pod network across the cluster.
 	//
 	// Dual-stack clusters may set 2 subnets (one for each IP family), otherwise only 1 subnet is allowed.
 	// Given subnet is split into smaller subnets for every node.
+	// Once the network is created, subnets of the IP families already in use can be appended to extend the
+	// network, for instance when it runs out of node subnets. Existing subnets cannot be changed or removed.
 	//
 	// +kubebuilder:validation:MinItems=1
-	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:MaxItems=8
 	// +required
-	// +kubebuilder:validation:XValidation:rule="size(self) != 2 || !isCIDR(self[0].cidr) || !isCIDR(self[1].cidr) || cidr(self[0].cidr).ip().family() != cidr(self[1].cidr).ip().family()", message="When 2 CIDRs are set, they must be from different IP families"
+	// +kubebuilder:validation:XValidation:rule="oldSelf.hasValue() || size(self) <= 2",message="At most 2 subnets can be set when the network is created",optionalOldSelf=true
+	// +kubebuilder:validation:XValidation:rule="oldSelf.hasValue() || size(self) != 2 || !isCIDR(self[0].cidr) || !isCIDR(self[1].cidr) || cidr(self[0].cidr).ip().family() != cidr(self[1].cidr).ip().family()",message="When 2 CIDRs are set, they must be from different IP families",optionalOldSelf=true
 	Subnets []Layer3Subnet `json:"subnets,omitempty"`
 
+	// excludeSubnets is a list of CIDRs to be removed from the specified CIDRs in `subnets`.
+	// The CIDRs in this list must be in range of at least one subnet specified in `subnets`.
+	// excludeSubnets is optional. When omitted no IP address is excluded and all IP addresses specified in `subnets`
+	// are subject to assignment.
+	// The format should match standard CIDR notation (for example, "10.128.0.0/16").
+	// Excluded IP addresses are never assigned to pods, on whichever node subnet they fall.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=25
+	ExcludeSubnets []CIDR `json:"excludeSubnets,omitempty"`
+
+	// reservedSubnets is a list of CIDRs whose IP addresses are not assigned automatically to pods, but can still
+	// be requested explicitly by a pod, for instance through the `ips` field of the `k8s.v1.cni.cncf.io/networks`
+	// annotation. This allows migrated workloads to keep their historic addresses without colliding with the
+	// addresses assigned to other pods.
+	// The CIDRs in this list must be in range of at least one subnet specified in `subnets` and must not overlap
+	// with `excludeSubnets`.
+	// reservedSubnets is optional. The format should match standard CIDR notation (for example, "10.128.0.0/24").
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=25
+	ReservedSubnets []CIDR `json:"reservedSubnets,omitempty"`
+
 	// JoinSubnets are used inside the OVN network topology.
 	//
 	// Dual-stack clusters may set 2 subnets (one for each IP family), otherwise only 1 subnet is allowed.
@@ -91,6 +130,8 @@ type Layer3Subnet struct {
 // +kubebuilder:validation:XValidation:rule="!has(self.ipam) || !has(self.ipam.mode) || self.ipam.mode != 'Disabled' || self.role == 'Secondary'", message="Disabled ipam.mode is only supported for Secondary network"
 // +kubebuilder:validation:XValidation:rule="!has(self.joinSubnets) || has(self.role) && self.role == 'Primary'", message="JoinSubnets is only supported for Primary network"
 // +kubebuilder:validation:XValidation:rule="!has(self.subnets) || !has(self.mtu) || !self.subnets.exists_one(i, isCIDR(i) && cidr(i).ip().family() == 6) || self.mtu >= 1280", message="MTU should be greater than or equal to 1280 when IPv6 subnet is used"
+// +kubebuilder:validation:XValidation:rule="!has(self.excludeSubnets) || has(self.subnets)", message="excludeSubnets must be unset when subnets is unset"
+// +kubebuilder:validation:XValidation:rule="!has(self.reservedSubnets) || has(self.subnets)", message="reservedSubnets must be unset when subnets is unset"
 type Layer2Config struct {
 	// Role describes the network role in the pod.
 	//
@@ -110,6 +151,13 @@ type Layer2Config struct {
 	// +optional
 	MTU int32 `json:"mtu,omitempty"`
 
+	// Bandwidth is the default bandwidth limit of the pod interfaces attached to the network.
+	// Bandwidth is optional. It takes precedence over the pod's `kubernetes.io/ingress-bandwidth` and
+	// `kubernetes.io/egress-bandwidth` annotations.
+	//
+	// +optional
+	Bandwidth *Bandwidth `json:"bandwidth,omitempty"`
+
 	// Subnets are used for the pod network across the cluster.
 	// Dual-stack clusters may set 2 subnets (one for each IP family), otherwise only 1 subnet is allowed.
 	//
@@ -119,6 +167,32 @@ type Layer2Config struct {
 	// +optional
 	Subnets DualStackCIDRs `json:"subnets,omitempty"`
 
+	// excludeSubnets is a list of CIDRs to be removed from the specified CIDRs in `subnets`.
+	// The CIDRs in this list must be in range of at least one subnet specified in `subnets`.
+	// excludeSubnets is optional. When omitted no IP address is excluded and all IP addresses specified in `subnets`
+	// are subject to assignment.
+	// The format should match standard CIDR notation (for example, "10.128.0.0/16").
+	// This field must be omitted if `subnets` is unset.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=25
+	ExcludeSubnets []CIDR `json:"excludeSubnets,omitempty"`
+
+	// reservedSubnets is a list of CIDRs whose IP addresses are not assigned automatically to pods, but can still
+	// be requested explicitly by a pod, for instance through the `ips` field of the `k8s.v1.cni.cncf.io/networks`
+	// annotation. This allows migrated workloads to keep their historic addresses without colliding with the
+	// addresses assigned to other pods.
+	// The CIDRs in this list must be in range of at least one subnet specified in `subnets` and must not overlap
+	// with `excludeSubnets`.
+	// reservedSubnets is optional. The format should match standard CIDR notation (for example, "10.128.0.0/24").
+	// This field must be omitted if `subnets` is unset.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=25
+	ReservedSubnets []CIDR `json:"reservedSubnets,omitempty"`
+
 	// JoinSubnets are used inside the OVN network topology.
 	//
 	// Dual-stack clusters may set 2 subnets (one for each IP family), otherwise only 1 subnet is allowed.
@@ -159,6 +233,22 @@ type IPAMConfig struct {
 	Lifecycle NetworkIPAMLifecycle `json:"lifecycle,omitempty"`
 }
 
+// Bandwidth is the bandwidth limit of a pod interface.
+// +kubebuilder:validation:MinProperties=1
+type Bandwidth struct {
+	// IngressRate is the rate limit, in bits per second, of the traffic received by the pod interface.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	IngressRate int64 `json:"ingressRate,omitempty"`
+
+	// EgressRate is the rate limit, in bits per second, of the traffic sent by the pod interface.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	EgressRate int64 `json:"egressRate,omitempty"`
+}
+
 // +kubebuilder:validation:Enum=Enabled;Disabled
 type IPAMMode string
 
@@ -187,3 +277,33 @@ type CIDR string
 // +kubebuilder:validation:MaxItems=2
 // +kubebuilder:validation:XValidation:rule="size(self) != 2 || !isCIDR(self[0]) || !isCIDR(self[1]) || cidr(self[0]).ip().family() != cidr(self[1]).ip().family()", message="When 2 CIDRs are set, they must be from different IP families"
 type DualStackCIDRs []CIDR
+
+// SubnetCapacity reports how much of a network subnet is in use.
+type SubnetCapacity struct {
+	// CIDR is the network subnet the capacity is reported for.
+	// +required
+	CIDR CIDR `json:"cidr"`
+
+	// HostSubnets reports the per-node subnets carved out of the subnet.
+	// Only reported for the Layer3 topology.
+	// +optional
+	HostSubnets *CapacityUsage `json:"hostSubnets,omitempty"`
+
+	// IPs reports the pod IP addresses allocated out of the subnet, excluding the
+	// reserved and excluded ones.
+	// Only reported for the Layer2 and Localnet topologies, and only when interconnect
+	// is enabled, since pod IPs are otherwise allocated by the ovnkube-controller.
+	// +optional
+	IPs *CapacityUsage `json:"ips,omitempty"`
+}
+
+// CapacityUsage reports the total and used amount of an allocatable resource.
+type CapacityUsage struct {
+	// Total is the number of items that can be allocated.
+	// +required
+	Total int64 `json:"total"`
+
+	// Used is the number of items currently allocated.
+	// +required
+	Used int64 `json:"used"`
+}
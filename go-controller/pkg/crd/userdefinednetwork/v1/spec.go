@@ -13,8 +13,7 @@ func (s *UserDefinedNetworkSpec) GetLayer2() *Layer2Config {
 }
 
 func (s *UserDefinedNetworkSpec) GetLocalnet() *LocalnetConfig {
-	// localnet is not supported
-	return nil
+	return s.Localnet
 }
 
 func (s *NetworkSpec) GetTopology() NetworkTopology {
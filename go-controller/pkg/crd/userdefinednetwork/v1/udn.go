@@ -14,9 +14,10 @@ type UserDefinedNetwork struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="Spec is immutable"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf || self.topology == 'Layer3' && oldSelf.topology == 'Layer3'", message="Spec is immutable"
 	// +kubebuilder:validation:XValidation:rule="has(self.topology) && self.topology == 'Layer3' ? has(self.layer3): !has(self.layer3)", message="spec.layer3 is required when topology is Layer3 and forbidden otherwise"
 	// +kubebuilder:validation:XValidation:rule="has(self.topology) && self.topology == 'Layer2' ? has(self.layer2): !has(self.layer2)", message="spec.layer2 is required when topology is Layer2 and forbidden otherwise"
+	// +kubebuilder:validation:XValidation:rule="has(self.topology) && self.topology == 'Localnet' ? has(self.localnet): !has(self.localnet)", message="spec.localnet is required when topology is Localnet and forbidden otherwise"
 	// +required
 	Spec UserDefinedNetworkSpec `json:"spec"`
 	// +optional
@@ -28,11 +29,13 @@ type UserDefinedNetwork struct {
 type UserDefinedNetworkSpec struct {
 	// Topology describes network configuration.
 	//
-	// Allowed values are "Layer3", "Layer2".
+	// Allowed values are "Layer3", "Layer2" and "Localnet".
 	// Layer3 topology creates a layer 2 segment per node, each with a different subnet. Layer 3 routing is used to interconnect node subnets.
 	// Layer2 topology creates one logical switch shared by all nodes.
+	// Localnet topology is based on layer 2 topology, but also allows connecting to an existent (configured) physical network to provide north-south traffic to the workloads.
+	// Localnet is only allowed for the physicalNetworkName and VLAN pairs allowed by the cluster administrator.
 	//
-	// +kubebuilder:validation:Enum=Layer2;Layer3
+	// +kubebuilder:validation:Enum=Layer2;Layer3;Localnet
 	// +kubebuilder:validation:Required
 	// +required
 	// +unionDiscriminator
@@ -45,6 +48,10 @@ type UserDefinedNetworkSpec struct {
 	// Layer2 is the Layer2 topology configuration.
 	// +optional
 	Layer2 *Layer2Config `json:"layer2,omitempty"`
+
+	// Localnet is the Localnet topology configuration.
+	// +optional
+	Localnet *LocalnetConfig `json:"localnet,omitempty"`
 }
 
 // UserDefinedNetworkStatus contains the observed status of the UserDefinedNetwork.
@@ -52,6 +59,13 @@ type UserDefinedNetworkStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Capacity reports the usage of each of the network subnets.
+	// +listType=map
+	// +listMapKey=cidr
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	Capacity []SubnetCapacity `json:"capacity,omitempty"`
 }
 
 // UserDefinedNetworkList contains a list of UserDefinedNetwork.
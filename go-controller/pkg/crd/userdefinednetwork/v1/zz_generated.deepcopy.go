@@ -41,6 +41,38 @@ func (in *AccessVLANConfig) DeepCopy() *AccessVLANConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Bandwidth) DeepCopyInto(out *Bandwidth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bandwidth.
+func (in *Bandwidth) DeepCopy() *Bandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(Bandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityUsage) DeepCopyInto(out *CapacityUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityUsage.
+func (in *CapacityUsage) DeepCopy() *CapacityUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterUserDefinedNetwork) DeepCopyInto(out *ClusterUserDefinedNetwork) {
 	*out = *in
@@ -130,6 +162,13 @@ func (in *ClusterUserDefinedNetworkStatus) DeepCopyInto(out *ClusterUserDefinedN
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make([]SubnetCapacity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -182,11 +221,26 @@ func (in *IPAMConfig) DeepCopy() *IPAMConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Layer2Config) DeepCopyInto(out *Layer2Config) {
 	*out = *in
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(Bandwidth)
+		**out = **in
+	}
 	if in.Subnets != nil {
 		in, out := &in.Subnets, &out.Subnets
 		*out = make(DualStackCIDRs, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExcludeSubnets != nil {
+		in, out := &in.ExcludeSubnets, &out.ExcludeSubnets
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReservedSubnets != nil {
+		in, out := &in.ReservedSubnets, &out.ReservedSubnets
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
 	if in.JoinSubnets != nil {
 		in, out := &in.JoinSubnets, &out.JoinSubnets
 		*out = make(DualStackCIDRs, len(*in))
@@ -213,11 +267,26 @@ func (in *Layer2Config) DeepCopy() *Layer2Config {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Layer3Config) DeepCopyInto(out *Layer3Config) {
 	*out = *in
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(Bandwidth)
+		**out = **in
+	}
 	if in.Subnets != nil {
 		in, out := &in.Subnets, &out.Subnets
 		*out = make([]Layer3Subnet, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExcludeSubnets != nil {
+		in, out := &in.ExcludeSubnets, &out.ExcludeSubnets
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReservedSubnets != nil {
+		in, out := &in.ReservedSubnets, &out.ReservedSubnets
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
 	if in.JoinSubnets != nil {
 		in, out := &in.JoinSubnets, &out.JoinSubnets
 		*out = make(DualStackCIDRs, len(*in))
@@ -270,6 +339,11 @@ func (in *LocalnetConfig) DeepCopyInto(out *LocalnetConfig) {
 		*out = new(IPAMConfig)
 		**out = **in
 	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(Bandwidth)
+		**out = **in
+	}
 	if in.VLAN != nil {
 		in, out := &in.VLAN, &out.VLAN
 		*out = new(VLANConfig)
@@ -319,6 +393,53 @@ func (in *NetworkSpec) DeepCopy() *NetworkSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetCapacity) DeepCopyInto(out *SubnetCapacity) {
+	*out = *in
+	if in.HostSubnets != nil {
+		in, out := &in.HostSubnets, &out.HostSubnets
+		*out = new(CapacityUsage)
+		**out = **in
+	}
+	if in.IPs != nil {
+		in, out := &in.IPs, &out.IPs
+		*out = new(CapacityUsage)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetCapacity.
+func (in *SubnetCapacity) DeepCopy() *SubnetCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrunkVLANConfig) DeepCopyInto(out *TrunkVLANConfig) {
+	*out = *in
+	if in.AllowedVLANs != nil {
+		in, out := &in.AllowedVLANs, &out.AllowedVLANs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrunkVLANConfig.
+func (in *TrunkVLANConfig) DeepCopy() *TrunkVLANConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TrunkVLANConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserDefinedNetwork) DeepCopyInto(out *UserDefinedNetwork) {
 	*out = *in
@@ -393,6 +514,11 @@ func (in *UserDefinedNetworkSpec) DeepCopyInto(out *UserDefinedNetworkSpec) {
 		*out = new(Layer2Config)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Localnet != nil {
+		in, out := &in.Localnet, &out.Localnet
+		*out = new(LocalnetConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -416,6 +542,13 @@ func (in *UserDefinedNetworkStatus) DeepCopyInto(out *UserDefinedNetworkStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make([]SubnetCapacity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -437,6 +570,11 @@ func (in *VLANConfig) DeepCopyInto(out *VLANConfig) {
 		*out = new(AccessVLANConfig)
 		**out = **in
 	}
+	if in.Trunk != nil {
+		in, out := &in.Trunk, &out.Trunk
+		*out = new(TrunkVLANConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -44,6 +44,7 @@ import (
 	"k8s.io/apimachinery/pkg/selection"
 	utilwait "k8s.io/apimachinery/pkg/util/wait"
 	informerfactory "k8s.io/client-go/informers"
+	appsinformers "k8s.io/client-go/informers/apps/v1"
 	certificatesinformers "k8s.io/client-go/informers/certificates/v1"
 	v1coreinformers "k8s.io/client-go/informers/core/v1"
 	discoveryinformers "k8s.io/client-go/informers/discovery/v1"
@@ -86,6 +87,10 @@ import (
 	networkqosinformerfactory "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1/apis/informers/externalversions"
 	networkqosinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1/apis/informers/externalversions/networkqos/v1alpha1"
 	networkqoslister "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1/apis/listers/networkqos/v1alpha1"
+	observabilityconfigapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1"
+	observabilityconfigscheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1/apis/clientset/versioned/scheme"
+	observabilityconfiginformerfactory "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1/apis/informers/externalversions"
+	observabilityconfiginformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1/apis/informers/externalversions/observabilityconfig/v1"
 	routeadvertisementsapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
 	routeadvertisementsscheme "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/clientset/versioned/scheme"
 	routeadvertisementsinformerfactory "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/informers/externalversions"
@@ -124,6 +129,7 @@ type WatchFactory struct {
 	raFactory            routeadvertisementsinformerfactory.SharedInformerFactory
 	frrFactory           frrinformerfactory.SharedInformerFactory
 	networkQoSFactory    networkqosinformerfactory.SharedInformerFactory
+	observFactory        observabilityconfiginformerfactory.SharedInformerFactory
 	informers            map[reflect.Type]*informer
 
 	stopChan chan struct{}
@@ -152,6 +158,7 @@ func (wf *WatchFactory) ShallowClone() *WatchFactory {
 		raFactory:            wf.raFactory,
 		frrFactory:           wf.frrFactory,
 		networkQoSFactory:    wf.networkQoSFactory,
+		observFactory:        wf.observFactory,
 		informers:            wf.informers,
 		stopChan:             wf.stopChan,
 
@@ -364,6 +371,10 @@ func NewOVNKubeControllerWatchFactory(ovnClientset *util.OVNKubeControllerClient
 		return nil, err
 	}
 
+	if err := observabilityconfigapi.AddToScheme(observabilityconfigscheme.Scheme); err != nil {
+		return nil, err
+	}
+
 	// For Services and Endpoints, pre-populate the shared Informer with one that
 	// has a label selector excluding headless services.
 	wf.iFactory.InformerFor(&corev1.Service{}, func(c kubernetes.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
@@ -441,6 +452,8 @@ func NewOVNKubeControllerWatchFactory(ovnClientset *util.OVNKubeControllerClient
 		if err != nil {
 			return nil, err
 		}
+		// make sure shared informer is created for a factory, so on wf.efFactory.Start() it is initialized and caches are synced.
+		wf.efFactory.K8s().V1().ClusterEgressFirewalls().Informer()
 
 		if config.OVNKubernetesFeature.EnableDNSNameResolver {
 			// make sure shared informer is created for a factory, so on wf.dnsFactory.Start() it is initialized and caches are synced.
@@ -522,6 +535,12 @@ func NewOVNKubeControllerWatchFactory(ovnClientset *util.OVNKubeControllerClient
 		}
 	}
 
+	if config.OVNKubernetesFeature.EnableObservability {
+		wf.observFactory = observabilityconfiginformerfactory.NewSharedInformerFactory(ovnClientset.ObservabilityConfigClient, resyncInterval)
+		// make sure shared informer is created for a factory, so on wf.observFactory.Start() it is initialized and caches are synced.
+		wf.observFactory.K8s().V1().ObservabilityConfigs().Informer()
+	}
+
 	return wf, nil
 }
 
@@ -665,6 +684,15 @@ func (wf *WatchFactory) Start() error {
 		}
 	}
 
+	if wf.observFactory != nil {
+		wf.observFactory.Start(wf.stopChan)
+		for oType, synced := range waitForCacheSyncWithTimeout(wf.observFactory, wf.stopChan) {
+			if !synced {
+				return fmt.Errorf("error in syncing cache for %v informer", oType)
+			}
+		}
+	}
+
 	if config.OVNKubernetesFeature.EnableNetworkQoS && wf.networkQoSFactory != nil {
 		wf.networkQoSFactory.Start(wf.stopChan)
 		for oType, synced := range waitForCacheSyncWithTimeout(wf.networkQoSFactory, wf.stopChan) {
@@ -727,6 +755,10 @@ func (wf *WatchFactory) Stop() {
 	if wf.networkQoSFactory != nil {
 		wf.networkQoSFactory.Shutdown()
 	}
+
+	if wf.observFactory != nil {
+		wf.observFactory.Shutdown()
+	}
 }
 
 // NewNodeWatchFactory initializes a watch factory with significantly fewer
@@ -1045,6 +1077,11 @@ func NewClusterManagerWatchFactory(ovnClientset *util.OVNClusterManagerClientset
 				if err != nil {
 					return nil, err
 				}
+
+				if util.IsStatefulSetPersistentIPsEnabled() {
+					// make sure statefulset informer cache is initialized and synced on Start().
+					wf.iFactory.Apps().V1().StatefulSets().Informer()
+				}
 			}
 		}
 	}
@@ -1057,6 +1094,7 @@ func NewClusterManagerWatchFactory(ovnClientset *util.OVNClusterManagerClientset
 	if config.OVNKubernetesFeature.EnableEgressFirewall {
 		// make sure shared informer is created for a factory, so on wf.efFactory.Start() it is initialized and caches are synced.
 		wf.efFactory.K8s().V1().EgressFirewalls().Informer()
+		wf.efFactory.K8s().V1().ClusterEgressFirewalls().Informer()
 
 		if config.OVNKubernetesFeature.EnableDNSNameResolver {
 			// make sure shared informer is created for a factory, so on wf.dnsFactory.Start() it is initialized and caches are synced.
@@ -1739,6 +1777,10 @@ func (wf *WatchFactory) PodCoreInformer() v1coreinformers.PodInformer {
 	return wf.iFactory.Core().V1().Pods()
 }
 
+func (wf *WatchFactory) StatefulSetCoreInformer() appsinformers.StatefulSetInformer {
+	return wf.iFactory.Apps().V1().StatefulSets()
+}
+
 func (wf *WatchFactory) NamespaceInformer() v1coreinformers.NamespaceInformer {
 	return wf.iFactory.Core().V1().Namespaces()
 }
@@ -1791,6 +1833,10 @@ func (wf *WatchFactory) EgressFirewallInformer() egressfirewallinformer.EgressFi
 	return wf.efFactory.K8s().V1().EgressFirewalls()
 }
 
+func (wf *WatchFactory) ClusterEgressFirewallInformer() egressfirewallinformer.ClusterEgressFirewallInformer {
+	return wf.efFactory.K8s().V1().ClusterEgressFirewalls()
+}
+
 func (wf *WatchFactory) IPAMClaimsInformer() ipamclaimsinformer.IPAMClaimInformer {
 	return wf.ipamClaimsFactory.K8s().V1alpha1().IPAMClaims()
 }
@@ -1823,6 +1869,10 @@ func (wf *WatchFactory) NetworkQoSInformer() networkqosinformer.NetworkQoSInform
 	return wf.networkQoSFactory.K8s().V1alpha1().NetworkQoSes()
 }
 
+func (wf *WatchFactory) ObservabilityConfigInformer() observabilityconfiginformer.ObservabilityConfigInformer {
+	return wf.observFactory.K8s().V1().ObservabilityConfigs()
+}
+
 // withServiceNameAndNoHeadlessServiceSelector returns a LabelSelector (added to the
 // watcher for EndpointSlices) that will only choose EndpointSlices with a non-empty
 // "kubernetes.io/service-name" label and without "service.kubernetes.io/headless"
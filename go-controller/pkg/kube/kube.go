@@ -47,6 +47,9 @@ type InterfaceOVN interface {
 	DeleteCloudPrivateIPConfig(name string) error
 	UpdateEgressServiceStatus(namespace, name, host string) error
 	UpdateIPAMClaimIPs(updatedIPAMClaim *ipamclaimsapi.IPAMClaim) error
+	GetIPAMClaim(namespace, name string) (*ipamclaimsapi.IPAMClaim, error)
+	CreateIPAMClaim(ipamClaim *ipamclaimsapi.IPAMClaim) (*ipamclaimsapi.IPAMClaim, error)
+	DeleteIPAMClaim(namespace, name string) error
 }
 
 // Interface represents the exported methods for dealing with getting/setting
@@ -471,6 +474,18 @@ func (k *KubeOVN) UpdateIPAMClaimIPs(updatedIPAMClaim *ipamclaimsapi.IPAMClaim)
 	return err
 }
 
+func (k *KubeOVN) GetIPAMClaim(namespace, name string) (*ipamclaimsapi.IPAMClaim, error) {
+	return k.IPAMClaimsClient.K8sV1alpha1().IPAMClaims(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+}
+
+func (k *KubeOVN) CreateIPAMClaim(ipamClaim *ipamclaimsapi.IPAMClaim) (*ipamclaimsapi.IPAMClaim, error) {
+	return k.IPAMClaimsClient.K8sV1alpha1().IPAMClaims(ipamClaim.Namespace).Create(context.TODO(), ipamClaim, metav1.CreateOptions{})
+}
+
+func (k *KubeOVN) DeleteIPAMClaim(namespace, name string) error {
+	return k.IPAMClaimsClient.K8sV1alpha1().IPAMClaims(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
 // SetAnnotationsOnNAD takes a NAD namespace and name and a map of key/value string pairs to set as annotations
 func (k *KubeOVN) SetAnnotationsOnNAD(namespace, name string, annotations map[string]string, fieldManager string) error {
 	var err error
@@ -53,6 +53,36 @@ func (_m *InterfaceOVN) CreateCloudPrivateIPConfig(cloudPrivateIPConfig *v1.Clou
 	return r0, r1
 }
 
+// CreateIPAMClaim provides a mock function with given fields: ipamClaim
+func (_m *InterfaceOVN) CreateIPAMClaim(ipamClaim *v1alpha1.IPAMClaim) (*v1alpha1.IPAMClaim, error) {
+	ret := _m.Called(ipamClaim)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateIPAMClaim")
+	}
+
+	var r0 *v1alpha1.IPAMClaim
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*v1alpha1.IPAMClaim) (*v1alpha1.IPAMClaim, error)); ok {
+		return rf(ipamClaim)
+	}
+	if rf, ok := ret.Get(0).(func(*v1alpha1.IPAMClaim) *v1alpha1.IPAMClaim); ok {
+		r0 = rf(ipamClaim)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.IPAMClaim)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*v1alpha1.IPAMClaim) error); ok {
+		r1 = rf(ipamClaim)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteCloudPrivateIPConfig provides a mock function with given fields: name
 func (_m *InterfaceOVN) DeleteCloudPrivateIPConfig(name string) error {
 	ret := _m.Called(name)
@@ -71,6 +101,24 @@ func (_m *InterfaceOVN) DeleteCloudPrivateIPConfig(name string) error {
 	return r0
 }
 
+// DeleteIPAMClaim provides a mock function with given fields: namespace, name
+func (_m *InterfaceOVN) DeleteIPAMClaim(namespace string, name string) error {
+	ret := _m.Called(namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteIPAMClaim")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(namespace, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Events provides a mock function with given fields:
 func (_m *InterfaceOVN) Events() corev1.EventInterface {
 	ret := _m.Called()
@@ -211,6 +259,36 @@ func (_m *InterfaceOVN) GetEgressIPs() ([]*egressipv1.EgressIP, error) {
 	return r0, r1
 }
 
+// GetIPAMClaim provides a mock function with given fields: namespace, name
+func (_m *InterfaceOVN) GetIPAMClaim(namespace string, name string) (*v1alpha1.IPAMClaim, error) {
+	ret := _m.Called(namespace, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIPAMClaim")
+	}
+
+	var r0 *v1alpha1.IPAMClaim
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*v1alpha1.IPAMClaim, error)); ok {
+		return rf(namespace, name)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *v1alpha1.IPAMClaim); ok {
+		r0 = rf(namespace, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.IPAMClaim)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(namespace, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNamespaces provides a mock function with given fields: labelSelector
 func (_m *InterfaceOVN) GetNamespaces(labelSelector metav1.LabelSelector) ([]*apicorev1.Namespace, error) {
 	ret := _m.Called(labelSelector)
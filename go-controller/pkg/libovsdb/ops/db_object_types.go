@@ -16,6 +16,7 @@ const (
 	// owner types
 	EgressFirewallDNSOwnerType          ownerType = "EgressFirewallDNS"
 	EgressFirewallOwnerType             ownerType = "EgressFirewall"
+	ClusterEgressFirewallOwnerType      ownerType = "ClusterEgressFirewall"
 	EgressQoSOwnerType                  ownerType = "EgressQoS"
 	AdminNetworkPolicyOwnerType         ownerType = "AdminNetworkPolicy"
 	BaselineAdminNetworkPolicyOwnerType ownerType = "BaselineAdminNetworkPolicy"
@@ -42,6 +43,8 @@ const (
 	ClusterOwnerType ownerType = "Cluster"
 	// UDNIsolationOwnerType means the object is needed to implement UserDefinedNetwork isolation
 	UDNIsolationOwnerType ownerType = "UDNIsolation"
+	// LocalnetVLANTrunkOwnerType means the object is needed to implement a localnet network VLAN trunk
+	LocalnetVLANTrunkOwnerType ownerType = "LocalnetVLANTrunk"
 
 	// owner extra IDs, make sure to define only 1 ExternalIDKey for every string value
 	PriorityKey           ExternalIDKey = "priority"
@@ -262,6 +265,13 @@ var ACLEgressFirewall = newObjectIDsType(acl, EgressFirewallOwnerType, []Externa
 	RuleIndex,
 })
 
+var ACLClusterEgressFirewall = newObjectIDsType(acl, ClusterEgressFirewallOwnerType, []ExternalIDKey{
+	// ClusterEgressFirewall name
+	ObjectNameKey,
+	// the index of the ClusterEgressFirewall.Spec.Egress rule.
+	RuleIndex,
+})
+
 var ACLUDN = newObjectIDsType(acl, UDNIsolationOwnerType, []ExternalIDKey{
 	// name of a UDN-related ACL
 	ObjectNameKey,
@@ -269,6 +279,13 @@ var ACLUDN = newObjectIDsType(acl, UDNIsolationOwnerType, []ExternalIDKey{
 	PolicyDirectionKey,
 })
 
+var ACLLocalnetVLANTrunk = newObjectIDsType(acl, LocalnetVLANTrunkOwnerType, []ExternalIDKey{
+	// name of a VLAN trunk ACL, tagged or untagged
+	ObjectNameKey,
+	// Egress for the traffic sent by the pods, Ingress for the traffic they receive
+	PolicyDirectionKey,
+})
+
 var VirtualMachineDHCPOptions = newObjectIDsType(dhcpOptions, VirtualMachineOwnerType, []ExternalIDKey{
 	// We can have multiple VMs with same CIDR they  may have different
 	// hostname.
@@ -307,6 +324,11 @@ var PortGroupBaselineAdminNetworkPolicy = newObjectIDsType(portGroup, BaselineAd
 	ObjectNameKey,
 })
 
+var PortGroupClusterEgressFirewall = newObjectIDsType(portGroup, ClusterEgressFirewallOwnerType, []ExternalIDKey{
+	// ClusterEgressFirewall name
+	ObjectNameKey,
+})
+
 var PortGroupCluster = newObjectIDsType(portGroup, ClusterOwnerType, []ExternalIDKey{
 	// name of a global port group
 	// currently ClusterPortGroup and ClusterRtrPortGroup are present
@@ -357,6 +379,8 @@ var QoSRuleEgressIP = newObjectIDsType(qos, EgressIPOwnerType, []ExternalIDKey{
 
 var NetworkQoS = newObjectIDsType(qos, NetworkQoSOwnerType, []ExternalIDKey{
 	ObjectNameKey,
+	// egress or ingress
+	PolicyDirectionKey,
 	// rule index
 	RuleIndex,
 })
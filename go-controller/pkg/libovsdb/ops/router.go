@@ -880,6 +880,17 @@ func DeleteBFDs(nbClient libovsdbclient.Client, bfds ...*nbdb.BFD) error {
 	return m.Delete(opModels...)
 }
 
+type bfdPredicate func(*nbdb.BFD) bool
+
+// FindBFDsWithPredicate looks up BFDs from the cache based on a given predicate
+func FindBFDsWithPredicate(nbClient libovsdbclient.Client, p bfdPredicate) ([]*nbdb.BFD, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), config.Default.OVSDBTxnTimeout)
+	defer cancel()
+	found := []*nbdb.BFD{}
+	err := nbClient.WhereCache(p).List(ctx, &found)
+	return found, err
+}
+
 func LookupBFD(nbClient libovsdbclient.Client, bfd *nbdb.BFD) (*nbdb.BFD, error) {
 	found := []*nbdb.BFD{}
 	opModel := operationModel{
@@ -68,6 +68,14 @@ func FindSampleCollectorWithPredicate(nbClient libovsdbclient.Client, p func(*nb
 	return collectors, err
 }
 
+func FindSamplesWithPredicate(nbClient libovsdbclient.Client, p func(*nbdb.Sample) bool) ([]*nbdb.Sample, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), config.Default.OVSDBTxnTimeout)
+	defer cancel()
+	samples := []*nbdb.Sample{}
+	err := nbClient.WhereCache(p).List(ctx, &samples)
+	return samples, err
+}
+
 func ListSampleCollectors(nbClient libovsdbclient.Client) ([]*nbdb.SampleCollector, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), config.Default.OVSDBTxnTimeout)
 	defer cancel()
@@ -138,9 +146,16 @@ const (
 	UDNIsolationSample       SampleFeature = "UDNIsolation"
 )
 
+// NamespaceFilter tells if the objects of the given namespace are sampled.
+type NamespaceFilter func(namespace string) bool
+
 // SamplingConfig is used to configure sampling for different db objects.
 type SamplingConfig struct {
 	featureCollectors map[SampleFeature][]string
+	// namespaceFilters limit some of the collectors of a feature to the objects of the namespaces accepted by
+	// their filter, objects that don't belong to a namespace are not sampled by them.
+	// feature => collector UUID => filter
+	namespaceFilters map[SampleFeature]map[string]NamespaceFilter
 }
 
 func NewSamplingConfig(featureCollectors map[SampleFeature][]string) *SamplingConfig {
@@ -149,6 +164,33 @@ func NewSamplingConfig(featureCollectors map[SampleFeature][]string) *SamplingCo
 	}
 }
 
+func NewSamplingConfigWithNamespaceFilters(featureCollectors map[SampleFeature][]string,
+	namespaceFilters map[SampleFeature]map[string]NamespaceFilter) *SamplingConfig {
+	return &SamplingConfig{
+		featureCollectors: featureCollectors,
+		namespaceFilters:  namespaceFilters,
+	}
+}
+
+// getACLCollectors returns the UUIDs of the collectors that sample the given ACL.
+func (c *SamplingConfig) getACLCollectors(acl *nbdb.ACL) []string {
+	feature := GetACLSampleFeature(acl)
+	collectors := c.featureCollectors[feature]
+	filters := c.namespaceFilters[feature]
+	if len(filters) == 0 {
+		return collectors
+	}
+	namespace := GetACLNamespace(acl)
+	aclCollectors := make([]string, 0, len(collectors))
+	for _, collector := range collectors {
+		if filter, ok := filters[collector]; ok && (namespace == "" || !filter(namespace)) {
+			continue
+		}
+		aclCollectors = append(aclCollectors, collector)
+	}
+	return aclCollectors
+}
+
 func addSample(c *SamplingConfig, opModels []operationModel, model model.Model) []operationModel {
 	switch t := model.(type) {
 	case *nbdb.ACL:
@@ -164,7 +206,7 @@ func createOrUpdateSampleForACL(opModels []operationModel, c *SamplingConfig, ac
 		acl.SampleNew = nil
 		return opModels
 	}
-	collectors := c.featureCollectors[getACLSampleFeature(acl)]
+	collectors := c.getACLCollectors(acl)
 	if len(collectors) == 0 {
 		acl.SampleEst = nil
 		acl.SampleNew = nil
@@ -206,7 +248,8 @@ func GetACLSampleID(acl *nbdb.ACL) uint32 {
 	return h.Sum32()
 }
 
-func getACLSampleFeature(acl *nbdb.ACL) SampleFeature {
+// GetACLSampleFeature returns the feature that the ACL belongs to, or an empty string if the ACL is not sampled.
+func GetACLSampleFeature(acl *nbdb.ACL) SampleFeature {
 	switch acl.ExternalIDs[OwnerTypeKey.String()] {
 	case AdminNetworkPolicyOwnerType, BaselineAdminNetworkPolicyOwnerType:
 		return AdminNetworkPolicySample
@@ -221,3 +264,20 @@ func getACLSampleFeature(acl *nbdb.ACL) SampleFeature {
 	}
 	return ""
 }
+
+// GetACLNamespace returns the namespace of the object that the ACL was created for, or an empty string
+// if the ACL doesn't belong to a namespace.
+func GetACLNamespace(acl *nbdb.ACL) string {
+	objectName := acl.ExternalIDs[ObjectNameKey.String()]
+	switch acl.ExternalIDs[OwnerTypeKey.String()] {
+	case NetworkPolicyOwnerType:
+		namespace, _, err := ParseNamespaceNameKey(objectName)
+		if err != nil {
+			return ""
+		}
+		return namespace
+	case NetpolNamespaceOwnerType, MulticastNamespaceOwnerType, EgressFirewallOwnerType:
+		return objectName
+	}
+	return ""
+}
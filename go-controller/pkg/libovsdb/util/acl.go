@@ -78,6 +78,8 @@ func GetACLName(dbIDs *libovsdbops.DbObjectIDs) string {
 		aclName = "NP:" + dbIDs.GetObjectID(libovsdbops.ObjectNameKey) + ":" + dbIDs.GetObjectID(libovsdbops.PolicyDirectionKey)
 	case t.IsSameType(libovsdbops.ACLEgressFirewall):
 		aclName = "EF:" + dbIDs.GetObjectID(libovsdbops.ObjectNameKey) + ":" + dbIDs.GetObjectID(libovsdbops.RuleIndex)
+	case t.IsSameType(libovsdbops.ACLClusterEgressFirewall):
+		aclName = "CEF:" + dbIDs.GetObjectID(libovsdbops.ObjectNameKey) + ":" + dbIDs.GetObjectID(libovsdbops.RuleIndex)
 	case t.IsSameType(libovsdbops.ACLAdminNetworkPolicy):
 		aclName = "ANP:" + dbIDs.GetObjectID(libovsdbops.ObjectNameKey) + ":" + dbIDs.GetObjectID(libovsdbops.PolicyDirectionKey) +
 			":" + dbIDs.GetObjectID(libovsdbops.GressIdxKey)
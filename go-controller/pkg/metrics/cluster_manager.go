@@ -66,6 +66,50 @@ var metricV6AllocatedHostSubnetCount = prometheus.NewGaugeVec(prometheus.GaugeOp
 	},
 )
 
+var metricClusterSubnetHostSubnetCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: MetricOvnkubeNamespace,
+	Subsystem: MetricOvnkubeSubsystemClusterManager,
+	Name:      "num_cluster_subnet_host_subnets",
+	Help:      "The total number of host subnets possible per network cluster subnet"},
+	[]string{
+		"network_name",
+		"subnet",
+	},
+)
+
+var metricClusterSubnetAllocatedHostSubnetCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: MetricOvnkubeNamespace,
+	Subsystem: MetricOvnkubeSubsystemClusterManager,
+	Name:      "allocated_cluster_subnet_host_subnets",
+	Help:      "The total number of host subnets currently allocated per network cluster subnet"},
+	[]string{
+		"network_name",
+		"subnet",
+	},
+)
+
+var metricPodIPCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: MetricOvnkubeNamespace,
+	Subsystem: MetricOvnkubeSubsystemClusterManager,
+	Name:      "num_pod_ips",
+	Help:      "The total number of pod IPs possible per network subnet, for the networks whose pod IPs are allocated by the cluster manager"},
+	[]string{
+		"network_name",
+		"subnet",
+	},
+)
+
+var metricAllocatedPodIPCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: MetricOvnkubeNamespace,
+	Subsystem: MetricOvnkubeSubsystemClusterManager,
+	Name:      "allocated_pod_ips",
+	Help:      "The total number of pod IPs currently allocated per network subnet, for the networks whose pod IPs are allocated by the cluster manager"},
+	[]string{
+		"network_name",
+		"subnet",
+	},
+)
+
 /** EgressIP metrics recorded from cluster-manager begins**/
 var metricEgressIPCount = prometheus.NewGauge(prometheus.GaugeOpts{
 	Namespace: MetricOvnkubeNamespace,
@@ -124,6 +168,10 @@ func RegisterClusterManagerFunctional() {
 	prometheus.MustRegister(metricV6HostSubnetCount)
 	prometheus.MustRegister(metricV4AllocatedHostSubnetCount)
 	prometheus.MustRegister(metricV6AllocatedHostSubnetCount)
+	prometheus.MustRegister(metricClusterSubnetHostSubnetCount)
+	prometheus.MustRegister(metricClusterSubnetAllocatedHostSubnetCount)
+	prometheus.MustRegister(metricPodIPCount)
+	prometheus.MustRegister(metricAllocatedPodIPCount)
 	if config.OVNKubernetesFeature.EnableEgressIP {
 		prometheus.MustRegister(metricEgressIPNodeUnreacheableCount)
 		prometheus.MustRegister(metricEgressIPRebalanceCount)
@@ -149,6 +197,30 @@ func RecordSubnetCount(v4SubnetCount, v6SubnetCount float64, networkName string)
 	metricV6HostSubnetCount.WithLabelValues(networkName).Set(v6SubnetCount)
 }
 
+// RecordClusterSubnetHostSubnetUsage records the number of possible and
+// allocated host subnets of a network cluster subnet
+func RecordClusterSubnetHostSubnetUsage(count, used float64, networkName, subnet string) {
+	metricClusterSubnetHostSubnetCount.WithLabelValues(networkName, subnet).Set(count)
+	metricClusterSubnetAllocatedHostSubnetCount.WithLabelValues(networkName, subnet).Set(used)
+}
+
+// RecordPodIPUsage records the number of possible and allocated pod IPs of a
+// network subnet
+func RecordPodIPUsage(count, used float64, networkName, subnet string) {
+	metricPodIPCount.WithLabelValues(networkName, subnet).Set(count)
+	metricAllocatedPodIPCount.WithLabelValues(networkName, subnet).Set(used)
+}
+
+// DeleteNetworkCapacityMetrics removes the per subnet usage metrics of a
+// network that no longer exists
+func DeleteNetworkCapacityMetrics(networkName string) {
+	labels := prometheus.Labels{"network_name": networkName}
+	metricClusterSubnetHostSubnetCount.DeletePartialMatch(labels)
+	metricClusterSubnetAllocatedHostSubnetCount.DeletePartialMatch(labels)
+	metricPodIPCount.DeletePartialMatch(labels)
+	metricAllocatedPodIPCount.DeletePartialMatch(labels)
+}
+
 // RecordEgressIPReachableNode records how many times EgressIP detected an unuseable node.
 func RecordEgressIPUnreachableNode() {
 	metricEgressIPNodeUnreacheableCount.Inc()
@@ -312,7 +312,15 @@ func (c *nadController) syncNAD(key string, nad *nettypes.NetworkAttachmentDefin
 		ensureNetwork = util.NewMutableNetInfo(nadNetwork)
 	case util.AreNetworksCompatible(currentNetwork, nadNetwork):
 		// the NAD refers to an existing compatible network, ensure that
-		// existing network holds a reference to this NAD
+		// existing network holds a reference to this NAD, the subnets that
+		// might have been appended to it and its current bandwidth
+		ensureNetwork = currentNetwork
+		ensureNetwork.SetSubnets(nadNetwork.Subnets())
+		ensureNetwork.SetBandwidth(nadNetwork.Bandwidth())
+	case util.AreNetworksCompatible(nadNetwork, currentNetwork):
+		// the NAD refers to an existing network that had subnets appended
+		// to it and the NAD will eventually be updated with them, ensure
+		// that existing network holds a reference to this NAD
 		ensureNetwork = currentNetwork
 	case sets.New(key).HasAll(currentNetwork.GetNADs()...):
 		// the NAD is the only NAD referring to an existing incompatible
@@ -171,6 +171,38 @@ func TestNADController(t *testing.T) {
 		MTU: 1400,
 	}
 
+	networkCSecondary := &ovncnitypes.NetConf{
+		Topology: types.Layer3Topology,
+		NetConf: cnitypes.NetConf{
+			Name: "networkCSecondary",
+			Type: "ovn-k8s-cni-overlay",
+		},
+		Subnets: "10.1.0.0/16/24",
+		Role:    types.NetworkRoleSecondary,
+		MTU:     1400,
+	}
+	networkCAppended := &ovncnitypes.NetConf{
+		Topology: types.Layer3Topology,
+		NetConf: cnitypes.NetConf{
+			Name: "networkCSecondary",
+			Type: "ovn-k8s-cni-overlay",
+		},
+		Subnets: "10.1.0.0/16/24,10.2.0.0/16/24",
+		Role:    types.NetworkRoleSecondary,
+		MTU:     1400,
+	}
+	networkCLimited := &ovncnitypes.NetConf{
+		Topology: types.Layer3Topology,
+		NetConf: cnitypes.NetConf{
+			Name: "networkCSecondary",
+			Type: "ovn-k8s-cni-overlay",
+		},
+		Subnets:   "10.1.0.0/16/24",
+		Role:      types.NetworkRoleSecondary,
+		MTU:       1400,
+		Bandwidth: &ovncnitypes.BandwidthConf{IngressRate: 100000000, EgressRate: 100000000},
+	}
+
 	networkDefault := &ovncnitypes.NetConf{
 		NetConf: cnitypes.NetConf{
 			Name: types.DefaultNetworkName,
@@ -469,6 +501,71 @@ func TestNADController(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "NAD added then updated with an appended subnet",
+			args: []args{
+				{
+					nad:     "test/nad_1",
+					network: networkCSecondary,
+				},
+				{
+					nad:     "test/nad_1",
+					network: networkCAppended,
+				},
+			},
+			expected: []expected{
+				{
+					network: networkCAppended,
+					nads:    []string{"test/nad_1"},
+				},
+			},
+		},
+		{
+			name: "two NADs added then one updated with an appended subnet",
+			args: []args{
+				{
+					nad:     "test/nad_1",
+					network: networkCSecondary,
+				},
+				{
+					nad:     "test/nad_2",
+					network: networkCSecondary,
+				},
+				{
+					nad:     "test/nad_1",
+					network: networkCAppended,
+				},
+				{
+					nad:     "test/nad_2",
+					network: networkCSecondary,
+				},
+			},
+			expected: []expected{
+				{
+					network: networkCAppended,
+					nads:    []string{"test/nad_1", "test/nad_2"},
+				},
+			},
+		},
+		{
+			name: "NAD added then updated with a bandwidth",
+			args: []args{
+				{
+					nad:     "test/nad_1",
+					network: networkCSecondary,
+				},
+				{
+					nad:     "test/nad_1",
+					network: networkCLimited,
+				},
+			},
+			expected: []expected{
+				{
+					network: networkCLimited,
+					nads:    []string{"test/nad_1"},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -545,6 +642,10 @@ func TestNADController(t *testing.T) {
 							fmt.Sprintf("matching network config for network %s", name))
 						g.Expect(netController.networks[name].GetNADs()).To(gomega.ConsistOf(expected.nads),
 							fmt.Sprintf("matching NADs for network %s", name))
+						g.Expect(netController.networks[name].Subnets()).To(gomega.ConsistOf(netInfo.Subnets()),
+							fmt.Sprintf("matching subnets for network %s", name))
+						g.Expect(netController.networks[name].Bandwidth()).To(gomega.Equal(netInfo.Bandwidth()),
+							fmt.Sprintf("matching bandwidth for network %s", name))
 						id, err := nadController.networkIDAllocator.AllocateID(name)
 						g.Expect(err).ToNot(gomega.HaveOccurred())
 						g.Expect(netController.networks[name].GetNetworkID()).To(gomega.Equal(id))
@@ -556,6 +657,10 @@ func TestNADController(t *testing.T) {
 								fmt.Sprintf("matching network config for network %s", name))
 							g.Expect(tcm.controllers[testNetworkKey].GetNADs()).To(gomega.ConsistOf(expected.nads),
 								fmt.Sprintf("matching NADs for network %s", name))
+							g.Expect(tcm.controllers[testNetworkKey].Subnets()).To(gomega.ConsistOf(netInfo.Subnets()),
+								fmt.Sprintf("matching subnets for network %s", name))
+							g.Expect(tcm.controllers[testNetworkKey].Bandwidth()).To(gomega.Equal(netInfo.Bandwidth()),
+								fmt.Sprintf("matching bandwidth for network %s", name))
 							g.Expect(tcm.controllers[testNetworkKey].GetNetworkID()).To(gomega.Equal(id))
 							expectRunning = append(expectRunning, testNetworkKey)
 						}
@@ -131,6 +131,8 @@ type DefaultNodeNetworkController struct {
 
 	udnHostIsolationManager *UDNHostIsolationManager
 
+	podRoutesManager *podRoutesManager
+
 	nodeAddress net.IP
 	sbZone      string
 
@@ -154,6 +156,9 @@ func newDefaultNodeNetworkController(cnnci *CommonNodeNetworkControllerInfo, sto
 		c.udnHostIsolationManager = NewUDNHostIsolationManager(config.IPv4Mode, config.IPv6Mode,
 			cnnci.watchFactory.PodCoreInformer(), cnnci.name, cnnci.recorder)
 	}
+	if config.OvnKubeNode.Mode == types.NodeModeFull {
+		c.podRoutesManager = newPodRoutesManager(cnnci.watchFactory.PodCoreInformer())
+	}
 	c.linkManager = linkmanager.NewController(cnnci.name, config.IPv4Mode, config.IPv6Mode, c.updateGatewayMAC)
 	return c
 }
@@ -891,6 +896,11 @@ func (nc *DefaultNodeNetworkController) Init(ctx context.Context) error {
 				return fmt.Errorf("failed cleaning up UDN host isolation: %w", err)
 			}
 		}
+		if nc.podRoutesManager != nil {
+			if err = nc.podRoutesManager.Start(); err != nil {
+				return err
+			}
+		}
 	}
 
 	// First wait for the node logical switch to be created by the Master, timeout is 300s.
@@ -937,6 +947,12 @@ func (nc *DefaultNodeNetworkController) Init(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if nc.cniServer != nil {
+		mgmtPort := nc.mgmtPortController
+		nc.cniServer.AddStatusCheck(func() error {
+			return checkManagementPortStatus(mgmtPort)
+		})
+	}
 	nc.nodeAddress = nodeAddr
 
 	if err := util.SetNodeZone(nodeAnnotator, sbZone); err != nil {
@@ -1336,6 +1352,9 @@ func (nc *DefaultNodeNetworkController) Start(ctx context.Context) error {
 // Stop gracefully stops the controller
 // deleteLogicalEntities will never be true for default network
 func (nc *DefaultNodeNetworkController) Stop() {
+	if nc.podRoutesManager != nil {
+		nc.podRoutesManager.Stop()
+	}
 	close(nc.stopChan)
 	nc.wg.Wait()
 }
@@ -1726,3 +1745,17 @@ func configureGlobalForwarding() error {
 	}
 	return nil
 }
+
+// checkManagementPortStatus returns an error if the management port interface
+// is missing or down, as pods would not be able to reach the host network
+func checkManagementPortStatus(mgmtPort managementport.Interface) error {
+	name := mgmtPort.GetInterfaceName()
+	link, err := util.GetNetLinkOps().LinkByName(name)
+	if err != nil {
+		return fmt.Errorf("failed to get management port %s: %w", name, err)
+	}
+	if link.Attrs().Flags&net.FlagUp == 0 {
+		return fmt.Errorf("management port %s is down", name)
+	}
+	return nil
+}
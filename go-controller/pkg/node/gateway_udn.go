@@ -138,6 +138,18 @@ func (b *bridgeConfiguration) addNetworkBridgeConfig(
 	return nil
 }
 
+// updateNetworkBridgeConfigSubnets updates the cluster subnets of the provided
+// netInfo in the bridge configuration cache, as subnets can be appended to a
+// running layer3 network
+func (b *bridgeConfiguration) updateNetworkBridgeConfigSubnets(nInfo util.NetInfo) {
+	b.Lock()
+	defer b.Unlock()
+
+	if netConfig, found := b.netConfig[nInfo.GetNetworkName()]; found {
+		netConfig.subnets = nInfo.Subnets()
+	}
+}
+
 // delNetworkBridgeConfig deletes the provided netInfo from the bridge configuration cache
 func (b *bridgeConfiguration) delNetworkBridgeConfig(nInfo util.NetInfo) {
 	b.Lock()
@@ -653,7 +665,7 @@ func (udng *UserDefinedNetworkGateway) computeRoutesForUDN(mpLink netlink.Link)
 
 	// Add routes for V[4|6]HostETPLocalMasqueradeIP:
 	//   169.254.0.3 via 100.100.1.1 dev ovn-k8s-mp1
-	// For Layer3 networks add the cluster subnet route
+	// For Layer3 networks add the cluster subnet routes
 	//   100.100.0.0/16 via 100.100.1.1 dev ovn-k8s-mp1
 	networkLocalSubnets, err := udng.getLocalSubnets()
 	if err != nil {
@@ -677,18 +689,7 @@ func (udng *UserDefinedNetworkGateway) computeRoutesForUDN(mpLink netlink.Link)
 			Gw:    gwIP.IP,
 			Table: udng.vrfTableId,
 		})
-		if udng.NetInfo.TopologyType() == types.Layer3Topology {
-			for _, clusterSubnet := range udng.Subnets() {
-				if clusterSubnet.CIDR.Contains(gwIP.IP) {
-					retVal = append(retVal, netlink.Route{
-						LinkIndex: mpLink.Attrs().Index,
-						Dst:       clusterSubnet.CIDR,
-						Gw:        gwIP.IP,
-						Table:     udng.vrfTableId,
-					})
-				}
-			}
-		}
+		retVal = append(retVal, udng.computeClusterSubnetRoutesForUDN(mpLink, gwIP.IP)...)
 	}
 	// Add unreachable route to enure that kernel always finds a match to the VRF table rather than
 	// referring to default VRF table and send traffic via unwanted interfaces and to unwanted gateway.
@@ -920,6 +921,11 @@ func (udng *UserDefinedNetworkGateway) doReconcile() error {
 	// update bridge configuration
 	isNetworkAdvertised := util.IsPodNetworkAdvertisedAtNode(udng.NetInfo, udng.node.Name)
 	udng.openflowManager.defaultBridge.netConfig[udng.GetNetworkName()].advertised.Store(isNetworkAdvertised)
+	udng.openflowManager.updateNetworkSubnets(udng.NetInfo)
+
+	if err := udng.updateUDNVRFClusterSubnetRoutes(); err != nil {
+		return fmt.Errorf("error while updating cluster subnet routes for UDN %s: %w", udng.GetNetworkName(), err)
+	}
 
 	if err := udng.updateUDNVRFIPRules(isNetworkAdvertised); err != nil {
 		return fmt.Errorf("error while updating ip rule for UDN %s: %s", udng.GetNetworkName(), err)
@@ -945,6 +951,57 @@ func (udng *UserDefinedNetworkGateway) doReconcile() error {
 	return nil
 }
 
+// computeClusterSubnetRoutesForUDN returns the routes towards the cluster
+// subnets of a layer3 network through the management port. All the cluster
+// subnets of the same IP family as the gateway IP are routed, as subnets can be
+// appended to the network after the node subnet was allocated.
+//
+//	100.100.0.0/16 via 100.100.1.1 dev ovn-k8s-mp1
+//	100.101.0.0/16 via 100.100.1.1 dev ovn-k8s-mp1
+func (udng *UserDefinedNetworkGateway) computeClusterSubnetRoutesForUDN(mpLink netlink.Link, gwIP net.IP) []netlink.Route {
+	if udng.NetInfo.TopologyType() != types.Layer3Topology {
+		return nil
+	}
+	var routes []netlink.Route
+	for _, clusterSubnet := range udng.Subnets() {
+		if utilnet.IsIPv6CIDR(clusterSubnet.CIDR) != utilnet.IsIPv6(gwIP) {
+			continue
+		}
+		routes = append(routes, netlink.Route{
+			LinkIndex: mpLink.Attrs().Index,
+			Dst:       clusterSubnet.CIDR,
+			Gw:        gwIP,
+			Table:     udng.vrfTableId,
+		})
+	}
+	return routes
+}
+
+// updateUDNVRFClusterSubnetRoutes adds the routes towards cluster subnets
+// appended to the network since the VRF was created
+func (udng *UserDefinedNetworkGateway) updateUDNVRFClusterSubnetRoutes() error {
+	if udng.NetInfo.TopologyType() != types.Layer3Topology {
+		return nil
+	}
+	mpLink, err := util.GetNetLinkOps().LinkByName(util.GetNetworkScopedK8sMgmtHostIntfName(uint(udng.GetNetworkID())))
+	if err != nil {
+		return fmt.Errorf("unable to get management port link for network %s: %w", udng.GetNetworkName(), err)
+	}
+	networkLocalSubnets, err := udng.getLocalSubnets()
+	if err != nil {
+		return err
+	}
+	var routes []netlink.Route
+	for _, localSubnet := range networkLocalSubnets {
+		gwIP := util.GetNodeGatewayIfAddr(localSubnet)
+		if gwIP == nil {
+			return fmt.Errorf("unable to find gateway IP for network %s, subnet: %s", udng.GetNetworkName(), localSubnet)
+		}
+		routes = append(routes, udng.computeClusterSubnetRoutesForUDN(mpLink, gwIP.IP)...)
+	}
+	return udng.vrfManager.AddVRFRoutes(util.GetNetworkVRFName(udng.NetInfo), routes)
+}
+
 // updateUDNVRFIPRules updates IP rules for a network depending on whether the
 // network is advertised or not
 func (udng *UserDefinedNetworkGateway) updateUDNVRFIPRules(isNetworkAdvertised bool) error {
@@ -3,11 +3,13 @@ package node
 import (
 	"fmt"
 	"net"
+	"os"
 	"reflect"
 
 	corev1 "k8s.io/api/core/v1"
 	discovery "k8s.io/api/discovery/v1"
 	cache "k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
@@ -121,7 +123,8 @@ func (h *nodeEventHandler) AreResourcesEqual(obj1, obj2 interface{}) (bool, erro
 		if !ok {
 			return false, fmt.Errorf("could not cast obj2 of type %T to *kapi.Node", obj2)
 		}
-		return reflect.DeepEqual(node1.Status.Addresses, node2.Status.Addresses), nil
+		return reflect.DeepEqual(node1.Status.Addresses, node2.Status.Addresses) &&
+			!util.NodeSubnetAnnotationChanged(node1, node2), nil
 
 	default:
 		return false, fmt.Errorf("no object comparison for type %s", h.objType)
@@ -218,6 +221,13 @@ func (h *nodeEventHandler) UpdateResource(oldObj, newObj interface{}, _ bool) er
 
 		// if it's our node that is changing, then nothing to do as we dont add our own IP to the nftables rules
 		if newNode.Name == h.nc.name {
+			// the host subnet is only configured on startup, restart to
+			// configure the one the node was reallocated
+			if util.NodeSubnetAnnotationChanged(oldNode, newNode) &&
+				util.NodeSubnetAnnotationChangedForNetwork(oldNode, newNode, types.DefaultNetworkName) {
+				klog.Errorf("Fatal error: host subnet of node %s changed, restarting to configure it", newNode.Name)
+				os.Exit(1)
+			}
 			return nil
 		}
 
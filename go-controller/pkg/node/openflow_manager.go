@@ -51,6 +51,13 @@ func (c *openflowManager) addNetwork(nInfo util.NetInfo, nodeSubnets []*net.IPNe
 	return nil
 }
 
+func (c *openflowManager) updateNetworkSubnets(nInfo util.NetInfo) {
+	c.defaultBridge.updateNetworkBridgeConfigSubnets(nInfo)
+	if c.externalGatewayBridge != nil {
+		c.externalGatewayBridge.updateNetworkBridgeConfigSubnets(nInfo)
+	}
+}
+
 func (c *openflowManager) delNetwork(nInfo util.NetInfo) {
 	c.defaultBridge.delNetworkBridgeConfig(nInfo)
 	if c.externalGatewayBridge != nil {
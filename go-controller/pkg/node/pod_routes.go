@@ -0,0 +1,223 @@
+//go:build linux
+
+package node
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/vishvananda/netlink"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// podRoutesManager configures on the running pods of the node the routes
+// added to their pod annotations after they were created, like the routes
+// towards the subnets appended to the networks they are attached to. Routes are
+// only added, never removed.
+type podRoutesManager struct {
+	podLister     corelisters.PodLister
+	podController controller.Controller
+}
+
+func newPodRoutesManager(podInformer coreinformers.PodInformer) *podRoutesManager {
+	m := &podRoutesManager{
+		podLister: podInformer.Lister(),
+	}
+	controllerConfig := &controller.ControllerConfig[corev1.Pod]{
+		RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+		Informer:       podInformer.Informer(),
+		Lister:         podInformer.Lister().List,
+		ObjNeedsUpdate: podRoutesNeedUpdate,
+		Reconcile:      m.reconcilePod,
+		Threadiness:    1,
+	}
+	m.podController = controller.NewController[corev1.Pod]("pod-routes-manager", controllerConfig)
+	return m
+}
+
+func (m *podRoutesManager) Start() error {
+	klog.Infof("Starting pod routes manager")
+	return controller.Start(m.podController)
+}
+
+func (m *podRoutesManager) Stop() {
+	controller.Stop(m.podController)
+}
+
+// podRoutesNeedUpdate handles the existing pods on startup, as their
+// annotation might have been updated while the node was down, and then the pods
+// which annotation changes.
+func podRoutesNeedUpdate(oldObj, newObj *corev1.Pod) bool {
+	if newObj == nil || util.PodWantsHostNetwork(newObj) || util.PodCompleted(newObj) {
+		return false
+	}
+	if oldObj == nil {
+		return newObj.Annotations[util.OvnPodAnnotationName] != ""
+	}
+	return oldObj.Annotations[util.OvnPodAnnotationName] != newObj.Annotations[util.OvnPodAnnotationName]
+}
+
+func (m *podRoutesManager) reconcilePod(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	pod, err := m.podLister.Pods(namespace).Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if util.PodWantsHostNetwork(pod) || util.PodCompleted(pod) {
+		return nil
+	}
+	podNetworks, err := util.UnmarshalPodAnnotationAllNetworks(pod.Annotations)
+	if err != nil {
+		// nothing to do until the annotation is fixed
+		klog.Warningf("Failed to sync routes of pod %s: %v", key, err)
+		return nil
+	}
+	var errs []error
+	for nadName := range podNetworks {
+		podAnnotation, err := util.UnmarshalPodAnnotation(pod.Annotations, nadName)
+		if err != nil {
+			klog.Warningf("Failed to sync routes of pod %s on NAD %s: %v", key, nadName, err)
+			continue
+		}
+		if len(podAnnotation.Routes) == 0 {
+			continue
+		}
+		ifaceID := util.GetIfaceId(pod.Namespace, pod.Name)
+		if nadName != types.DefaultNetworkName {
+			ifaceID = util.GetSecondaryNetworkIfaceId(pod.Namespace, pod.Name, nadName)
+		}
+		if err := syncPodRoutes(ifaceID, string(pod.UID), podAnnotation); err != nil {
+			errs = append(errs, fmt.Errorf("failed to sync routes of pod %s on NAD %s: %w", key, nadName, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// getPodNetnsPath returns the network namespace recorded on the OVS interface
+// of the pod. It is empty if the interface is not configured yet or was
+// configured without recording it.
+func getPodNetnsPath(ifaceID, podUID string) (string, error) {
+	stdout, stderr, err := util.RunOVSVsctl("--no-heading", "--format=csv", "--data=bare",
+		"--columns=external_ids", "find", "Interface",
+		"external_ids:iface-id="+ifaceID, "external_ids:iface-id-ver="+podUID)
+	if err != nil {
+		return "", fmt.Errorf("failed to find OVS interface %s, stderr %q: %w", ifaceID, stderr, err)
+	}
+	for _, externalIDs := range strings.Split(stdout, "\n") {
+		if netnsPath := util.GetExternalIDValByKey(externalIDs, types.NetnsExternalID); netnsPath != "" {
+			return netnsPath, nil
+		}
+	}
+	return "", nil
+}
+
+// syncPodRoutes adds the routes of the pod annotation that are missing in the
+// network namespace of the pod
+func syncPodRoutes(ifaceID, podUID string, podAnnotation *util.PodAnnotation) error {
+	netnsPath, err := getPodNetnsPath(ifaceID, podUID)
+	if err != nil {
+		return err
+	}
+	if netnsPath == "" {
+		return nil
+	}
+	netns, err := ns.GetNS(netnsPath)
+	if err != nil {
+		// the sandbox is gone or is being torn down
+		klog.V(5).Infof("Skipping routes of OVS interface %s: %v", ifaceID, err)
+		return nil
+	}
+	defer netns.Close()
+	return netns.Do(func(_ ns.NetNS) error {
+		link, err := getPodLinkWithIPs(podAnnotation.IPs)
+		if err != nil || link == nil {
+			return err
+		}
+		for _, route := range podAnnotation.Routes {
+			if err := ensurePodRoute(link, route); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// getPodLinkWithIPs returns the link of the current network namespace that
+// holds the provided IPs, if any
+func getPodLinkWithIPs(ips []*net.IPNet) (netlink.Link, error) {
+	if len(ips) == 0 {
+		return nil, nil
+	}
+	links, err := util.GetNetLinkOps().LinkList()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list links: %w", err)
+	}
+	for _, link := range links {
+		addrs, err := util.GetNetLinkOps().AddrList(link, netlink.FAMILY_ALL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list addresses of link %s: %w", link.Attrs().Name, err)
+		}
+		for _, addr := range addrs {
+			if addr.IP.Equal(ips[0].IP) {
+				return link, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// ensurePodRoute adds the route through the provided link if there is no route
+// to its destination yet. The route takes the MTU of the other routes of the
+// link through the same next hop.
+func ensurePodRoute(link netlink.Link, route util.PodRoute) error {
+	family := netlink.FAMILY_V4
+	if utilnet.IsIPv6CIDR(route.Dest) {
+		family = netlink.FAMILY_V6
+	}
+	routes, err := util.GetNetLinkOps().RouteList(link, family)
+	if err != nil {
+		return fmt.Errorf("failed to list routes of link %s: %w", link.Attrs().Name, err)
+	}
+	var mtu int
+	for _, existing := range routes {
+		if util.IsIPNetEqual(existing.Dst, route.Dest) {
+			return nil
+		}
+		if existing.Gw.Equal(route.NextHop) {
+			mtu = existing.MTU
+		}
+	}
+	err = util.GetNetLinkOps().RouteAdd(&netlink.Route{
+		LinkIndex: link.Attrs().Index,
+		Scope:     netlink.SCOPE_UNIVERSE,
+		Dst:       route.Dest,
+		Gw:        route.NextHop,
+		MTU:       mtu,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add route %s via %s to link %s: %w", route.Dest, route.NextHop, link.Attrs().Name, err)
+	}
+	klog.Infof("Added route %s via %s to pod link %s", route.Dest, route.NextHop, link.Attrs().Name)
+	return nil
+}
@@ -0,0 +1,68 @@
+//go:build linux
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vishvananda/netlink"
+
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	util_mocks "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/mocks"
+)
+
+func TestEnsurePodRoute(t *testing.T) {
+	link := &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "eth0", Index: 3}}
+	route := util.PodRoute{
+		Dest:    ovntest.MustParseIPNet("10.130.0.0/16"),
+		NextHop: ovntest.MustParseIP("10.128.1.1"),
+	}
+
+	tests := []struct {
+		desc      string
+		routes    []netlink.Route
+		wantRoute *netlink.Route
+	}{
+		{
+			desc: "existing route is kept",
+			routes: []netlink.Route{
+				{LinkIndex: 3, Dst: ovntest.MustParseIPNet("10.130.0.0/16"), Gw: ovntest.MustParseIP("10.128.1.1")},
+			},
+		},
+		{
+			desc: "missing route is added with the MTU of the routes through the same next hop",
+			routes: []netlink.Route{
+				{LinkIndex: 3, Dst: ovntest.MustParseIPNet("10.128.0.0/16"), Gw: ovntest.MustParseIP("10.128.1.1"), MTU: 1400},
+				{LinkIndex: 3, Dst: ovntest.MustParseIPNet("10.128.1.0/24")},
+			},
+			wantRoute: &netlink.Route{
+				LinkIndex: 3,
+				Scope:     netlink.SCOPE_UNIVERSE,
+				Dst:       ovntest.MustParseIPNet("10.130.0.0/16"),
+				Gw:        ovntest.MustParseIP("10.128.1.1"),
+				MTU:       1400,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			mockNetLinkOps := new(util_mocks.NetLinkOps)
+			util.SetNetLinkOpMockInst(mockNetLinkOps)
+			defer util.ResetNetLinkOpMockInst()
+
+			mockNetLinkOps.On("RouteList", link, netlink.FAMILY_V4).Return(tc.routes, nil)
+			if tc.wantRoute != nil {
+				mockNetLinkOps.On("RouteAdd", tc.wantRoute).Return(nil)
+			}
+
+			require.NoError(t, ensurePodRoute(link, route))
+			mockNetLinkOps.AssertExpectations(t)
+			if tc.wantRoute == nil {
+				mockNetLinkOps.AssertNotCalled(t, "RouteAdd", mock.Anything)
+			}
+		})
+	}
+}
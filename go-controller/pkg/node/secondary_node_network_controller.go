@@ -103,7 +103,9 @@ func (nc *SecondaryNodeNetworkController) Cleanup() error {
 func (nc *SecondaryNodeNetworkController) shouldReconcileNetworkChange(old, new util.NetInfo) bool {
 	wasUDNNetworkAdvertisedAtNode := util.IsPodNetworkAdvertisedAtNode(old, nc.name)
 	isUDNNetworkAdvertisedAtNode := util.IsPodNetworkAdvertisedAtNode(new, nc.name)
-	return wasUDNNetworkAdvertisedAtNode != isUDNNetworkAdvertisedAtNode
+	// subnets can only be appended to a running network
+	subnetsChanged := len(old.Subnets()) != len(new.Subnets())
+	return wasUDNNetworkAdvertisedAtNode != isUDNNetworkAdvertisedAtNode || subnetsChanged
 }
 
 // Reconcile function reconciles three entities based on whether UDN network is advertised
@@ -5,14 +5,26 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
 	"github.com/ovn-org/libovsdb/ovsdb"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	observabilityapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1"
+	observabilityinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1/apis/informers/externalversions/observabilityconfig/v1"
+	observabilitylister "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1/apis/listers/observabilityconfig/v1"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 )
@@ -24,9 +36,12 @@ const (
 	ACLEstTrafficSamplingID
 )
 
-// temporary const, until we have dynamic config
+// DefaultObservabilityCollectorSetID is the collector set used when no ObservabilityConfig exists.
 const DefaultObservabilityCollectorSetID = 42
 
+// aclSyncBatchSize is the maximum number of ACLs updated per transaction when the sampling config changes.
+const aclSyncBatchSize = 500
+
 // this is inferred from nbdb schema, check Sample_Collector.id
 const maxCollectorID = 255
 const collectorFeaturesExternalID = "sample-features"
@@ -38,11 +53,15 @@ type collectorConfig struct {
 	collectorSetID int
 	// probability in percent, 0 to 100
 	featuresProbability map[libovsdbops.SampleFeature]int
+	// featuresNamespaceSelector limits the sampling of some features to the objects of the selected namespaces.
+	featuresNamespaceSelector map[libovsdbops.SampleFeature]labels.Selector
 }
 
 type Manager struct {
-	nbClient       libovsdbclient.Client
-	sampConfig     *libovsdbops.SamplingConfig
+	nbClient libovsdbclient.Client
+	// sampConfig is read by the network controllers every time they create or update a sampled db object,
+	// and replaced on every reconfiguration.
+	sampConfig     atomic.Pointer[libovsdbops.SamplingConfig]
 	collectorsLock sync.Mutex
 	// nbdb Collectors have probability. To allow different probabilities for different features,
 	// multiple nbdb Collectors will be created, one per probability.
@@ -57,6 +76,18 @@ type Manager struct {
 	// Only maxCollectorID collectors are allowed, each should have unique ID.
 	// this set is tracking already assigned IDs.
 	takenCollectorIDs sets.Set[int]
+	// cleanupTimer is the pending retry of the stale collectors cleanup, if any.
+	cleanupTimer *time.Timer
+
+	// configLock serializes the reconfigurations and the updates of the samples of existing db objects.
+	configLock sync.Mutex
+	// appliedSpecs are the ObservabilityConfig specs of the current sampling config.
+	appliedSpecs          []observabilityapi.ObservabilityConfigSpec
+	hasNamespaceSelectors atomic.Bool
+	configLister          observabilitylister.ObservabilityConfigLister
+	namespaceLister       corev1listers.NamespaceLister
+	configController      controller.Controller
+	namespaceController   controller.Controller
 }
 
 func NewManager(nbClient libovsdbclient.Client) *Manager {
@@ -71,48 +102,295 @@ func NewManager(nbClient libovsdbclient.Client) *Manager {
 }
 
 func (m *Manager) SamplingConfig() *libovsdbops.SamplingConfig {
-	return m.sampConfig
-}
-
-func (m *Manager) Init() error {
-	// this will be read from the kube-api in the future
-	currentConfig := &collectorConfig{
-		collectorSetID: DefaultObservabilityCollectorSetID,
-		featuresProbability: map[libovsdbops.SampleFeature]int{
-			libovsdbops.EgressFirewallSample:     100,
-			libovsdbops.NetworkPolicySample:      100,
-			libovsdbops.AdminNetworkPolicySample: 100,
-			libovsdbops.MulticastSample:          100,
-			libovsdbops.UDNIsolationSample:       100,
-		},
-	}
+	return m.sampConfig.Load()
+}
+
+// Start sets up sampling with the config of the existing ObservabilityConfigs, or the default config when there
+// is none, and keeps watching them to reconfigure sampling at runtime. The namespaces are watched for the
+// namespace selectors of the configs.
+// On reconfiguration, the samples of the existing db objects of the sampled features are updated, whichever
+// network controller owns them. Start must be called before the network controllers create sampled db objects.
+func (m *Manager) Start(configInformer observabilityinformer.ObservabilityConfigInformer,
+	namespaceInformer corev1informers.NamespaceInformer) error {
+	m.configLister = configInformer.Lister()
+	m.namespaceLister = namespaceInformer.Lister()
+
+	m.configController = controller.NewController[observabilityapi.ObservabilityConfig]("observability-config-controller",
+		&controller.ControllerConfig[observabilityapi.ObservabilityConfig]{
+			RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+			Informer:       configInformer.Informer(),
+			Lister:         m.configLister.List,
+			ObjNeedsUpdate: configNeedsUpdate,
+			Reconcile:      m.reconcileConfig,
+			Threadiness:    1,
+			MaxAttempts:    controller.InfiniteAttempts,
+		})
+	m.namespaceController = controller.NewController[corev1.Namespace]("observability-namespace-controller",
+		&controller.ControllerConfig[corev1.Namespace]{
+			RateLimiter:    workqueue.DefaultTypedControllerRateLimiter[string](),
+			Informer:       namespaceInformer.Informer(),
+			Lister:         m.namespaceLister.List,
+			ObjNeedsUpdate: m.namespaceNeedsUpdate,
+			Reconcile:      m.reconcileNamespace,
+			Threadiness:    1,
+		})
+	return controller.StartWithInitialSync(m.initialSync, m.configController, m.namespaceController)
+}
 
-	return m.initWithConfig(currentConfig)
+// Stop stops watching the ObservabilityConfigs.
+func (m *Manager) Stop() {
+	if m.configController != nil {
+		controller.Stop(m.configController, m.namespaceController)
+	}
+	m.stopCleanupRetry()
 }
 
-func (m *Manager) initWithConfig(config *collectorConfig) error {
-	if err := m.setSamplingAppIDs(); err != nil {
+func (m *Manager) initialSync() error {
+	m.configLock.Lock()
+	defer m.configLock.Unlock()
+	specs, configs, err := m.getCollectorConfigs()
+	if err != nil {
 		return err
 	}
-	if err := m.setDbCollectors(); err != nil {
+	if err = m.initWithConfig(configs...); err != nil {
 		return err
 	}
+	m.appliedSpecs = specs
+	return nil
+}
 
-	featuresConfig, err := m.addCollector(config)
+// reconcileConfig applies the config of all the ObservabilityConfigs, whichever changed.
+func (m *Manager) reconcileConfig(_ string) error {
+	m.configLock.Lock()
+	defer m.configLock.Unlock()
+	specs, configs, err := m.getCollectorConfigs()
 	if err != nil {
 		return err
 	}
-	m.sampConfig = libovsdbops.NewSamplingConfig(featuresConfig)
+	if equality.Semantic.DeepEqual(specs, m.appliedSpecs) {
+		return nil
+	}
+	klog.Infof("Observability config changed, reconfiguring sampling for %d collector sets", len(configs))
+	if err = m.reconfigure(configs...); err != nil {
+		return err
+	}
+	m.appliedSpecs = specs
+	return nil
+}
+
+// reconcileNamespace updates the samples of the db objects of a namespace whose labels changed, as they may
+// now be selected, or no longer be selected, by the namespace selectors of the config.
+func (m *Manager) reconcileNamespace(namespace string) error {
+	m.configLock.Lock()
+	defer m.configLock.Unlock()
+	return m.syncACLSamples(func(acl *nbdb.ACL) bool {
+		return libovsdbops.GetACLNamespace(acl) == namespace
+	})
+}
 
+func configNeedsUpdate(oldObj, newObj *observabilityapi.ObservabilityConfig) bool {
+	if oldObj == nil || newObj == nil {
+		return true
+	}
+	return !equality.Semantic.DeepEqual(oldObj.Spec, newObj.Spec)
+}
+
+func (m *Manager) namespaceNeedsUpdate(oldObj, newObj *corev1.Namespace) bool {
+	// new namespaces don't have any sampled db objects yet, and the objects of deleted namespaces are deleted
+	if oldObj == nil || newObj == nil {
+		return false
+	}
+	return m.hasNamespaceSelectors.Load() && !labels.Equals(oldObj.Labels, newObj.Labels)
+}
+
+// defaultObservabilityConfigSpec returns the config used when no ObservabilityConfig exists: all features
+// are sampled with 100% probability.
+func defaultObservabilityConfigSpec() observabilityapi.ObservabilityConfigSpec {
+	return observabilityapi.ObservabilityConfigSpec{
+		CollectorSetID: DefaultObservabilityCollectorSetID,
+		Features: []observabilityapi.FeatureSampling{
+			{Feature: observabilityapi.EgressFirewallSampleFeature, Probability: 100},
+			{Feature: observabilityapi.NetworkPolicySampleFeature, Probability: 100},
+			{Feature: observabilityapi.AdminNetworkPolicySampleFeature, Probability: 100},
+			{Feature: observabilityapi.MulticastSampleFeature, Probability: 100},
+			{Feature: observabilityapi.UDNIsolationSampleFeature, Probability: 100},
+		},
+	}
+}
+
+// getCollectorConfigs returns the specs of the ObservabilityConfigs and the matching collector configs, or the
+// default ones when no ObservabilityConfig exists. When several ObservabilityConfigs use the same collector set,
+// only the oldest one is used.
+func (m *Manager) getCollectorConfigs() ([]observabilityapi.ObservabilityConfigSpec, []*collectorConfig, error) {
+	observConfigs, err := m.configLister.List(labels.Everything())
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list observability configs: %w", err)
+	}
+	if len(observConfigs) == 0 {
+		spec := defaultObservabilityConfigSpec()
+		config, err := m.newCollectorConfig(spec)
+		if err != nil {
+			return nil, nil, err
+		}
+		return []observabilityapi.ObservabilityConfigSpec{spec}, []*collectorConfig{config}, nil
+	}
+	slices.SortFunc(observConfigs, func(a, b *observabilityapi.ObservabilityConfig) int {
+		if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var specs []observabilityapi.ObservabilityConfigSpec
+	var configs []*collectorConfig
+	collectorSetIDs := sets.New[int64]()
+	for _, observConfig := range observConfigs {
+		if collectorSetIDs.Has(observConfig.Spec.CollectorSetID) {
+			klog.Warningf("Ignoring ObservabilityConfig %s: collector set %d is already used by another ObservabilityConfig",
+				observConfig.Name, observConfig.Spec.CollectorSetID)
+			continue
+		}
+		config, err := m.newCollectorConfig(observConfig.Spec)
+		if err != nil {
+			klog.Errorf("Ignoring ObservabilityConfig %s: %v", observConfig.Name, err)
+			continue
+		}
+		collectorSetIDs.Insert(observConfig.Spec.CollectorSetID)
+		specs = append(specs, observConfig.Spec)
+		configs = append(configs, config)
+	}
+	return specs, configs, nil
+}
+
+func (m *Manager) newCollectorConfig(spec observabilityapi.ObservabilityConfigSpec) (*collectorConfig, error) {
+	config := &collectorConfig{
+		collectorSetID:            int(spec.CollectorSetID),
+		featuresProbability:       map[libovsdbops.SampleFeature]int{},
+		featuresNamespaceSelector: map[libovsdbops.SampleFeature]labels.Selector{},
+	}
+	for _, featureSampling := range spec.Features {
+		feature := libovsdbops.SampleFeature(featureSampling.Feature)
+		probability := int(featureSampling.Probability)
+		if probability == 0 {
+			// defaulted by the API server, but not by the fake clients
+			probability = 100
+		}
+		config.featuresProbability[feature] = probability
+		if featureSampling.NamespaceSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(featureSampling.NamespaceSelector)
+			if err != nil {
+				return nil, fmt.Errorf("invalid namespace selector for feature %s: %w", feature, err)
+			}
+			config.featuresNamespaceSelector[feature] = selector
+		}
+	}
+	return config, nil
+}
+
+func (m *Manager) initWithConfig(configs ...*collectorConfig) error {
+	if err := m.applyConfig(configs...); err != nil {
+		return err
+	}
 	// now cleanup stale collectors
+	m.stopCleanupRetry()
 	m.deleteStaleCollectorsWithRetry()
 	return nil
 }
 
+// reconfigure applies a new config at runtime. Unlike on init, the network controllers won't update the samples of
+// their existing db objects, so it is done here before the stale collectors can be cleaned up.
+func (m *Manager) reconfigure(configs ...*collectorConfig) error {
+	if err := m.applyConfig(configs...); err != nil {
+		return err
+	}
+	if err := m.syncACLSamples(func(*nbdb.ACL) bool { return true }); err != nil {
+		return err
+	}
+	m.stopCleanupRetry()
+	m.deleteStaleCollectorsWithRetry()
+	return nil
+}
+
+// applyConfig creates the collectors of the given configs, and replaces the sampling config used for new samples.
+func (m *Manager) applyConfig(configs ...*collectorConfig) error {
+	if err := m.setSamplingAppIDs(); err != nil {
+		return err
+	}
+	if err := m.setDbCollectors(); err != nil {
+		return err
+	}
+
+	featuresConfig := make(map[libovsdbops.SampleFeature][]string)
+	namespaceFilters := make(map[libovsdbops.SampleFeature]map[string]libovsdbops.NamespaceFilter)
+	for _, config := range configs {
+		configFeatures, err := m.addCollector(config)
+		if err != nil {
+			return err
+		}
+		for feature, collectors := range configFeatures {
+			featuresConfig[feature] = append(featuresConfig[feature], collectors...)
+			selector, ok := config.featuresNamespaceSelector[feature]
+			if !ok {
+				continue
+			}
+			if namespaceFilters[feature] == nil {
+				namespaceFilters[feature] = make(map[string]libovsdbops.NamespaceFilter)
+			}
+			// a config has a single collector per feature
+			for _, collector := range collectors {
+				namespaceFilters[feature][collector] = m.getNamespaceFilter(selector)
+			}
+		}
+	}
+	m.sampConfig.Store(libovsdbops.NewSamplingConfigWithNamespaceFilters(featuresConfig, namespaceFilters))
+	m.hasNamespaceSelectors.Store(len(namespaceFilters) > 0)
+	return nil
+}
+
+// getNamespaceFilter returns a filter accepting the namespaces selected by the given selector.
+func (m *Manager) getNamespaceFilter(selector labels.Selector) libovsdbops.NamespaceFilter {
+	return func(namespace string) bool {
+		if m.namespaceLister == nil {
+			return false
+		}
+		ns, err := m.namespaceLister.Get(namespace)
+		if err != nil {
+			return false
+		}
+		return selector.Matches(labels.Set(ns.Labels))
+	}
+}
+
+// syncACLSamples updates the samples of the sampled ACLs accepted by p to the current sampling config.
+// ACLs are sampled based on their owner type, so the ACLs of all the network controllers are updated.
+func (m *Manager) syncACLSamples(p func(*nbdb.ACL) bool) error {
+	acls, err := libovsdbops.FindACLsWithPredicate(m.nbClient, func(acl *nbdb.ACL) bool {
+		return libovsdbops.GetACLSampleFeature(acl) != "" && p(acl)
+	})
+	if err != nil {
+		return fmt.Errorf("error getting sampled ACLs: %w", err)
+	}
+	samplingConfig := m.SamplingConfig()
+	for start := 0; start < len(acls); start += aclSyncBatchSize {
+		end := min(start+aclSyncBatchSize, len(acls))
+		ops, err := libovsdbops.CreateOrUpdateACLsOps(m.nbClient, nil, samplingConfig, acls[start:end]...)
+		if err != nil {
+			return fmt.Errorf("error updating ACL samples: %w", err)
+		}
+		if _, err = libovsdbops.TransactAndCheck(m.nbClient, ops); err != nil {
+			return fmt.Errorf("error updating ACL samples: %w", err)
+		}
+	}
+	klog.V(5).Infof("Updated the samples of %d ACLs", len(acls))
+	return nil
+}
+
 func (m *Manager) setDbCollectors() error {
 	m.collectorsLock.Lock()
 	defer m.collectorsLock.Unlock()
 	clear(m.dbCollectors)
+	clear(m.unusedCollectors)
+	m.takenCollectorIDs = sets.New[int]()
 	collectors, err := libovsdbops.ListSampleCollectors(m.nbClient)
 	if err != nil {
 		return fmt.Errorf("error getting sample collectors: %w", err)
@@ -132,21 +410,76 @@ func (m *Manager) setDbCollectors() error {
 // deleteStaleCollectorsWithRetry will retry, considering deletion should eventually succeed when all controllers
 // update their db entries to use the latest observability config.
 func (m *Manager) deleteStaleCollectorsWithRetry() {
-	if err := m.deleteStaleCollectors(); err != nil {
+	err := m.deleteStaleCollectors()
+	m.collectorsLock.Lock()
+	defer m.collectorsLock.Unlock()
+	if err != nil {
 		m.collectorsCleanupRetries += 1
 		// allow retries for 1 hour, hopefully it will be enough for all handler to complete initial sync
 		if m.collectorsCleanupRetries > 60 {
 			m.collectorsCleanupRetries = 0
+			m.cleanupTimer = nil
 			klog.Errorf("Cleanup stale collectors failed after 30 retries: %v", err)
 			return
 		}
-		time.AfterFunc(m.unusedCollectorsRetryInterval, m.deleteStaleCollectorsWithRetry)
+		m.cleanupTimer = time.AfterFunc(m.unusedCollectorsRetryInterval, m.retryDeleteStaleCollectors)
 		return
 	}
 	m.collectorsCleanupRetries = 0
+	m.cleanupTimer = nil
 	klog.Infof("Cleanup stale collectors succeeded.")
 }
 
+// retryDeleteStaleCollectors retries the stale collectors cleanup. A network controller may have created or updated
+// an ACL with the previous sampling config while the samples of the existing ACLs were updated, so the ACLs still
+// referencing the stale collectors are updated again first, until none is left.
+func (m *Manager) retryDeleteStaleCollectors() {
+	m.configLock.Lock()
+	defer m.configLock.Unlock()
+	if err := m.syncStaleACLSamples(); err != nil {
+		klog.Warningf("Failed to update the ACLs referencing stale collectors: %v", err)
+	}
+	m.deleteStaleCollectorsWithRetry()
+}
+
+// syncStaleACLSamples updates the samples of the ACLs referencing the unused collectors to the current sampling config.
+func (m *Manager) syncStaleACLSamples() error {
+	m.collectorsLock.Lock()
+	staleCollectors := sets.New[string]()
+	for collectorKey := range m.unusedCollectors {
+		staleCollectors.Insert(m.dbCollectors[collectorKey])
+	}
+	m.collectorsLock.Unlock()
+	if len(staleCollectors) == 0 {
+		return nil
+	}
+	samples, err := libovsdbops.FindSamplesWithPredicate(m.nbClient, func(sample *nbdb.Sample) bool {
+		return staleCollectors.HasAny(sample.Collectors...)
+	})
+	if err != nil {
+		return fmt.Errorf("error getting samples of stale collectors: %w", err)
+	}
+	staleSamples := sets.New[string]()
+	for _, sample := range samples {
+		staleSamples.Insert(sample.UUID)
+	}
+	return m.syncACLSamples(func(acl *nbdb.ACL) bool {
+		return acl.SampleNew != nil && staleSamples.Has(*acl.SampleNew) || acl.SampleEst != nil && staleSamples.Has(*acl.SampleEst)
+	})
+}
+
+// stopCleanupRetry cancels the pending retry of the stale collectors cleanup, so that a new config starts
+// with a new cleanup.
+func (m *Manager) stopCleanupRetry() {
+	m.collectorsLock.Lock()
+	defer m.collectorsLock.Unlock()
+	if m.cleanupTimer != nil {
+		m.cleanupTimer.Stop()
+		m.cleanupTimer = nil
+	}
+	m.collectorsCleanupRetries = 0
+}
+
 func (m *Manager) deleteStaleCollectors() error {
 	m.collectorsLock.Lock()
 	defer m.collectorsLock.Unlock()
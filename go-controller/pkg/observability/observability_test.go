@@ -4,8 +4,16 @@ import (
 	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
 
+	observabilityapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1"
+	observabilitylister "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1/apis/listers/observabilityconfig/v1"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
@@ -31,12 +39,14 @@ var _ = Describe("Observability Manager", func() {
 			NBData: data})
 		Expect(err).NotTo(HaveOccurred())
 		manager = NewManager(nbClient)
-		err = manager.Init()
+		config, err := manager.newCollectorConfig(defaultObservabilityConfigSpec())
+		Expect(err).NotTo(HaveOccurred())
+		err = manager.initWithConfig(config)
 		Expect(err).NotTo(HaveOccurred())
 	}
 
-	createACLWithPortGroup := func(acl *nbdb.ACL) *nbdb.PortGroup {
-		ops, err := libovsdbops.CreateOrUpdateACLsOps(nbClient, nil, manager.SamplingConfig(), acl)
+	createACLWithPortGroupAndConfig := func(acl *nbdb.ACL, samplingConfig *libovsdbops.SamplingConfig) *nbdb.PortGroup {
+		ops, err := libovsdbops.CreateOrUpdateACLsOps(nbClient, nil, samplingConfig, acl)
 		Expect(err).NotTo(HaveOccurred())
 		pg := &nbdb.PortGroup{
 			UUID: "pg-uuid",
@@ -49,6 +59,10 @@ var _ = Describe("Observability Manager", func() {
 		return pg
 	}
 
+	createACLWithPortGroup := func(acl *nbdb.ACL) *nbdb.PortGroup {
+		return createACLWithPortGroupAndConfig(acl, manager.SamplingConfig())
+	}
+
 	// createOrUpdateACLPreserveUUID calls CreateOrUpdateACLs and sets the acl.UUID back.
 	// that is required as setting real UUID breaks libovsdb matching
 	createOrUpdateACLPreserveUUID := func(nbClient libovsdbclient.Client, samplingConfig *libovsdbops.SamplingConfig, acl *nbdb.ACL) error {
@@ -93,6 +107,7 @@ var _ = Describe("Observability Manager", func() {
 	AfterEach(func() {
 		if libovsdbCleanup != nil {
 			libovsdbCleanup.Cleanup()
+			libovsdbCleanup = nil
 		}
 	})
 
@@ -373,4 +388,299 @@ var _ = Describe("Observability Manager", func() {
 			Eventually(nbClient, 2*manager.unusedCollectorsRetryInterval).Should(libovsdbtest.HaveData(expectedDB))
 		})
 	})
+
+	When("config is changed at runtime", func() {
+		const controllerName = "test-controller"
+
+		startManagerWithConfig := func(data []libovsdbtest.TestData, configs ...*collectorConfig) {
+			var err error
+			nbClient, _, libovsdbCleanup, err = libovsdbtest.NewNBSBTestHarness(libovsdbtest.TestSetup{
+				NBData: data})
+			Expect(err).NotTo(HaveOccurred())
+			manager = NewManager(nbClient)
+			manager.unusedCollectorsRetryInterval = time.Second
+			err = manager.initWithConfig(configs...)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		newNetpolACL := func(namespace string) *nbdb.ACL {
+			return &nbdb.ACL{
+				UUID: "acl-" + namespace + "-uuid",
+				ExternalIDs: map[string]string{
+					libovsdbops.OwnerControllerKey.String(): controllerName,
+					libovsdbops.OwnerTypeKey.String():       libovsdbops.NetworkPolicyOwnerType,
+					libovsdbops.ObjectNameKey.String():      namespace + ":policy",
+					libovsdbops.PrimaryIDKey.String():       namespace + ":policy",
+				},
+			}
+		}
+
+		setNamespaces := func(namespaces ...*corev1.Namespace) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, ns := range namespaces {
+				Expect(indexer.Add(ns)).To(Succeed())
+			}
+			manager.namespaceLister = corev1listers.NewNamespaceLister(indexer)
+		}
+
+		It("should sample to every collector set", func() {
+			startManagerWithConfig(samplingApps,
+				&collectorConfig{
+					collectorSetID:      DefaultObservabilityCollectorSetID,
+					featuresProbability: map[libovsdbops.SampleFeature]int{libovsdbops.NetworkPolicySample: 100},
+				},
+				&collectorConfig{
+					collectorSetID:      DefaultObservabilityCollectorSetID + 1,
+					featuresProbability: map[libovsdbops.SampleFeature]int{libovsdbops.NetworkPolicySample: 50},
+				},
+			)
+			acl := newNetpolACL("ns1")
+			pg := createACLWithPortGroup(acl)
+
+			collector1 := &nbdb.SampleCollector{
+				UUID:        collectorUUID,
+				ID:          1,
+				SetID:       DefaultObservabilityCollectorSetID,
+				Probability: 65535,
+				ExternalIDs: map[string]string{collectorFeaturesExternalID: libovsdbops.NetworkPolicySample},
+			}
+			collector2 := &nbdb.SampleCollector{
+				UUID:        collectorUUID + "-2",
+				ID:          2,
+				SetID:       DefaultObservabilityCollectorSetID + 1,
+				Probability: 32767,
+				ExternalIDs: map[string]string{collectorFeaturesExternalID: libovsdbops.NetworkPolicySample},
+			}
+			sample := &nbdb.Sample{
+				UUID:       "sample-uuid",
+				Metadata:   int(libovsdbops.GetACLSampleID(acl)),
+				Collectors: []string{collector1.UUID, collector2.UUID},
+			}
+			acl.SampleNew = &sample.UUID
+			acl.SampleEst = &sample.UUID
+			Eventually(nbClient).Should(libovsdbtest.HaveData(append(samplingApps, collector1, collector2, sample, pg, acl)))
+		})
+
+		It("should update the samples of existing ACLs and cleanup stale collectors", func() {
+			startManagerWithConfig(initialDB, &collectorConfig{
+				collectorSetID:      DefaultObservabilityCollectorSetID,
+				featuresProbability: map[libovsdbops.SampleFeature]int{libovsdbops.NetworkPolicySample: 100},
+			})
+			acl := newNetpolACL("ns1")
+			// ACLs of other controllers are updated as well
+			otherControllerACL := &nbdb.ACL{
+				UUID: "other-controller-acl-uuid",
+				ExternalIDs: map[string]string{
+					libovsdbops.OwnerControllerKey.String(): "other-controller",
+					libovsdbops.OwnerTypeKey.String():       libovsdbops.NetworkPolicyOwnerType,
+					libovsdbops.ObjectNameKey.String():      "ns1:policy",
+					libovsdbops.PrimaryIDKey.String():       "other-controller:ns1:policy",
+				},
+			}
+			// ACLs of the features that are not sampled are not updated
+			otherACL := &nbdb.ACL{
+				UUID: "other-acl-uuid",
+				ExternalIDs: map[string]string{
+					libovsdbops.OwnerControllerKey.String(): controllerName,
+					libovsdbops.OwnerTypeKey.String():       libovsdbops.NetpolDefaultOwnerType,
+					libovsdbops.ObjectNameKey.String():      "ns1",
+					libovsdbops.PrimaryIDKey.String():       controllerName + ":ns1",
+				},
+			}
+			ops, err := libovsdbops.CreateOrUpdateACLsOps(nbClient, nil, manager.SamplingConfig(), acl, otherControllerACL)
+			Expect(err).NotTo(HaveOccurred())
+			ops, err = libovsdbops.CreateOrUpdateACLsOps(nbClient, ops, nil, otherACL)
+			Expect(err).NotTo(HaveOccurred())
+			pg := &nbdb.PortGroup{
+				UUID: "pg-uuid",
+				ACLs: []string{acl.UUID, otherControllerACL.UUID, otherACL.UUID},
+			}
+			ops, err = libovsdbops.CreateOrUpdatePortGroupsOps(nbClient, ops, pg)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = libovsdbops.TransactAndCheck(nbClient, ops)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = manager.reconfigure(&collectorConfig{
+				collectorSetID:      DefaultObservabilityCollectorSetID + 1,
+				featuresProbability: map[libovsdbops.SampleFeature]int{libovsdbops.NetworkPolicySample: 50},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			newCollector := &nbdb.SampleCollector{
+				UUID:        collectorUUID + "-2",
+				ID:          2,
+				SetID:       DefaultObservabilityCollectorSetID + 1,
+				Probability: 32767,
+				ExternalIDs: map[string]string{collectorFeaturesExternalID: libovsdbops.NetworkPolicySample},
+			}
+			sample := &nbdb.Sample{
+				UUID:       "sample-uuid",
+				Metadata:   int(libovsdbops.GetACLSampleID(acl)),
+				Collectors: []string{newCollector.UUID},
+			}
+			acl.SampleNew = &sample.UUID
+			acl.SampleEst = &sample.UUID
+			otherControllerSample := &nbdb.Sample{
+				UUID:       "other-controller-sample-uuid",
+				Metadata:   int(libovsdbops.GetACLSampleID(otherControllerACL)),
+				Collectors: []string{newCollector.UUID},
+			}
+			otherControllerACL.SampleNew = &otherControllerSample.UUID
+			otherControllerACL.SampleEst = &otherControllerSample.UUID
+			Eventually(nbClient).Should(libovsdbtest.HaveData(append(samplingApps, newCollector, sample, otherControllerSample, pg,
+				acl, otherControllerACL, otherACL)))
+		})
+
+		It("should update the ACLs created with the previous config before cleaning up stale collectors", func() {
+			startManagerWithConfig(initialDB, &collectorConfig{
+				collectorSetID:      DefaultObservabilityCollectorSetID,
+				featuresProbability: map[libovsdbops.SampleFeature]int{libovsdbops.NetworkPolicySample: 100},
+			})
+			previousConfig := manager.SamplingConfig()
+
+			// reconfigure, with a network controller creating an ACL with the config it loaded before the
+			// reconfiguration once the samples of the existing ACLs are updated
+			err := manager.applyConfig(&collectorConfig{
+				collectorSetID:      DefaultObservabilityCollectorSetID + 1,
+				featuresProbability: map[libovsdbops.SampleFeature]int{libovsdbops.NetworkPolicySample: 50},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manager.syncACLSamples(func(*nbdb.ACL) bool { return true })).To(Succeed())
+			acl := newNetpolACL("ns1")
+			pg := createACLWithPortGroupAndConfig(acl, previousConfig)
+			manager.stopCleanupRetry()
+			manager.deleteStaleCollectorsWithRetry()
+
+			newCollector := &nbdb.SampleCollector{
+				UUID:        collectorUUID + "-2",
+				ID:          2,
+				SetID:       DefaultObservabilityCollectorSetID + 1,
+				Probability: 32767,
+				ExternalIDs: map[string]string{collectorFeaturesExternalID: libovsdbops.NetworkPolicySample},
+			}
+			sample := &nbdb.Sample{
+				UUID:       "sample-uuid",
+				Metadata:   int(libovsdbops.GetACLSampleID(acl)),
+				Collectors: []string{newCollector.UUID},
+			}
+			acl.SampleNew = &sample.UUID
+			acl.SampleEst = &sample.UUID
+			Eventually(nbClient, 2*manager.unusedCollectorsRetryInterval).Should(libovsdbtest.HaveData(
+				append(samplingApps, newCollector, sample, pg, acl)))
+		})
+
+		It("should only sample the objects of the selected namespaces", func() {
+			startManagerWithConfig(samplingApps, &collectorConfig{
+				collectorSetID:      DefaultObservabilityCollectorSetID,
+				featuresProbability: map[libovsdbops.SampleFeature]int{libovsdbops.NetworkPolicySample: 100},
+				featuresNamespaceSelector: map[libovsdbops.SampleFeature]labels.Selector{
+					libovsdbops.NetworkPolicySample: labels.SelectorFromSet(labels.Set{"sampled": "true"}),
+				},
+			})
+			sampledNs := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "sampled", Labels: map[string]string{"sampled": "true"}}}
+			otherNs := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}}
+			setNamespaces(sampledNs, otherNs)
+			Expect(manager.hasNamespaceSelectors.Load()).To(BeTrue())
+
+			sampledACL := newNetpolACL(sampledNs.Name)
+			otherACL := newNetpolACL(otherNs.Name)
+			ops, err := libovsdbops.CreateOrUpdateACLsOps(nbClient, nil, manager.SamplingConfig(), sampledACL, otherACL)
+			Expect(err).NotTo(HaveOccurred())
+			pg := &nbdb.PortGroup{
+				UUID: "pg-uuid",
+				ACLs: []string{sampledACL.UUID, otherACL.UUID},
+			}
+			ops, err = libovsdbops.CreateOrUpdatePortGroupsOps(nbClient, ops, pg)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = libovsdbops.TransactAndCheck(nbClient, ops)
+			Expect(err).NotTo(HaveOccurred())
+
+			collector := &nbdb.SampleCollector{
+				UUID:        collectorUUID,
+				ID:          1,
+				SetID:       DefaultObservabilityCollectorSetID,
+				Probability: 65535,
+				ExternalIDs: map[string]string{collectorFeaturesExternalID: libovsdbops.NetworkPolicySample},
+			}
+			sample := &nbdb.Sample{
+				UUID:       "sample-uuid",
+				Metadata:   int(libovsdbops.GetACLSampleID(sampledACL)),
+				Collectors: []string{collector.UUID},
+			}
+			sampledACL.SampleNew = &sample.UUID
+			sampledACL.SampleEst = &sample.UUID
+			Eventually(nbClient).Should(libovsdbtest.HaveData(append(samplingApps, collector, sample, pg, sampledACL, otherACL)))
+
+			// the other namespace gets selected
+			otherNs = otherNs.DeepCopy()
+			otherNs.Labels = map[string]string{"sampled": "true"}
+			setNamespaces(sampledNs, otherNs)
+			Expect(manager.reconcileNamespace(otherNs.Name)).To(Succeed())
+
+			otherSample := &nbdb.Sample{
+				UUID:       "other-sample-uuid",
+				Metadata:   int(libovsdbops.GetACLSampleID(otherACL)),
+				Collectors: []string{collector.UUID},
+			}
+			otherACL.SampleNew = &otherSample.UUID
+			otherACL.SampleEst = &otherSample.UUID
+			Eventually(nbClient).Should(libovsdbtest.HaveData(append(samplingApps, collector, sample, otherSample, pg, sampledACL, otherACL)))
+		})
+	})
+
+	Context("ObservabilityConfigs", func() {
+		setConfigs := func(configs ...*observabilityapi.ObservabilityConfig) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, config := range configs {
+				Expect(indexer.Add(config)).To(Succeed())
+			}
+			manager = NewManager(nil)
+			manager.configLister = observabilitylister.NewObservabilityConfigLister(indexer)
+		}
+
+		newConfig := func(name string, created time.Time, collectorSetID int64, features ...observabilityapi.FeatureSampling) *observabilityapi.ObservabilityConfig {
+			return &observabilityapi.ObservabilityConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+				Spec: observabilityapi.ObservabilityConfigSpec{
+					CollectorSetID: collectorSetID,
+					Features:       features,
+				},
+			}
+		}
+
+		It("should use the default config when no ObservabilityConfig exists", func() {
+			setConfigs()
+			specs, configs, err := manager.getCollectorConfigs()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(specs).To(Equal([]observabilityapi.ObservabilityConfigSpec{defaultObservabilityConfigSpec()}))
+			Expect(configs).To(HaveLen(1))
+			Expect(configs[0].collectorSetID).To(Equal(DefaultObservabilityCollectorSetID))
+			Expect(configs[0].featuresProbability).To(HaveLen(5))
+		})
+
+		It("should ignore the newer ObservabilityConfigs using the same collector set", func() {
+			now := time.Now()
+			setConfigs(
+				newConfig("newer", now, 10, observabilityapi.FeatureSampling{Feature: observabilityapi.MulticastSampleFeature}),
+				newConfig("older", now.Add(-time.Minute), 10, observabilityapi.FeatureSampling{
+					Feature:     observabilityapi.NetworkPolicySampleFeature,
+					Probability: 10,
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"sampled": "true"},
+					},
+				}),
+				newConfig("other", now, 20, observabilityapi.FeatureSampling{Feature: observabilityapi.EgressFirewallSampleFeature}),
+			)
+			specs, configs, err := manager.getCollectorConfigs()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(specs).To(HaveLen(2))
+			Expect(configs).To(HaveLen(2))
+			Expect(configs[0].collectorSetID).To(Equal(10))
+			Expect(configs[0].featuresProbability).To(Equal(map[libovsdbops.SampleFeature]int{libovsdbops.NetworkPolicySample: 10}))
+			Expect(configs[0].featuresNamespaceSelector[libovsdbops.NetworkPolicySample].String()).To(Equal("sampled=true"))
+			Expect(configs[1].collectorSetID).To(Equal(20))
+			// probability defaults to 100%
+			Expect(configs[1].featuresProbability).To(Equal(map[libovsdbops.SampleFeature]int{libovsdbops.EgressFirewallSample: 100}))
+		})
+	})
 })
@@ -19,8 +19,11 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	ref "k8s.io/client-go/tools/reference"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	utilnet "k8s.io/utils/net"
 
@@ -29,6 +32,7 @@ import (
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/pod"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	egressqoslisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/listers/egressqos/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kubevirt"
@@ -188,17 +192,34 @@ type BaseNetworkController struct {
 
 	// Controller used for programming OVN for Network QoS
 	nqosController *nqoscontroller.Controller
+
+	// EgressQoS
+	egressQoSLister egressqoslisters.EgressQoSLister
+	egressQoSSynced cache.InformerSynced
+	egressQoSQueue  workqueue.TypedRateLimitingInterface[string]
+	egressQoSCache  sync.Map
+
+	egressQoSPodLister corev1listers.PodLister
+	egressQoSPodSynced cache.InformerSynced
+	egressQoSPodQueue  workqueue.TypedRateLimitingInterface[string]
+
+	egressQoSNodeLister corev1listers.NodeLister
+	egressQoSNodeSynced cache.InformerSynced
+	egressQoSNodeQueue  workqueue.TypedRateLimitingInterface[string]
 }
 
 func (oc *BaseNetworkController) reconcile(netInfo util.NetInfo, setNodeFailed func(string)) error {
 	// gather some information first
 	var err error
 	var retryNodes []*corev1.Node
+	// appended subnets need the cluster subnet routes and policies of every
+	// local node to be synced again
+	subnetsChanged := !sameSubnets(oc.Subnets(), netInfo.Subnets())
 	oc.localZoneNodes.Range(func(key, _ any) bool {
 		nodeName := key.(string)
 		wasAdvertised := util.IsPodNetworkAdvertisedAtNode(oc, nodeName)
 		isAdvertised := util.IsPodNetworkAdvertisedAtNode(netInfo, nodeName)
-		if wasAdvertised == isAdvertised {
+		if wasAdvertised == isAdvertised && !subnetsChanged {
 			// noop
 			return true
 		}
@@ -249,6 +270,24 @@ func (oc *BaseNetworkController) reconcile(netInfo util.NetInfo, setNodeFailed f
 	return nil
 }
 
+// sameSubnets tells whether both lists hold the same subnets regardless of
+// their order
+func sameSubnets(a, b []config.CIDRNetworkEntry) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	subnets := sets.New[string]()
+	for _, subnet := range a {
+		subnets.Insert(subnet.String())
+	}
+	for _, subnet := range b {
+		if !subnets.Has(subnet.String()) {
+			return false
+		}
+	}
+	return true
+}
+
 // BaseSecondaryNetworkController structure holds per-network fields and network specific
 // configuration for secondary network controller
 type BaseSecondaryNetworkController struct {
@@ -551,7 +590,15 @@ func (bnc *BaseNetworkController) createNodeLogicalSwitch(nodeName string, hostS
 		return fmt.Errorf("failed finding migratable pod IPs belonging to %s: %v", nodeName, err)
 	}
 
-	return bnc.lsManager.AddOrUpdateSwitch(logicalSwitch.Name, hostSubnets, migratableIPsByPod...)
+	networkExcludeSubnets := util.IntersectCIDRs(hostSubnets, bnc.ExcludeSubnets()...)
+	excludeSubnets := make([]*net.IPNet, 0, len(migratableIPsByPod)+len(networkExcludeSubnets))
+	excludeSubnets = append(excludeSubnets, migratableIPsByPod...)
+	excludeSubnets = append(excludeSubnets, networkExcludeSubnets...)
+	if err := bnc.lsManager.AddOrUpdateSwitch(logicalSwitch.Name, hostSubnets, excludeSubnets...); err != nil {
+		return err
+	}
+
+	return bnc.lsManager.ReserveSubnets(logicalSwitch.Name, bnc.ReservedSubnets()...)
 }
 
 // deleteNodeLogicalNetwork removes the logical switch and logical router port associated with the node
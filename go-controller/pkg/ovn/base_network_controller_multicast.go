@@ -6,10 +6,12 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
 type defaultMcastACLTypeID string
@@ -28,6 +30,21 @@ const (
 	legacyMulticastDefaultDenyPortGroup = "mcastPortGroupDeny"
 )
 
+// isMulticastSupportedForUserDefinedNetwork returns true if multicast has to be
+// configured for the given user defined network: primary networks follow the
+// cluster wide setting, while secondary networks have to opt in through the
+// multicast setting of their NAD. Multicast on secondary networks relies on the
+// namespace address sets, so IPAM-less networks are not supported.
+func isMulticastSupportedForUserDefinedNetwork(netInfo util.NetInfo) bool {
+	if !config.EnableMulticast {
+		return false
+	}
+	if netInfo.IsPrimaryNetwork() {
+		return util.IsNetworkSegmentationSupportEnabled()
+	}
+	return netInfo.AllowsMulticast() && util.DoesNetworkRequireIPAM(netInfo)
+}
+
 func getACLMatchAF(ipv4Match, ipv6Match string, ipv4Mode, ipv6Mode bool) string {
 	if ipv4Mode && ipv6Mode {
 		return "(" + ipv4Match + " || " + ipv6Match + ")"
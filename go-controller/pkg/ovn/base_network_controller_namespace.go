@@ -73,9 +73,11 @@ func (bnc *BaseNetworkController) shouldWatchNamespaces() bool {
 	// - The network is the default network.
 	// - The network is primary, and network segmentation is enabled.
 	// - The network is secondary, and multi NetworkPolicies are enabled.
+	// - The network is secondary, and multicast is enabled on it.
 	return bnc.IsDefault() ||
 		bnc.IsPrimaryNetwork() && util.IsNetworkSegmentationSupportEnabled() ||
-		bnc.IsSecondary() && util.IsMultiNetworkPoliciesSupportEnabled()
+		bnc.IsSecondary() && util.IsMultiNetworkPoliciesSupportEnabled() ||
+		bnc.IsSecondary() && bnc.multicastSupport
 }
 
 // WatchNamespaces starts the watching of namespace resource and calls
@@ -18,6 +18,7 @@ func TestBaseNetworkController_shouldWatchNamespaces(t *testing.T) {
 		name                                                 string
 		netCfg                                               *ovntypes.NetConf
 		enableNetSeg, enableMultiNetPolicies, expectedReturn bool
+		enableMulticast                                      bool
 	}{
 		{
 			name: "should watch namespaces for default network",
@@ -46,6 +47,29 @@ func TestBaseNetworkController_shouldWatchNamespaces(t *testing.T) {
 			enableMultiNetPolicies: true,
 			expectedReturn:         true,
 		},
+		{
+			name: "should watch namespaces for secondary network with multicast enabled",
+			netCfg: &ovntypes.NetConf{
+				NetConf:   cnitypes.NetConf{Name: "secondary"},
+				Topology:  types.Layer2Topology,
+				Role:      types.NetworkRoleSecondary,
+				Subnets:   "10.1.130.0/24",
+				Multicast: true,
+			},
+			enableMulticast: true,
+			expectedReturn:  true,
+		},
+		{
+			name: "should not watch namespaces for secondary network with multicast enabled when multicast is disabled in the cluster",
+			netCfg: &ovntypes.NetConf{
+				NetConf:   cnitypes.NetConf{Name: "secondary"},
+				Topology:  types.Layer2Topology,
+				Role:      types.NetworkRoleSecondary,
+				Subnets:   "10.1.130.0/24",
+				Multicast: true,
+			},
+			expectedReturn: false,
+		},
 		{
 			name: "should not watch namespaces for primary network when network segmentation is disabled",
 			netCfg: &ovntypes.NetConf{
@@ -68,12 +92,16 @@ func TestBaseNetworkController_shouldWatchNamespaces(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			util.PrepareTestConfig()
-			config.OVNKubernetesFeature.EnableMultiNetwork = tt.enableNetSeg || tt.enableMultiNetPolicies
+			config.OVNKubernetesFeature.EnableMultiNetwork = tt.enableNetSeg || tt.enableMultiNetPolicies || tt.enableMulticast
+			config.EnableMulticast = tt.enableMulticast
 			config.OVNKubernetesFeature.EnableNetworkSegmentation = tt.enableNetSeg
 			config.OVNKubernetesFeature.EnableMultiNetworkPolicy = tt.enableMultiNetPolicies
 			netInfo, err := util.NewNetInfo(tt.netCfg)
 			require.NoError(t, err, "failed to create network info")
 			bnc := &BaseNetworkController{
+				CommonNetworkControllerInfo: CommonNetworkControllerInfo{
+					multicastSupport: isMulticastSupportedForUserDefinedNetwork(netInfo),
+				},
 				ReconcilableNetInfo: util.NewReconcilableNetInfo(netInfo),
 			}
 			if tt.expectedReturn != bnc.shouldWatchNamespaces() {
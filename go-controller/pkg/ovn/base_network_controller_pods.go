@@ -832,6 +832,13 @@ func (bnc *BaseNetworkController) allocatePodAnnotation(pod *corev1.Pod, existin
 					return nil, false, fmt.Errorf("unable to ensure IPs allocated for already annotated pod: %s, IPs: %s, error: %v",
 						podDesc, util.JoinIPNetIPs(podIfAddrs, " "), err)
 				}
+				// pods annotated before subnets were appended to the
+				// cluster network lack the routes towards them
+				if util.AddMissingClusterSubnetRoutes(bnc.GetNetInfo(), podAnnotation) {
+					if err = bnc.updatePodAnnotationWithRetry(pod, podAnnotation, nadName); err != nil {
+						return nil, false, err
+					}
+				}
 			}
 			return podAnnotation, false, nil
 
@@ -373,7 +373,8 @@ func (bsnc *BaseSecondaryNetworkController) addLogicalPortToNetworkForNAD(pod *c
 	}
 
 	if bsnc.doesNetworkRequireIPAM() &&
-		(util.IsMultiNetworkPoliciesSupportEnabled() || (util.IsNetworkSegmentationSupportEnabled() && bsnc.IsPrimaryNetwork())) {
+		(util.IsMultiNetworkPoliciesSupportEnabled() || (util.IsNetworkSegmentationSupportEnabled() && bsnc.IsPrimaryNetwork()) ||
+			bsnc.multicastSupport) {
 		// Ensure the namespace/nsInfo exists
 		portUUID := ""
 		if lsp != nil {
@@ -386,6 +387,16 @@ func (bsnc *BaseSecondaryNetworkController) addLogicalPortToNetworkForNAD(pod *c
 		ops = append(ops, addOps...)
 	}
 
+	// secondary networks have no management port on their switches, the default
+	// deny multicast ACLs are applied to them through the pods' ports
+	if bsnc.multicastSupport && !bsnc.IsPrimaryNetwork() && lsp != nil {
+		ops, err = libovsdbops.AddPortsToPortGroupOps(bsnc.nbClient, ops,
+			bsnc.getClusterPortGroupName(types.ClusterPortGroupNameBase), lsp.UUID)
+		if err != nil {
+			return fmt.Errorf("failed adding port %s to cluster port group for multicast: %w", lsp.Name, err)
+		}
+	}
+
 	recordOps, txOkCallBack, _, err := bsnc.AddConfigDurationRecord("pod", pod.Namespace, pod.Name)
 	if err != nil {
 		klog.Errorf("Config duration recorder: %v", err)
@@ -457,9 +468,10 @@ func (bsnc *BaseSecondaryNetworkController) removePodForSecondaryNetwork(pod *co
 		// handle remote pod clean up but only do this one time
 		if !hasLogicalPort && !alreadyProcessed {
 			if bsnc.doesNetworkRequireIPAM() &&
-				// address set is for network policy only. So either multi network policy is enabled or network
-				// segmentation, and it is a primary UDN (regular netpol)
-				(util.IsMultiNetworkPoliciesSupportEnabled() || (util.IsNetworkSegmentationSupportEnabled() && bsnc.IsPrimaryNetwork())) {
+				// address set is for network policy and multicast only. So either multi network policy is enabled,
+				// or network segmentation and it is a primary UDN (regular netpol), or multicast is enabled
+				(util.IsMultiNetworkPoliciesSupportEnabled() || (util.IsNetworkSegmentationSupportEnabled() && bsnc.IsPrimaryNetwork()) ||
+					bsnc.multicastSupport) {
 				return bsnc.removeRemoteZonePodFromNamespaceAddressSet(pod)
 			}
 
@@ -531,17 +543,18 @@ func (bsnc *BaseSecondaryNetworkController) hasIPAMClaim(pod *corev1.Pod, nadNam
 
 	var ipamClaimName string
 	var wasPersistentIPRequested bool
+	nadKeys := strings.Split(nadNamespacedName, "/")
+	if len(nadKeys) != 2 {
+		return false, fmt.Errorf("invalid NAD name %s", nadNamespacedName)
+	}
+	nadNamespace := nadKeys[0]
+	nadName := nadKeys[1]
 	if bsnc.IsPrimaryNetwork() {
-		// primary network ipam reference claim is on the annotation
-		ipamClaimName, wasPersistentIPRequested = pod.Annotations[util.OvnUDNIPAMClaimName]
+		// primary network ipam reference claim is on the annotation or derived
+		// from the StatefulSet pod identity
+		ipamClaimName, wasPersistentIPRequested = util.GetPrimaryNetworkIPAMClaimName(pod, bsnc.GetNetInfo(), nadName)
 	} else {
 		// secondary network the IPAM claim reference is on the network selection element
-		nadKeys := strings.Split(nadNamespacedName, "/")
-		if len(nadKeys) != 2 {
-			return false, fmt.Errorf("invalid NAD name %s", nadNamespacedName)
-		}
-		nadNamespace := nadKeys[0]
-		nadName := nadKeys[1]
 		allNetworks, err := util.GetK8sPodAllNetworkSelections(pod)
 		if err != nil {
 			return false, err
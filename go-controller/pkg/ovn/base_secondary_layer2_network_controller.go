@@ -137,6 +137,19 @@ func (oc *BaseSecondaryLayer2NetworkController) run() error {
 		}
 	}
 
+	if config.OVNKubernetesFeature.EnableEgressQoS && oc.IsPrimaryNetwork() {
+		err := oc.initEgressQoSController(
+			oc.watchFactory.EgressQoSInformer(),
+			oc.watchFactory.PodCoreInformer(),
+			oc.watchFactory.NodeCoreInformer())
+		if err != nil {
+			return err
+		}
+		if err = oc.runEgressQoSController(oc.wg, 1, oc.stopChan); err != nil {
+			return err
+		}
+	}
+
 	// start NetworkQoS controller if feature is enabled
 	if config.OVNKubernetesFeature.EnableNetworkQoS {
 		err := oc.newNetworkQoSController()
@@ -186,6 +199,18 @@ func (oc *BaseSecondaryLayer2NetworkController) initializeLogicalSwitch(switchNa
 		}
 	}
 
+	// If supported, enable IGMP/MLD snooping on the switch of secondary networks.
+	// There is no router address to source queries from, so the querier is left
+	// disabled.
+	if oc.multicastSupport && !oc.IsPrimaryNetwork() {
+		if logicalSwitch.OtherConfig == nil {
+			logicalSwitch.OtherConfig = map[string]string{}
+		}
+		logicalSwitch.OtherConfig["mcast_snoop"] = "true"
+		logicalSwitch.OtherConfig["mcast_querier"] = "false"
+		logicalSwitch.OtherConfig["mcast_flood_unregistered"] = "true"
+	}
+
 	if clusterLoadBalancerGroupUUID != "" && switchLoadBalancerGroupUUID != "" {
 		logicalSwitch.LoadBalancerGroup = []string{clusterLoadBalancerGroupUUID, switchLoadBalancerGroupUUID}
 	}
@@ -199,6 +224,10 @@ func (oc *BaseSecondaryLayer2NetworkController) initializeLogicalSwitch(switchNa
 		return nil, err
 	}
 
+	if err = oc.lsManager.ReserveSubnets(switchName, oc.ReservedSubnets()...); err != nil {
+		return nil, err
+	}
+
 	return &logicalSwitch, nil
 }
 
@@ -0,0 +1,455 @@
+package ovn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	libovsdbclient "github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/ovsdb"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	egressfirewallapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+	egressfirewallapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1/apis/applyconfiguration/egressfirewall/v1"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+	utilerrors "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util/errors"
+)
+
+// ClusterEgressFirewall rules are applied to the pods of all selected namespaces. Every ClusterEgressFirewall
+// owns a port group with the local pods of the selected namespaces, and every rule is translated to a single
+// ACL on that port group. The ACLs live in the default tier with the namespace EgressFirewall ACLs: rules placed
+// before the namespace EgressFirewall have higher priorities than the EgressFirewall ACLs, rules placed after it
+// have lower priorities.
+// dnsName destinations are not supported: the DNS names of EgressFirewall rules are resolved by the DNS name
+// resolvers into address sets owned by the namespace of the EgressFirewall, which don't exist for a cluster
+// scoped object.
+
+func (oc *DefaultNetworkController) newClusterEgressFirewallController() controller.Controller {
+	cefInformer := oc.watchFactory.ClusterEgressFirewallInformer()
+	controllerConfig := &controller.ControllerConfig[egressfirewallapi.ClusterEgressFirewall]{
+		RateLimiter:    workqueue.NewTypedItemFastSlowRateLimiter[string](time.Second, 5*time.Second, 5),
+		Informer:       cefInformer.Informer(),
+		Lister:         cefInformer.Lister().List,
+		ObjNeedsUpdate: cefNeedsUpdate,
+		Reconcile:      oc.syncClusterEgressFirewall,
+		Threadiness:    1,
+	}
+	return controller.NewController[egressfirewallapi.ClusterEgressFirewall]("cef_controller", controllerConfig)
+}
+
+func cefNeedsUpdate(oldCEF, newCEF *egressfirewallapi.ClusterEgressFirewall) bool {
+	if oldCEF == nil || newCEF == nil {
+		return true
+	}
+	// status updates don't need to be handled
+	return !reflect.DeepEqual(oldCEF.Spec, newCEF.Spec)
+}
+
+func (oc *DefaultNetworkController) newCEFNamespaceController(namespaceInformer coreinformers.NamespaceInformer) controller.Controller {
+	controllerConfig := &controller.ControllerConfig[corev1.Namespace]{
+		RateLimiter:    workqueue.NewTypedItemFastSlowRateLimiter[string](time.Second, 5*time.Second, 5),
+		Informer:       namespaceInformer.Informer(),
+		Lister:         namespaceInformer.Lister().List,
+		ObjNeedsUpdate: cefNamespaceNeedsUpdate,
+		Reconcile:      oc.updateClusterEgressFirewallsForNamespace,
+		Threadiness:    1,
+	}
+	return controller.NewController[corev1.Namespace]("cef_namespace_controller", controllerConfig)
+}
+
+func cefNamespaceNeedsUpdate(oldNamespace, newNamespace *corev1.Namespace) bool {
+	if oldNamespace == nil || newNamespace == nil {
+		return true
+	}
+	return !reflect.DeepEqual(oldNamespace.Labels, newNamespace.Labels)
+}
+
+func (oc *DefaultNetworkController) newCEFPodController(podInformer coreinformers.PodInformer) controller.Controller {
+	controllerConfig := &controller.ControllerConfig[corev1.Pod]{
+		RateLimiter:    workqueue.NewTypedItemFastSlowRateLimiter[string](time.Second, 5*time.Second, 5),
+		Informer:       podInformer.Informer(),
+		Lister:         podInformer.Lister().List,
+		ObjNeedsUpdate: cefPodNeedsUpdate,
+		Reconcile:      oc.updateClusterEgressFirewallsForPod,
+		Threadiness:    1,
+	}
+	return controller.NewController[corev1.Pod]("cef_pod_controller", controllerConfig)
+}
+
+// cefPodNeedsUpdate only reacts to the changes that may add or remove a pod logical switch port from the
+// ClusterEgressFirewall port groups. The pod IPs are reported once the logical switch port exists.
+func cefPodNeedsUpdate(oldPod, newPod *corev1.Pod) bool {
+	if oldPod == nil || newPod == nil {
+		return true
+	}
+	return oldPod.Spec.NodeName != newPod.Spec.NodeName ||
+		oldPod.Status.Phase != newPod.Status.Phase ||
+		!reflect.DeepEqual(oldPod.Status.PodIPs, newPod.Status.PodIPs)
+}
+
+// startClusterEgressFirewallControllers cleans up port groups of deleted ClusterEgressFirewalls and starts
+// the controllers handling ClusterEgressFirewall, namespace and pod events.
+func (oc *DefaultNetworkController) startClusterEgressFirewallControllers() error {
+	oc.cefController = oc.newClusterEgressFirewallController()
+	oc.cefNamespaceController = oc.newCEFNamespaceController(oc.watchFactory.NamespaceCoreInformer())
+	oc.cefPodController = oc.newCEFPodController(oc.watchFactory.PodCoreInformer())
+	return controller.StartWithInitialSync(oc.syncClusterEgressFirewalls, oc.cefController, oc.cefNamespaceController,
+		oc.cefPodController)
+}
+
+// syncClusterEgressFirewalls deletes port groups of ClusterEgressFirewalls that don't exist anymore,
+// their ACLs are garbage collected by the database.
+func (oc *DefaultNetworkController) syncClusterEgressFirewalls() error {
+	cefs, err := oc.watchFactory.ClusterEgressFirewallInformer().Lister().List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list ClusterEgressFirewalls: %w", err)
+	}
+	existingCEFs := sets.New[string]()
+	for _, cef := range cefs {
+		existingCEFs.Insert(cef.Name)
+	}
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.PortGroupClusterEgressFirewall, oc.controllerName, nil)
+	pgP := libovsdbops.GetPredicate[*nbdb.PortGroup](predicateIDs, func(pg *nbdb.PortGroup) bool {
+		return !existingCEFs.Has(pg.ExternalIDs[libovsdbops.ObjectNameKey.String()])
+	})
+	if err = libovsdbops.DeletePortGroupsWithPredicate(oc.nbClient, pgP); err != nil {
+		return fmt.Errorf("cannot delete stale ClusterEgressFirewall port groups: %w", err)
+	}
+	return nil
+}
+
+func (oc *DefaultNetworkController) syncClusterEgressFirewall(key string) error {
+	_, cefName, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		klog.Errorf("Failed to split meta namespace cache key %s for ClusterEgressFirewall: %v", key, err)
+		return nil
+	}
+	oc.cefLock.Lock()
+	defer oc.cefLock.Unlock()
+	cef, err := oc.watchFactory.ClusterEgressFirewallInformer().Lister().Get(cefName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		klog.Infof("Deleting ClusterEgressFirewall %s", cefName)
+		oc.cefNamespaces.Delete(cefName)
+		pgName := libovsdbutil.GetPortGroupName(oc.getClusterEgressFirewallPortGroupDbIDs(cefName))
+		if err = libovsdbops.DeletePortGroups(oc.nbClient, pgName); err != nil {
+			return fmt.Errorf("failed to delete ClusterEgressFirewall %s port group %s: %w", cefName, pgName, err)
+		}
+		return nil
+	}
+
+	klog.Infof("Adding ClusterEgressFirewall %s", cefName)
+	handlerErr := oc.addClusterEgressFirewall(cef)
+	if statusErr := oc.setClusterEgressFirewallStatus(cef, handlerErr); statusErr != nil {
+		return errors.Join(handlerErr, fmt.Errorf("failed to update ClusterEgressFirewall %s status: %w", cefName, statusErr))
+	}
+	return handlerErr
+}
+
+// addClusterEgressFirewall creates or updates the port group of the given ClusterEgressFirewall with the
+// local pods of the selected namespaces and the ACLs for all its rules. ACLs that are not referenced by the
+// port group anymore are garbage collected by the database.
+func (oc *DefaultNetworkController) addClusterEgressFirewall(cef *egressfirewallapi.ClusterEgressFirewall) error {
+	if cef.Spec.Priority < 0 || cef.Spec.Priority > types.ClusterEgressFirewallMaxSupportedPriority {
+		return fmt.Errorf("ClusterEgressFirewall %s has unsupported priority %d, supported priorities are 0-%d",
+			cef.Name, cef.Spec.Priority, types.ClusterEgressFirewallMaxSupportedPriority)
+	}
+	switch cef.Spec.Placement {
+	case egressfirewallapi.ClusterEgressFirewallPlacementBeforeNamespace, egressfirewallapi.ClusterEgressFirewallPlacementAfterNamespace:
+	default:
+		return fmt.Errorf("ClusterEgressFirewall %s has unsupported placement %q", cef.Name, cef.Spec.Placement)
+	}
+
+	var rules []*egressFirewallRule
+	var errorList []error
+	for i, rawRule := range cef.Spec.Egress {
+		if i >= types.ClusterEgressFirewallMaxRulesPerObject {
+			errorList = append(errorList, fmt.Errorf("ClusterEgressFirewall %s has too many rules, max allowed number is %d",
+				cef.Name, types.ClusterEgressFirewallMaxRulesPerObject))
+			break
+		}
+		if rawRule.To.DNSName != "" {
+			// also rejected by the CRD validation, see the comment at the top of this file
+			errorList = append(errorList, fmt.Errorf("dnsName %s is not supported in ClusterEgressFirewall %s rules",
+				rawRule.To.DNSName, cef.Name))
+			continue
+		}
+		rule, err := oc.newEgressFirewallRule(rawRule, i)
+		if err != nil {
+			errorList = append(errorList, fmt.Errorf("cannot create ClusterEgressFirewall %s rule %d: %w", cef.Name, i, err))
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	if len(errorList) > 0 {
+		return utilerrors.Join(errorList...)
+	}
+
+	namespaces, err := oc.watchFactory.GetNamespacesBySelector(cef.Spec.NamespaceSelector)
+	if err != nil {
+		return fmt.Errorf("failed to get namespaces for ClusterEgressFirewall %s: %w", cef.Name, err)
+	}
+	selectedNamespaces := sets.New[string]()
+	for _, namespace := range namespaces {
+		selectedNamespaces.Insert(namespace.Name)
+	}
+	// store selected namespaces first, so that a namespace that stops matching is always handled
+	oc.cefNamespaces.Store(cef.Name, selectedNamespaces)
+
+	lsps, err := oc.getClusterEgressFirewallLSPs(selectedNamespaces)
+	if err != nil {
+		return fmt.Errorf("failed to get pods for ClusterEgressFirewall %s: %w", cef.Name, err)
+	}
+
+	pgDbIDs := oc.getClusterEgressFirewallPortGroupDbIDs(cef.Name)
+	pgName := libovsdbutil.GetPortGroupName(pgDbIDs)
+	acls := make([]*nbdb.ACL, 0, len(rules))
+	for _, rule := range rules {
+		matchTargets := rule.addressMatchTargets()
+		if len(matchTargets) == 0 {
+			klog.Warningf("ClusterEgressFirewall %s rule %d has no destination...ignoring", cef.Name, rule.id)
+			continue
+		}
+		action := nbdb.ACLActionDrop
+		if rule.access == egressfirewallapi.EgressFirewallRuleAllow {
+			action = nbdb.ACLActionAllow
+		}
+		acl := libovsdbutil.BuildACL(
+			oc.getClusterEgressFirewallACLDbIDs(cef.Name, rule.id),
+			getClusterEgressFirewallACLPriority(cef.Spec.Placement, cef.Spec.Priority, rule.id),
+			generateMatch(pgName, matchTargets, rule.ports),
+			action,
+			nil,
+			// same as EgressFirewall, the ACL has direction to-lport
+			libovsdbutil.LportIngress,
+		)
+		acls = append(acls, acl)
+	}
+
+	ops, err := libovsdbops.CreateOrUpdateACLsOps(oc.nbClient, nil, oc.GetSamplingConfig(), acls...)
+	if err != nil {
+		return fmt.Errorf("failed to create ClusterEgressFirewall %s ACLs: %w", cef.Name, err)
+	}
+	pg := libovsdbutil.BuildPortGroup(pgDbIDs, lsps, acls)
+	ops, err = libovsdbops.CreateOrUpdatePortGroupsOps(oc.nbClient, ops, pg)
+	if err != nil {
+		return fmt.Errorf("failed to create ClusterEgressFirewall %s port group %s: %w", cef.Name, pgName, err)
+	}
+	_, err = libovsdbops.TransactAndCheck(oc.nbClient, ops)
+	if err != nil {
+		return fmt.Errorf("failed to transact ClusterEgressFirewall %s: %w", cef.Name, err)
+	}
+	return nil
+}
+
+// getClusterEgressFirewallLSPs returns the logical switch ports of the local pods in the given namespaces.
+func (oc *DefaultNetworkController) getClusterEgressFirewallLSPs(namespaces sets.Set[string]) ([]*nbdb.LogicalSwitchPort, error) {
+	lsps := []*nbdb.LogicalSwitchPort{}
+	for _, namespace := range sets.List(namespaces) {
+		pods, err := oc.watchFactory.GetPods(namespace)
+		if err != nil {
+			return nil, err
+		}
+		for _, pod := range pods {
+			if !oc.isClusterEgressFirewallPod(pod) {
+				continue
+			}
+			logicalPortName := util.GetLogicalPortName(pod.Namespace, pod.Name)
+			lsp, err := libovsdbops.GetLogicalSwitchPort(oc.nbClient, &nbdb.LogicalSwitchPort{Name: logicalPortName})
+			if err != nil {
+				if errors.Is(err, libovsdbclient.ErrNotFound) {
+					// the pod update reporting its IPs will requeue the ClusterEgressFirewall
+					continue
+				}
+				return nil, fmt.Errorf("error retrieving logical switch port %s: %w", logicalPortName, err)
+			}
+			lsps = append(lsps, lsp)
+		}
+	}
+	return lsps, nil
+}
+
+// isClusterEgressFirewallPod returns true if the logical switch port of the given pod of a selected namespace
+// belongs in the ClusterEgressFirewall port groups.
+func (oc *DefaultNetworkController) isClusterEgressFirewallPod(pod *corev1.Pod) bool {
+	return !util.PodWantsHostNetwork(pod) && !util.PodCompleted(pod) && util.PodScheduled(pod) && oc.isPodScheduledinLocalZone(pod)
+}
+
+// updateClusterEgressFirewallsForPod adds the logical switch port of the given pod to the port groups of the
+// ClusterEgressFirewalls that select its namespace, or removes it when the pod doesn't need it anymore.
+func (oc *DefaultNetworkController) updateClusterEgressFirewallsForPod(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		klog.Errorf("Failed to split meta namespace cache key %s for pod: %v", key, err)
+		return nil
+	}
+	oc.cefLock.Lock()
+	defer oc.cefLock.Unlock()
+
+	pgNames := []string{}
+	oc.cefNamespaces.Range(func(cefName, obj any) bool {
+		if obj.(sets.Set[string]).Has(namespace) {
+			pgNames = append(pgNames, libovsdbutil.GetPortGroupName(oc.getClusterEgressFirewallPortGroupDbIDs(cefName.(string))))
+		}
+		return true
+	})
+	if len(pgNames) == 0 {
+		return nil
+	}
+
+	pod, err := oc.watchFactory.GetPod(namespace, name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	logicalPortName := util.GetLogicalPortName(namespace, name)
+	lsp, err := libovsdbops.GetLogicalSwitchPort(oc.nbClient, &nbdb.LogicalSwitchPort{Name: logicalPortName})
+	if err != nil {
+		if errors.Is(err, libovsdbclient.ErrNotFound) {
+			// the port groups stop referencing a deleted logical switch port, and the pod update reporting its
+			// IPs adds a new one
+			return nil
+		}
+		return fmt.Errorf("error retrieving logical switch port %s: %w", logicalPortName, err)
+	}
+
+	var ops []ovsdb.Operation
+	addPort := pod != nil && oc.isClusterEgressFirewallPod(pod)
+	for _, pgName := range pgNames {
+		if !addPort {
+			ops, err = libovsdbops.DeletePortsFromPortGroupOps(oc.nbClient, ops, pgName, lsp.UUID)
+			if err != nil {
+				return fmt.Errorf("failed to remove port %s from ClusterEgressFirewall port group %s: %w", logicalPortName, pgName, err)
+			}
+			continue
+		}
+		ops, err = libovsdbops.AddPortsToPortGroupOps(oc.nbClient, ops, pgName, lsp.UUID)
+		if err != nil {
+			if errors.Is(err, libovsdbclient.ErrNotFound) {
+				// the ClusterEgressFirewall adds the port when it creates its port group
+				continue
+			}
+			return fmt.Errorf("failed to add port %s to ClusterEgressFirewall port group %s: %w", logicalPortName, pgName, err)
+		}
+	}
+	if _, err = libovsdbops.TransactAndCheck(oc.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to update ClusterEgressFirewall port groups for pod %s: %w", key, err)
+	}
+	return nil
+}
+
+// updateClusterEgressFirewallsForNamespace requeues all ClusterEgressFirewalls that select the given namespace
+// now, or selected it before.
+func (oc *DefaultNetworkController) updateClusterEgressFirewallsForNamespace(namespaceName string) error {
+	namespace, err := oc.watchFactory.GetNamespace(namespaceName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	cefs, err := oc.watchFactory.ClusterEgressFirewallInformer().Lister().List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list ClusterEgressFirewalls: %w", err)
+	}
+	for _, cef := range cefs {
+		if obj, loaded := oc.cefNamespaces.Load(cef.Name); loaded && obj.(sets.Set[string]).Has(namespaceName) {
+			oc.cefController.Reconcile(cef.Name)
+			continue
+		}
+		if namespace == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(&cef.Spec.NamespaceSelector)
+		if err != nil {
+			klog.Errorf("Error while parsing namespace selector of ClusterEgressFirewall %s: %v", cef.Name, err)
+			continue
+		}
+		if selector.Matches(labels.Set(namespace.Labels)) {
+			oc.cefController.Reconcile(cef.Name)
+		}
+	}
+	return nil
+}
+
+// updateClusterEgressFirewallsForNode requeues all ClusterEgressFirewalls that have nodeSelector rules.
+func (oc *DefaultNetworkController) updateClusterEgressFirewallsForNode() error {
+	if oc.cefController == nil {
+		return nil
+	}
+	cefs, err := oc.watchFactory.ClusterEgressFirewallInformer().Lister().List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list ClusterEgressFirewalls: %w", err)
+	}
+	for _, cef := range cefs {
+		if slices.ContainsFunc(cef.Spec.Egress, func(rule egressfirewallapi.EgressFirewallRule) bool {
+			return rule.To.NodeSelector != nil
+		}) {
+			oc.cefController.Reconcile(cef.Name)
+		}
+	}
+	return nil
+}
+
+func (oc *DefaultNetworkController) setClusterEgressFirewallStatus(cef *egressfirewallapi.ClusterEgressFirewall, handlerErr error) error {
+	var newMsg string
+	if handlerErr != nil {
+		newMsg = types.EgressFirewallErrorMsg + ": " + handlerErr.Error()
+	} else {
+		newMsg = types.ClusterEgressFirewallAppliedCorrectly
+	}
+
+	newMsg = types.GetZoneStatus(oc.zone, newMsg)
+	if slices.Contains(cef.Status.Messages, newMsg) {
+		return nil
+	}
+
+	applyOptions := metav1.ApplyOptions{
+		Force:        true,
+		FieldManager: oc.zone,
+	}
+	applyObj := egressfirewallapply.ClusterEgressFirewall(cef.Name).
+		WithStatus(egressfirewallapply.EgressFirewallStatus().
+			WithMessages(newMsg))
+	_, err := oc.kube.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().ApplyStatus(context.TODO(), applyObj, applyOptions)
+	return err
+}
+
+func getClusterEgressFirewallACLPriority(placement egressfirewallapi.ClusterEgressFirewallPlacement, cefPriority int32, ruleIdx int) int {
+	startPriority := types.ClusterEgressFirewallBeforeNamespaceStartPriority
+	if placement == egressfirewallapi.ClusterEgressFirewallPlacementAfterNamespace {
+		startPriority = types.ClusterEgressFirewallAfterNamespaceStartPriority
+	}
+	return startPriority - int(cefPriority)*types.ClusterEgressFirewallMaxRulesPerObject - ruleIdx
+}
+
+func (oc *DefaultNetworkController) getClusterEgressFirewallPortGroupDbIDs(cefName string) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.PortGroupClusterEgressFirewall, oc.controllerName,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: cefName,
+		})
+}
+
+func (oc *DefaultNetworkController) getClusterEgressFirewallACLDbIDs(cefName string, ruleIdx int) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.ACLClusterEgressFirewall, oc.controllerName,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey: cefName,
+			libovsdbops.RuleIndex:     strconv.Itoa(ruleIdx),
+		})
+}
@@ -0,0 +1,300 @@
+package ovn
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/urfave/cli/v2"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	libovsdbclient "github.com/ovn-org/libovsdb/client"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
+	egressfirewallapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	t "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+func newClusterEgressFirewallObject(name string, priority int32, placement egressfirewallapi.ClusterEgressFirewallPlacement,
+	namespaceLabels map[string]string, egressRules []egressfirewallapi.EgressFirewallRule) *egressfirewallapi.ClusterEgressFirewall {
+	return &egressfirewallapi.ClusterEgressFirewall{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: egressfirewallapi.ClusterEgressFirewallSpec{
+			NamespaceSelector: metav1.LabelSelector{MatchLabels: namespaceLabels},
+			Placement:         placement,
+			Priority:          priority,
+			Egress:            egressRules,
+		},
+	}
+}
+
+func getCEFExpectedACL(fakeOVN *FakeOVN, cefName string, placement egressfirewallapi.ClusterEgressFirewallPlacement,
+	priority int32, ruleIdx int, match string, action nbdb.ACLAction) *nbdb.ACL {
+	dbIDs := fakeOVN.controller.getClusterEgressFirewallACLDbIDs(cefName, ruleIdx)
+	acl := libovsdbops.BuildACL(
+		libovsdbutil.GetACLName(dbIDs),
+		nbdb.ACLDirectionToLport,
+		getClusterEgressFirewallACLPriority(placement, priority, ruleIdx),
+		match,
+		action,
+		t.OvnACLLoggingMeter,
+		"",
+		false,
+		dbIDs.GetExternalIDs(),
+		nil,
+		t.DefaultACLTier,
+	)
+	acl.UUID = fmt.Sprintf("%s-%d-UUID", cefName, ruleIdx)
+	return acl
+}
+
+var _ = ginkgo.Describe("OVN ClusterEgressFirewall Operations", func() {
+	var (
+		app         *cli.App
+		fakeOVN     *FakeOVN
+		initialData []libovsdb.TestData
+	)
+	const (
+		cefName  = "cef1"
+		nodeName = "node1"
+	)
+
+	startOvn := func(namespaces []corev1.Namespace, pods []corev1.Pod, cefs []egressfirewallapi.ClusterEgressFirewall) {
+		fakeOVN.startWithDBSetup(libovsdb.TestSetup{NBData: initialData},
+			&egressfirewallapi.ClusterEgressFirewallList{
+				Items: cefs,
+			},
+			&corev1.NamespaceList{
+				Items: namespaces,
+			},
+			&corev1.PodList{
+				Items: pods,
+			},
+		)
+		fakeOVN.controller.localZoneNodes.Store(nodeName, true)
+		err := fakeOVN.controller.WatchNamespaces()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		err = fakeOVN.controller.startClusterEgressFirewallControllers()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		for _, namespace := range namespaces {
+			podIPs := []string{}
+			for _, pod := range pods {
+				if pod.Namespace == namespace.Name {
+					podIPs = append(podIPs, pod.Status.PodIP)
+				}
+			}
+			namespaceASip4, _ := buildNamespaceAddressSets(namespace.Name, podIPs)
+			initialData = append(initialData, namespaceASip4, getNamespacePG(namespace.Name, DefaultNetworkControllerName))
+		}
+	}
+
+	// getExpectedData returns the initial data with the ClusterEgressFirewall port group holding the given
+	// ports and ACLs, or the initial data only if no ACLs are given
+	getExpectedData := func(ports []*nbdb.LogicalSwitchPort, acls ...*nbdb.ACL) []libovsdb.TestData {
+		expectedData := append([]libovsdb.TestData{}, initialData...)
+		if len(acls) == 0 {
+			return expectedData
+		}
+		pg := libovsdbutil.BuildPortGroup(fakeOVN.controller.getClusterEgressFirewallPortGroupDbIDs(cefName), ports, acls)
+		pg.UUID = pg.Name + "-UUID"
+		expectedData = append(expectedData, pg)
+		for _, acl := range acls {
+			expectedData = append(expectedData, acl)
+		}
+		return expectedData
+	}
+
+	getCEFPortGroupName := func() string {
+		return libovsdbutil.GetPortGroupName(fakeOVN.controller.getClusterEgressFirewallPortGroupDbIDs(cefName))
+	}
+
+	// newNodeSwitch returns the node switch holding the logical switch ports of the given pods
+	newNodeSwitch := func(pods ...*corev1.Pod) (*nbdb.LogicalSwitch, []*nbdb.LogicalSwitchPort) {
+		nodeSwitch := &nbdb.LogicalSwitch{UUID: nodeName + "-UUID", Name: nodeName}
+		lsps := []*nbdb.LogicalSwitchPort{}
+		for _, pod := range pods {
+			name := util.GetLogicalPortName(pod.Namespace, pod.Name)
+			lsp := &nbdb.LogicalSwitchPort{UUID: name + "-UUID", Name: name}
+			nodeSwitch.Ports = append(nodeSwitch.Ports, lsp.UUID)
+			lsps = append(lsps, lsp)
+		}
+		return nodeSwitch, lsps
+	}
+
+	ginkgo.BeforeEach(func() {
+		// Restore global default values before each testcase
+		gomega.Expect(config.PrepareTestConfig()).To(gomega.Succeed())
+		config.OVNKubernetesFeature.EnableEgressFirewall = true
+
+		app = cli.NewApp()
+		app.Name = "test"
+		app.Flags = config.Flags
+
+		fakeOVN = NewFakeOVN(false)
+		initialData = []libovsdb.TestData{}
+	})
+
+	ginkgo.AfterEach(func() {
+		if fakeOVN.controller.cefController != nil {
+			controller.Stop(fakeOVN.controller.cefController, fakeOVN.controller.cefNamespaceController,
+				fakeOVN.controller.cefPodController)
+		}
+		fakeOVN.shutdown()
+	})
+
+	ginkgo.It("creates ACLs before the EgressFirewall ones for the pods of selected namespaces and reports status", func() {
+		app.Action = func(*cli.Context) error {
+			namespace1 := *newNamespaceWithLabels("namespace1", map[string]string{"team": "a"})
+			namespace2 := *newNamespaceWithLabels("namespace2", map[string]string{"team": "a"})
+			namespace3 := *newNamespace("namespace3")
+			pod1 := newPod(namespace1.Name, "pod1", nodeName, "10.128.1.3")
+			pod2 := newPod(namespace2.Name, "pod2", nodeName, "10.128.1.4")
+			pod3 := newPod(namespace3.Name, "pod3", nodeName, "10.128.1.5")
+			nodeSwitch, lsps := newNodeSwitch(pod1, pod2, pod3)
+			initialData = append(initialData, nodeSwitch, lsps[0], lsps[1], lsps[2])
+			cef := newClusterEgressFirewallObject(cefName, 5, egressfirewallapi.ClusterEgressFirewallPlacementBeforeNamespace,
+				map[string]string{"team": "a"}, []egressfirewallapi.EgressFirewallRule{
+					{
+						Type: egressfirewallapi.EgressFirewallRuleAllow,
+						To: egressfirewallapi.EgressFirewallDestination{
+							CIDRSelector: "1.2.3.0/24",
+						},
+						Ports: []egressfirewallapi.EgressFirewallPort{
+							{Protocol: "TCP", Port: 443},
+						},
+					},
+					{
+						Type: egressfirewallapi.EgressFirewallRuleDeny,
+						To: egressfirewallapi.EgressFirewallDestination{
+							CIDRSelector: "1.2.0.0/16",
+						},
+					},
+				})
+			startOvn([]corev1.Namespace{namespace1, namespace2, namespace3}, []corev1.Pod{*pod1, *pod2, *pod3},
+				[]egressfirewallapi.ClusterEgressFirewall{*cef})
+
+			src := "inport == @" + getCEFPortGroupName()
+			placement := egressfirewallapi.ClusterEgressFirewallPlacementBeforeNamespace
+			acl0 := getCEFExpectedACL(fakeOVN, cefName, placement, 5, 0, "(ip4.dst == 1.2.3.0/24) && "+src+" && ((tcp && ( tcp.dst == 443 )))",
+				nbdb.ACLActionAllow)
+			acl1 := getCEFExpectedACL(fakeOVN, cefName, placement, 5, 1, "(ip4.dst == 1.2.0.0/16) && "+src,
+				nbdb.ACLActionDrop)
+			gomega.Expect(acl0.Priority).To(gomega.Equal(19550))
+			gomega.Expect(acl0.Priority).To(gomega.BeNumerically(">", t.EgressFirewallStartPriority))
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdb.HaveData(
+				getExpectedData(lsps[:2], acl0, acl1)))
+
+			gomega.Eventually(func() []string {
+				cef, err := fakeOVN.fakeClient.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().Get(context.TODO(), cefName, metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				return cef.Status.Messages
+			}).Should(gomega.ConsistOf(t.GetZoneStatus(fakeOVN.controller.zone, t.ClusterEgressFirewallAppliedCorrectly)))
+			return nil
+		}
+		err := app.Run([]string{app.Name})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	ginkgo.It("updates the port group when a namespace starts being selected or a pod is added or completed, and removes it on delete", func() {
+		app.Action = func(*cli.Context) error {
+			namespace1 := *newNamespaceWithLabels("namespace1", map[string]string{"team": "a"})
+			namespace2 := *newNamespace("namespace2")
+			pod1 := newPod(namespace1.Name, "pod1", nodeName, "10.128.1.3")
+			pod2 := newPod(namespace2.Name, "pod2", nodeName, "10.128.1.4")
+			nodeSwitch, lsps := newNodeSwitch(pod1, pod2)
+			initialData = append(initialData, nodeSwitch, lsps[0], lsps[1])
+			cef := newClusterEgressFirewallObject(cefName, 0, egressfirewallapi.ClusterEgressFirewallPlacementAfterNamespace,
+				map[string]string{"team": "a"}, []egressfirewallapi.EgressFirewallRule{
+					{
+						Type: egressfirewallapi.EgressFirewallRuleDeny,
+						To: egressfirewallapi.EgressFirewallDestination{
+							CIDRSelector: "1.2.3.0/24",
+						},
+					},
+				})
+			startOvn([]corev1.Namespace{namespace1, namespace2}, []corev1.Pod{*pod1, *pod2},
+				[]egressfirewallapi.ClusterEgressFirewall{*cef})
+
+			acl := getCEFExpectedACL(fakeOVN, cefName, egressfirewallapi.ClusterEgressFirewallPlacementAfterNamespace, 0, 0,
+				"(ip4.dst == 1.2.3.0/24) && inport == @"+getCEFPortGroupName(), nbdb.ACLActionDrop)
+			gomega.Expect(acl.Priority).To(gomega.Equal(1999))
+			gomega.Expect(acl.Priority).To(gomega.BeNumerically("<", t.MinimumReservedEgressFirewallPriority))
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdb.HaveData(
+				getExpectedData(lsps[:1], acl)))
+
+			namespace2.Labels["team"] = "a"
+			_, err := fakeOVN.fakeClient.KubeClient.CoreV1().Namespaces().Update(context.TODO(), &namespace2, metav1.UpdateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdb.HaveData(
+				getExpectedData(lsps, acl)))
+
+			// a new pod is added to the port group once its logical switch port exists and it reports its IPs
+			pod3 := newPod(namespace1.Name, "pod3", nodeName, "")
+			pod3.Status.PodIPs = nil
+			pod3, err = fakeOVN.fakeClient.KubeClient.CoreV1().Pods(pod3.Namespace).Create(context.TODO(), pod3, metav1.CreateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			_, podLSPs := newNodeSwitch(pod3)
+			gomega.Expect(libovsdbops.CreateOrUpdateLogicalSwitchPortsOnSwitch(fakeOVN.nbClient,
+				&nbdb.LogicalSwitch{Name: nodeName}, podLSPs[0])).To(gomega.Succeed())
+			pod3.Status.PodIPs = []corev1.PodIP{{IP: "10.128.1.5"}}
+			_, err = fakeOVN.fakeClient.KubeClient.CoreV1().Pods(pod3.Namespace).UpdateStatus(context.TODO(), pod3, metav1.UpdateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Eventually(func() []string {
+				pg, err := libovsdbops.GetPortGroup(fakeOVN.nbClient, &nbdb.PortGroup{Name: getCEFPortGroupName()})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				return pg.Ports
+			}).Should(gomega.HaveLen(3))
+
+			// a completed pod is removed from the port group
+			pod3.Status.Phase = corev1.PodSucceeded
+			_, err = fakeOVN.fakeClient.KubeClient.CoreV1().Pods(pod3.Namespace).UpdateStatus(context.TODO(), pod3, metav1.UpdateOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Eventually(func() []string {
+				pg, err := libovsdbops.GetPortGroup(fakeOVN.nbClient, &nbdb.PortGroup{Name: getCEFPortGroupName()})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				return pg.Ports
+			}).Should(gomega.HaveLen(2))
+
+			err = fakeOVN.fakeClient.EgressFirewallClient.K8sV1().ClusterEgressFirewalls().Delete(context.TODO(), cefName, metav1.DeleteOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Eventually(func() error {
+				_, err := libovsdbops.GetPortGroup(fakeOVN.nbClient, &nbdb.PortGroup{Name: getCEFPortGroupName()})
+				return err
+			}).Should(gomega.MatchError(libovsdbclient.ErrNotFound))
+			return nil
+		}
+		err := app.Run([]string{app.Name})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
+	ginkgo.It("removes port groups of deleted ClusterEgressFirewalls on startup", func() {
+		app.Action = func(*cli.Context) error {
+			fakeOVN.controller = getFakeController(DefaultNetworkControllerName)
+			staleACL := getCEFExpectedACL(fakeOVN, "stale", egressfirewallapi.ClusterEgressFirewallPlacementBeforeNamespace, 0, 0,
+				"ip4.dst == 1.2.3.4/32", nbdb.ACLActionDrop)
+			stalePG := libovsdbutil.BuildPortGroup(fakeOVN.controller.getClusterEgressFirewallPortGroupDbIDs("stale"),
+				nil, []*nbdb.ACL{staleACL})
+			clusterPortGroup := newClusterPortGroup()
+			initialData = append(initialData, staleACL, stalePG, clusterPortGroup)
+
+			startOvn(nil, nil, nil)
+
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdb.HaveData(clusterPortGroup))
+			return nil
+		}
+		err := app.Run([]string{app.Name})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+})
@@ -190,6 +190,18 @@ type routePolicyConfig struct {
 	staticGateways *gateway_info.GatewayInfoList
 	// dynamicGateways contains the processed list of IPs and BFD information defined in the dynamicHop slice in the policy.
 	dynamicGateways *gateway_info.GatewayInfoList
+	// dynamicGatewayPods contains the gateway IPs of every pod selected by the dynamicHop slice in the policy.
+	dynamicGatewayPods map[ktypes.NamespacedName]sets.Set[string]
+}
+
+// routePolicyStatus is the result of the latest policy sync, it is used to update the policy status.
+type routePolicyStatus struct {
+	// gwIPs are the applied gateway IPs
+	gwIPs sets.Set[string]
+	// targetPods is the number of pods the gateways are applied to
+	targetPods int
+	// nextHops are the applied next hops. Zone and BFD state are left for the status updater to fill.
+	nextHops []adminpolicybasedrouteapi.NextHopStatus
 }
 
 type externalPolicyManager struct {
@@ -224,7 +236,7 @@ type externalPolicyManager struct {
 	namespaceLister   corev1listers.NamespaceLister
 	namespaceInformer cache.SharedIndexInformer
 
-	updatePolicyStatusFunc func(policyName string, gwIPs sets.Set[string], processedError error) error
+	updatePolicyStatusFunc func(policyName string, status *routePolicyStatus, processedError error) error
 }
 
 type policyReferencedObjects struct {
@@ -239,7 +251,7 @@ func newExternalPolicyManager(
 	namespaceInformer coreinformers.NamespaceInformer,
 	apbRouteInformer adminpolicybasedrouteinformer.AdminPolicyBasedExternalRouteInformer,
 	netClient networkClient,
-	updatePolicyStatusFunc func(policyName string, gwIPs sets.Set[string], processedError error) error) *externalPolicyManager {
+	updatePolicyStatusFunc func(policyName string, status *routePolicyStatus, processedError error) error) *externalPolicyManager {
 
 	m := externalPolicyManager{
 		stopCh:                      stopCh,
@@ -357,13 +369,13 @@ func (m *externalPolicyManager) processNextPolicyWorkItem(wg *sync.WaitGroup) bo
 	defer m.routeQueue.Done(key)
 
 	klog.V(4).Infof("Processing policy %s", key)
-	gwIPs, err := m.syncRoutePolicy(key)
+	status, err := m.syncRoutePolicy(key)
 	if err != nil {
 		klog.Errorf("Failed to sync APB policy %s: %v", key, err)
 	}
 
 	if m.updatePolicyStatusFunc != nil {
-		statusErr := m.updatePolicyStatusFunc(key, gwIPs, err)
+		statusErr := m.updatePolicyStatusFunc(key, status, err)
 		if statusErr != nil {
 			klog.Warningf("Failed to update AdminPolicyBasedExternalRoutes %s status: %v", key, statusErr)
 		}
@@ -700,7 +712,11 @@ func (m *externalPolicyManager) getPoliciesForPodChange(pod *corev1.Pod) (sets.S
 		if err != nil {
 			return nil, err
 		}
-		if targetNsSel.Matches(labels.Set(podNs.Labels)) {
+		targetPodSel, err := metav1.LabelSelectorAsSelector(&informerPolicy.Spec.From.PodSelector)
+		if err != nil {
+			return nil, err
+		}
+		if targetNsSel.Matches(labels.Set(podNs.Labels)) && targetPodSel.Matches(labels.Set(pod.Labels)) {
 			policyNames.Insert(informerPolicy.Name)
 			continue
 		}
@@ -723,7 +739,7 @@ func (m *externalPolicyManager) getPoliciesForPodChange(pod *corev1.Pod) (sets.S
 	m.policyReferencedObjectsLock.RLock()
 	defer m.policyReferencedObjectsLock.RUnlock()
 	for policyName, policyRefs := range m.policyReferencedObjects {
-		// we don't store target pods, because all pods in the target namespace are affected, check namespace
+		// we don't store target pods, a pod in the target namespace may have been selected before, check namespace
 		if policyRefs.targetNamespaces.Has(podNs.Name) {
 			policyNames.Insert(policyName)
 			continue
@@ -8,6 +8,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
@@ -447,9 +448,57 @@ var _ = Describe("OVN External Gateway pod", func() {
 			eventuallyExpectConfig(dynamicPolicyDiffTargetNSAndPodSel.Name, expectedPolicy2, expectedRefs2)
 		})
 
+		It("updates a target pod to match and then not match the pod selector of a policy", func() {
+			staticPolicy := newPolicy(
+				"static",
+				&metav1.LabelSelector{MatchLabels: targetNamespace2Match},
+				sets.New(staticHopGWIP),
+				nil,
+				nil,
+				false,
+			)
+			staticPolicy.Spec.From.PodSelector = metav1.LabelSelector{MatchLabels: map[string]string{"egress": "external"}}
+			targetPod3 := newPod("pod_target3", namespaceTarget2.Name, "192.169.10.3",
+				map[string]string{"name": "pod_target3", "egress": "external"})
+			initController([]runtime.Object{namespaceTarget2, targetPod2, targetPod3}, []runtime.Object{staticPolicy})
+
+			expectedPolicy, expectedRefs := expectedPolicyStateAndRefs(
+				[]*namespaceWithPods{newNamespaceWithPods(namespaceTarget2.Name, targetPod3)},
+				[]string{staticHopGWIP},
+				nil, false)
+			eventuallyExpectNumberOfPolicies(1)
+			eventuallyExpectConfig(staticPolicy.Name, expectedPolicy, expectedRefs)
+			eventuallyCheckAPBRouteStatusMessage(staticPolicy.Name, "for 1 target pods")
+
+			updatePodLabels(targetPod2, map[string]string{"name": "pod_target2", "egress": "external"}, fakeClient)
+
+			expectedPolicy, expectedRefs = expectedPolicyStateAndRefs(
+				[]*namespaceWithPods{newNamespaceWithPods(namespaceTarget2.Name, targetPod2, targetPod3)},
+				[]string{staticHopGWIP},
+				nil, false)
+			eventuallyExpectConfig(staticPolicy.Name, expectedPolicy, expectedRefs)
+			eventuallyCheckAPBRouteStatusMessage(staticPolicy.Name, "for 2 target pods")
+
+			updatePodLabels(targetPod3, map[string]string{"name": "pod_target3"}, fakeClient)
+
+			expectedPolicy, expectedRefs = expectedPolicyStateAndRefs(
+				[]*namespaceWithPods{newNamespaceWithPods(namespaceTarget2.Name, targetPod2)},
+				[]string{staticHopGWIP},
+				nil, false)
+			eventuallyExpectConfig(staticPolicy.Name, expectedPolicy, expectedRefs)
+			eventuallyCheckAPBRouteStatusMessage(staticPolicy.Name, "for 1 target pods")
+		})
 	})
 })
 
+func eventuallyCheckAPBRouteStatusMessage(policyName, message string) {
+	Eventually(func() []string {
+		pol, err := fakeRouteClient.K8sV1().AdminPolicyBasedExternalRoutes().Get(context.TODO(), policyName, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return pol.Status.Messages
+	}).Should(ConsistOf(ContainSubstring(message)))
+}
+
 func deletePod(pod *corev1.Pod, fakeClient *fake.Clientset) {
 	err = fakeClient.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{})
 	Expect(err).NotTo(HaveOccurred())
@@ -18,12 +18,12 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
-// syncRoutePolicy syncs policy with a given name, returns gwIPS to update policy status and error.
-// gwIPS == nil if the policy was deleted, and empty if error happened.
-func (m *externalPolicyManager) syncRoutePolicy(policyName string) (sets.Set[string], error) {
+// syncRoutePolicy syncs policy with a given name, returns status to update policy status and error.
+// status == nil if the policy was deleted, and empty if error happened.
+func (m *externalPolicyManager) syncRoutePolicy(policyName string) (*routePolicyStatus, error) {
 	var updatedPolicyObj *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute
-	// gwIPs are used to update policy status with the latest applied config
-	gwIPs := sets.New[string]()
+	// status is used to update policy status with the latest applied config
+	status := &routePolicyStatus{gwIPs: sets.New[string]()}
 	// 1. Take a lock on the existing policy state, as we are going to use it for cleanup and update.
 	// 2. Build latest policy config "updatedPolicy". This includes listing referenced namespaces and pods.
 	// To make sure there is no race with pod and namespace handlers, policyReferencedObjectsLock is acquired
@@ -33,8 +33,8 @@ func (m *externalPolicyManager) syncRoutePolicy(policyName string) (sets.Set[str
 	// 3. Pass existing policy config "existingPolicy" and latest policy config "updatedPolicy" to updateRoutePolicy
 	// function, that will apply "updatedPolicy" config to the "existingPolicy" and update "existingPolicy"
 	// status for every applied change.
-	// 4. On success, return applied ips from the updatedPolicy and delete policy from the cache
-	return gwIPs, m.routePolicySyncCache.DoWithLock(policyName, func(policyName string) error {
+	// 4. On success, return applied config status from the updatedPolicy and delete policy from the cache
+	err := m.routePolicySyncCache.DoWithLock(policyName, func(policyName string) error {
 
 		var err error
 		updatedPolicyObj, err = m.routeLister.Get(policyName)
@@ -72,18 +72,63 @@ func (m *externalPolicyManager) syncRoutePolicy(policyName string) (sets.Set[str
 		}
 		if updatedPolicy == nil {
 			m.routePolicySyncCache.Delete(policyName)
-			gwIPs = nil
+			status = nil
 		} else {
-			// update was successful, return ips from updatedPolicy, since existingPolicy will have the same config.
-			for _, static := range updatedPolicy.staticGateways.Elems() {
-				insertSet(gwIPs, static.Gateways)
-			}
-			for _, dynamic := range updatedPolicy.dynamicGateways.Elems() {
-				insertSet(gwIPs, dynamic.Gateways)
-			}
+			// update was successful, return status from updatedPolicy, since existingPolicy will have the same config.
+			status = updatedPolicy.status()
 		}
 		return nil
 	})
+	return status, err
+}
+
+// status returns the routePolicyStatus of the policy config once applied.
+func (c *routePolicyConfig) status() *routePolicyStatus {
+	status := &routePolicyStatus{gwIPs: sets.New[string]()}
+	for _, pods := range c.targetNamespacesWithPods {
+		status.targetPods += len(pods)
+	}
+	servedNamespaces := int32(len(c.targetNamespacesWithPods))
+	nextHops := map[string]adminpolicybasedrouteapi.NextHopStatus{}
+	for _, static := range c.staticGateways.Elems() {
+		insertSet(status.gwIPs, static.Gateways)
+		for ip := range static.Gateways {
+			nextHops[ip] = adminpolicybasedrouteapi.NextHopStatus{
+				IP:               ip,
+				Source:           adminpolicybasedrouteapi.StaticNextHopSource,
+				BFDEnabled:       static.BFDEnabled,
+				ServedNamespaces: servedNamespaces,
+			}
+		}
+	}
+	for _, dynamic := range c.dynamicGateways.Elems() {
+		insertSet(status.gwIPs, dynamic.Gateways)
+	}
+	for podName, podGWs := range c.dynamicGatewayPods {
+		for ip := range podGWs {
+			if _, found := nextHops[ip]; found {
+				// static hops take precedence, the route is the same
+				continue
+			}
+			nextHop := adminpolicybasedrouteapi.NextHopStatus{
+				IP:               ip,
+				Source:           adminpolicybasedrouteapi.DynamicPodNextHopSource,
+				Pod:              podName.String(),
+				ServedNamespaces: servedNamespaces,
+			}
+			for _, dynamic := range c.dynamicGateways.Elems() {
+				if dynamic.Has(ip) {
+					nextHop.BFDEnabled = dynamic.BFDEnabled
+					break
+				}
+			}
+			nextHops[ip] = nextHop
+		}
+	}
+	for _, ip := range sets.List(sets.KeySet(nextHops)) {
+		status.nextHops = append(status.nextHops, nextHops[ip])
+	}
+	return status
 }
 
 // updateRoutePolicy cleans up stale gateways that are present in the existingPolicy, but not in the updatedPolicy.
@@ -336,10 +381,10 @@ func (m *externalPolicyManager) processStaticHopsGatewayInformation(hops []*admi
 }
 
 func (m *externalPolicyManager) processDynamicHopsGatewayInformation(hops []*adminpolicybasedrouteapi.DynamicHop) (*gateway_info.GatewayInfoList,
-	sets.Set[string], sets.Set[ktypes.NamespacedName], error) {
+	sets.Set[string], map[ktypes.NamespacedName]sets.Set[string], error) {
 	podsInfo := gateway_info.NewGatewayInfoList()
 	selectedNamespaces := sets.Set[string]{}
-	selectedPods := sets.Set[ktypes.NamespacedName]{}
+	selectedPods := map[ktypes.NamespacedName]sets.Set[string]{}
 	for _, h := range hops {
 		gwNsSel, err := metav1.LabelSelectorAsSelector(&h.NamespaceSelector)
 		if err != nil {
@@ -371,7 +416,10 @@ func (m *externalPolicyManager) processDynamicHopsGatewayInformation(hops []*adm
 				}
 				key := ktypes.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
 				podsInfo.InsertOverwrite(gateway_info.NewGatewayInfo(foundGws, h.BFDEnabled))
-				selectedPods.Insert(key)
+				if selectedPods[key] == nil {
+					selectedPods[key] = sets.New[string]()
+				}
+				insertSet(selectedPods[key], foundGws)
 			}
 			selectedNamespaces.Insert(gwNamespace.Name)
 		}
@@ -408,12 +456,16 @@ func (m *externalPolicyManager) getPolicyConfigAndUpdatePolicyRefs(policy *admin
 	if err != nil {
 		return nil, fmt.Errorf("failed to list target namespaces: %w", err)
 	}
+	targetPodSel, err := metav1.LabelSelectorAsSelector(&policy.Spec.From.PodSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert target pod selector: %w", err)
+	}
 
 	targetNsNames := sets.Set[string]{}
 	targetNamespaces := map[string]map[ktypes.NamespacedName]*corev1.Pod{}
 	for _, ns := range targetNs {
 		targetNsNames.Insert(ns.Name)
-		targetPods, err := m.podLister.Pods(ns.Name).List(labels.Everything())
+		targetPods, err := m.podLister.Pods(ns.Name).List(targetPodSel)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get all ns %s pods: %v", ns.Name, err)
 		}
@@ -442,7 +494,7 @@ func (m *externalPolicyManager) getPolicyConfigAndUpdatePolicyRefs(policy *admin
 		refObjs := &policyReferencedObjects{
 			targetNamespaces:    targetNsNames,
 			dynamicGWNamespaces: gwNamespaces,
-			dynamicGWPods:       gwPods,
+			dynamicGWPods:       sets.KeySet(gwPods),
 		}
 		m.policyReferencedObjects[policy.Name] = refObjs
 	}
@@ -452,6 +504,7 @@ func (m *externalPolicyManager) getPolicyConfigAndUpdatePolicyRefs(policy *admin
 		targetNamespacesWithPods: targetNamespaces,
 		staticGateways:           staticGWInfo,
 		dynamicGateways:          dynamicGWInfo,
+		dynamicGatewayPods:       gwPods,
 	}, nil
 }
 
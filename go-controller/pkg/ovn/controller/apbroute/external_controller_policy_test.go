@@ -21,6 +21,7 @@ import (
 	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
 	adminpolicybasedrouteclient "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/clientset/versioned/fake"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
@@ -356,6 +357,63 @@ var _ = Describe("OVN External Gateway policy", func() {
 			eventuallyExpectConfig(policyName, expectedPolicy, expectedRefs)
 		})
 
+		It("reports the state of the next hops in the policy status", func() {
+			bfdPolicy := newPolicy("bfd",
+				&metav1.LabelSelector{MatchLabels: targetNamespace1Match},
+				sets.New(staticHopGWIP),
+				&metav1.LabelSelector{MatchLabels: gatewayNamespaceMatch},
+				&metav1.LabelSelector{MatchLabels: map[string]string{"duplicated": "true"}},
+				true,
+			)
+			initController([]runtime.Object{namespaceGW, namespaceTarget, targetPod1, pod1}, []runtime.Object{bfdPolicy})
+			eventuallyExpectNumberOfPolicies(1)
+
+			expectedNextHops := func(staticState, dynamicState adminpolicybasedrouteapi.BFDStateType) []adminpolicybasedrouteapi.NextHopStatus {
+				return []adminpolicybasedrouteapi.NextHopStatus{
+					{
+						Zone:             "single-zone",
+						IP:               staticHopGWIP,
+						Source:           adminpolicybasedrouteapi.StaticNextHopSource,
+						BFDEnabled:       true,
+						BFDState:         staticState,
+						ServedNamespaces: 1,
+					},
+					{
+						Zone:             "single-zone",
+						IP:               "192.168.10.1",
+						Source:           adminpolicybasedrouteapi.DynamicPodNextHopSource,
+						Pod:              "gateway/pod_1",
+						BFDEnabled:       true,
+						BFDState:         dynamicState,
+						ServedNamespaces: 1,
+					},
+				}
+			}
+			getStatus := func() adminpolicybasedrouteapi.AdminPolicyBasedRouteStatus {
+				pol, err := fakeRouteClient.K8sV1().AdminPolicyBasedExternalRoutes().Get(context.TODO(), bfdPolicy.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				return pol.Status
+			}
+			Eventually(func() []adminpolicybasedrouteapi.NextHopStatus { return getStatus().NextHops }).Should(
+				ConsistOf(expectedNextHops(adminpolicybasedrouteapi.BFDStateUnknown, adminpolicybasedrouteapi.BFDStateUnknown)))
+			messages := getStatus().Messages
+
+			// the BFD session with the static hop comes up
+			bfds, err := libovsdbops.FindBFDsWithPredicate(nbClient, func(item *nbdb.BFD) bool { return item.DstIP == staticHopGWIP })
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bfds).To(HaveLen(1))
+			bfds[0].Status = &nbdb.BFDStatusUp
+			ops, err := libovsdbops.CreateOrUpdateBFDOps(nbClient, nil, bfds[0])
+			Expect(err).NotTo(HaveOccurred())
+			_, err = libovsdbops.TransactAndCheck(nbClient, ops)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(func() []adminpolicybasedrouteapi.NextHopStatus { return getStatus().NextHops }).Should(
+				ConsistOf(expectedNextHops(adminpolicybasedrouteapi.BFDStateUp, adminpolicybasedrouteapi.BFDStateUnknown)))
+			// the BFD state is only reported in the next hops
+			Expect(getStatus().Messages).To(Equal(messages))
+		})
+
 		It("registers a second policy with no overlaping IPs", func() {
 			initController([]runtime.Object{namespaceGW, namespaceTarget, targetPod1, namespaceTarget2, targetPod2, pod1},
 				[]runtime.Object{staticPolicy, dynamicPolicy})
@@ -4,23 +4,31 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"reflect"
+	"slices"
+	"sort"
 	"strings"
 	"sync"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
 
+	libovsdbcache "github.com/ovn-org/libovsdb/cache"
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
+	"github.com/ovn-org/libovsdb/model"
 
 	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
 	adminpolicybasedrouteapply "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/applyconfiguration/adminpolicybasedroute/v1"
 	adminpolicybasedrouteclient "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/clientset/versioned"
 	adminpolicybasedrouteinformer "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/informers/externalversions/adminpolicybasedroute/v1"
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -87,6 +95,9 @@ func NewExternalMasterController(
 func (c *ExternalGatewayMasterController) Run(wg *sync.WaitGroup, threadiness int) error {
 	klog.V(4).Info("Starting Admin Policy Based Route Controller")
 
+	c.nbClient.nbClient.Cache().AddEventHandler(&libovsdbcache.EventHandlerFuncs{
+		UpdateFunc: c.onBFDUpdate,
+	})
 	return c.mgr.Run(wg, threadiness)
 }
 
@@ -104,9 +115,9 @@ func (c *ExternalGatewayMasterController) GetAdminPolicyBasedExternalRouteIPsFor
 }
 
 // updateStatusAPBExternalRoute updates the CR with the current status of the CR instance, including errors captured while processing the CR during its lifetime
-func (c *ExternalGatewayMasterController) updateStatusAPBExternalRoute(policyName string, gwIPs sets.Set[string],
+func (c *ExternalGatewayMasterController) updateStatusAPBExternalRoute(policyName string, status *routePolicyStatus,
 	syncError error) error {
-	if gwIPs == nil {
+	if status == nil {
 		// policy doesn't exist anymore, nothing to do
 		return nil
 	}
@@ -121,20 +132,31 @@ func (c *ExternalGatewayMasterController) updateStatusAPBExternalRoute(policyNam
 		}
 		return err
 	}
-	newMsg := fmt.Sprintf("configured external gateway IPs: %s", strings.Join(sets.List(gwIPs), ","))
+	nextHops := make([]adminpolicybasedrouteapi.NextHopStatus, 0, len(status.nextHops))
+	for _, nextHop := range status.nextHops {
+		nextHop.Zone = c.zoneID
+		if nextHop.BFDEnabled {
+			nextHop.BFDState, err = c.nbClient.getBFDState(nextHop.IP)
+			if err != nil {
+				return err
+			}
+		}
+		nextHops = append(nextHops, nextHop)
+	}
+	newMsg := fmt.Sprintf("configured external gateway IPs: %s for %d target pods", strings.Join(sets.List(status.gwIPs), ","), status.targetPods)
 	if syncError != nil {
 		newMsg = fmt.Sprintf("%s %s: %v", c.zoneID, types.APBRouteErrorMsg, syncError.Error())
 	}
 	newMsg = types.GetZoneStatus(c.zoneID, newMsg)
-	needsUpdate := true
-	for _, message := range routePolicy.Status.Messages {
-		if message == newMsg {
-			// found previous status
-			needsUpdate = false
-			break
+	var zoneNextHops []adminpolicybasedrouteapi.NextHopStatus
+	for _, nextHop := range routePolicy.Status.NextHops {
+		if nextHop.Zone == c.zoneID {
+			zoneNextHops = append(zoneNextHops, nextHop)
 		}
 	}
-	if !needsUpdate {
+	if slices.Contains(routePolicy.Status.Messages, newMsg) &&
+		equality.Semantic.DeepEqual(sortedNextHops(zoneNextHops), sortedNextHops(nextHops)) {
+		// found previous status
 		return nil
 	}
 
@@ -142,10 +164,25 @@ func (c *ExternalGatewayMasterController) updateStatusAPBExternalRoute(policyNam
 		Force:        true,
 		FieldManager: c.zoneID,
 	}
+	applyStatus := adminpolicybasedrouteapply.AdminPolicyBasedRouteStatus().
+		WithMessages(newMsg).
+		WithLastTransitionTime(metav1.Now())
+	for _, nextHop := range nextHops {
+		applyNextHop := adminpolicybasedrouteapply.NextHopStatus().
+			WithZone(nextHop.Zone).
+			WithIP(nextHop.IP).
+			WithSource(nextHop.Source).
+			WithServedNamespaces(nextHop.ServedNamespaces)
+		if nextHop.Pod != "" {
+			applyNextHop.WithPod(nextHop.Pod)
+		}
+		if nextHop.BFDEnabled {
+			applyNextHop.WithBFDEnabled(true).WithBFDState(nextHop.BFDState)
+		}
+		applyStatus.WithNextHops(applyNextHop)
+	}
 	applyObj := adminpolicybasedrouteapply.AdminPolicyBasedExternalRoute(policyName).
-		WithStatus(adminpolicybasedrouteapply.AdminPolicyBasedRouteStatus().
-			WithMessages(newMsg).
-			WithLastTransitionTime(metav1.Now()))
+		WithStatus(applyStatus)
 	_, err = c.apbRoutePolicyClient.K8sV1().AdminPolicyBasedExternalRoutes().ApplyStatus(context.TODO(), applyObj, applyOptions)
 
 	if err != nil {
@@ -154,6 +191,52 @@ func (c *ExternalGatewayMasterController) updateStatusAPBExternalRoute(policyNam
 	return nil
 }
 
+// sortedNextHops returns the given next hops sorted by IP, empty if there are none.
+func sortedNextHops(nextHops []adminpolicybasedrouteapi.NextHopStatus) []adminpolicybasedrouteapi.NextHopStatus {
+	sorted := make([]adminpolicybasedrouteapi.NextHopStatus, len(nextHops))
+	copy(sorted, nextHops)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].IP < sorted[j].IP })
+	return sorted
+}
+
+// onBFDUpdate enqueues the policies with a BFD enabled next hop to the BFD session destination when the state of
+// the session changes, so that their status reflects it.
+func (c *ExternalGatewayMasterController) onBFDUpdate(table string, old, new model.Model) {
+	if table != nbdb.BFDTable {
+		return
+	}
+	oldBFD, newBFD := old.(*nbdb.BFD), new.(*nbdb.BFD)
+	if reflect.DeepEqual(oldBFD.Status, newBFD.Status) {
+		return
+	}
+	policies, err := c.mgr.routeLister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("Failed to list AdminPolicyBasedExternalRoutes on BFD %s update: %v", newBFD.DstIP, err)
+		return
+	}
+	for _, policy := range policies {
+		if c.hasBFDEnabledNextHop(policy, newBFD.DstIP) {
+			c.mgr.routeQueue.Add(policy.Name)
+		}
+	}
+}
+
+// hasBFDEnabledNextHop returns true if the policy has a BFD enabled next hop with the given IP in the current zone.
+// The IPs of the dynamic hops are taken from the status, that lists the next hops applied by the latest sync.
+func (c *ExternalGatewayMasterController) hasBFDEnabledNextHop(policy *adminpolicybasedrouteapi.AdminPolicyBasedExternalRoute, ip string) bool {
+	for _, hop := range policy.Spec.NextHops.StaticHops {
+		if hop.BFDEnabled && hop.IP == ip {
+			return true
+		}
+	}
+	for _, nextHop := range policy.Status.NextHops {
+		if nextHop.Zone == c.zoneID && nextHop.BFDEnabled && nextHop.IP == ip {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *ExternalGatewayMasterController) GetDynamicGatewayIPsForTargetNamespace(namespaceName string) (sets.Set[string], error) {
 	return c.mgr.getDynamicGatewayIPsForTargetNamespace(namespaceName)
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/ovn-org/libovsdb/ovsdb"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	adminpolicybasedrouteapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1"
 	adminpolicybasedroutelisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/adminpolicybasedroute/v1/apis/listers/adminpolicybasedroute/v1"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
@@ -635,6 +636,49 @@ func (nb *northBoundClient) lookupBFDEntry(gatewayIP, gatewayRouter, prefix stri
 	return found, nil
 }
 
+// bfdStateSeverity ranks the BFD states from the best to the worst.
+var bfdStateSeverity = map[adminpolicybasedrouteapi.BFDStateType]int{
+	adminpolicybasedrouteapi.BFDStateUp:        0,
+	adminpolicybasedrouteapi.BFDStateUnknown:   1,
+	adminpolicybasedrouteapi.BFDStateInit:      2,
+	adminpolicybasedrouteapi.BFDStateAdminDown: 3,
+	adminpolicybasedrouteapi.BFDStateDown:      4,
+}
+
+// getBFDState returns the state of the BFD sessions established with the given gateway IP by the gateway routers of
+// the zone. When there are several sessions, the worst state is returned.
+func (nb *northBoundClient) getBFDState(gatewayIP string) (adminpolicybasedrouteapi.BFDStateType, error) {
+	bfds, err := libovsdbops.FindBFDsWithPredicate(nb.nbClient, func(item *nbdb.BFD) bool {
+		return item.DstIP == gatewayIP
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to find BFD entries for gateway IP %s: %w", gatewayIP, err)
+	}
+	if len(bfds) == 0 {
+		return adminpolicybasedrouteapi.BFDStateUnknown, nil
+	}
+	worst := adminpolicybasedrouteapi.BFDStateUp
+	for _, bfd := range bfds {
+		state := adminpolicybasedrouteapi.BFDStateUnknown
+		if bfd.Status != nil {
+			switch *bfd.Status {
+			case nbdb.BFDStatusUp:
+				state = adminpolicybasedrouteapi.BFDStateUp
+			case nbdb.BFDStatusInit:
+				state = adminpolicybasedrouteapi.BFDStateInit
+			case nbdb.BFDStatusAdminDown:
+				state = adminpolicybasedrouteapi.BFDStateAdminDown
+			case nbdb.BFDStatusDown:
+				state = adminpolicybasedrouteapi.BFDStateDown
+			}
+		}
+		if bfdStateSeverity[state] > bfdStateSeverity[worst] {
+			worst = state
+		}
+	}
+	return worst, nil
+}
+
 // buildPodSNAT builds per pod SNAT rules towards the nodeIP that are applied to the GR where the pod resides
 // if allSNATs flag is set, then all the SNATs (including against egressIPs if any) for that pod will be returned
 func buildPodSNAT(extIPs, podIPNets []*net.IPNet) ([]*nbdb.NAT, error) {
@@ -3,12 +3,14 @@ package networkqos
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 
 	corev1 "k8s.io/api/core/v1"
+	knet "k8s.io/api/networking/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -109,14 +111,44 @@ func (c *Controller) ensureNetworkQos(nqos *networkqosapi.NetworkQoS) error {
 		}
 	}
 
-	// set EgressRules to desiredNQOSState
+	// set EgressRules and IngressRules to desiredNQOSState
+	var err error
+	if desiredNQOSState.EgressRules, err = buildGressRules(nqos.Spec.Priority, knet.PolicyTypeEgress, nqos.Spec.Egress); err != nil {
+		return err
+	}
+	if desiredNQOSState.IngressRules, err = buildGressRules(nqos.Spec.Priority, knet.PolicyTypeIngress, nqos.Spec.Ingress); err != nil {
+		return err
+	}
+	if err := desiredNQOSState.initAddressSets(c.addressSetFactory, c.controllerName); err != nil {
+		return err
+	}
+	if err := c.resyncPods(desiredNQOSState); err != nil {
+		return fmt.Errorf("failed to resync pods: %w", err)
+	}
+	// delete stale rules left from previous NetworkQoS definition, along with the address sets
+	if err := c.cleanupStaleOvnObjects(desiredNQOSState); err != nil {
+		return fmt.Errorf("failed to delete stale QoSes: %w", err)
+	}
+	c.nqosCache.Store(joinMetaNamespaceAndName(nqos.Namespace, nqos.Name), desiredNQOSState)
+	if e := c.updateNQOSStatusToReady(nqos.Namespace, nqos.Name); e != nil {
+		return fmt.Errorf("successfully reconciled NetworkQoS %s/%s, but failed to patch status: %v", nqos.Namespace, nqos.Name, e)
+	}
+	return nil
+}
+
+// buildGressRules converts the given egress or ingress rules of a NetworkQoS into
+// their GressRule representation. The classifier's peers are taken from `to` for
+// egress rules and from `from` for ingress rules.
+func buildGressRules(qosPriority int, direction knet.PolicyType, ruleSpecs []networkqosapi.Rule) ([]*GressRule, error) {
 	rules := []*GressRule{}
-	for index, ruleSpec := range nqos.Spec.Egress {
+	for index, ruleSpec := range ruleSpecs {
 		bwRate := int(ruleSpec.Bandwidth.Rate)
 		bwBurst := int(ruleSpec.Bandwidth.Burst)
 		ruleState := &GressRule{
-			Priority: getQoSRulePriority(nqos.Spec.Priority, index),
-			Dscp:     ruleSpec.DSCP,
+			Direction: direction,
+			Index:     index,
+			Priority:  getQoSRulePriority(direction, qosPriority, index),
+			Dscp:      ruleSpec.DSCP,
 		}
 		if bwRate > 0 {
 			ruleState.Rate = &bwRate
@@ -124,23 +156,27 @@ func (c *Controller) ensureNetworkQos(nqos *networkqosapi.NetworkQoS) error {
 		if bwBurst > 0 {
 			ruleState.Burst = &bwBurst
 		}
+		peers := ruleSpec.Classifier.To
+		if direction == knet.PolicyTypeIngress {
+			peers = ruleSpec.Classifier.From
+		}
 		destStates := []*Destination{}
-		for _, destSpec := range ruleSpec.Classifier.To {
+		for _, destSpec := range peers {
 			if destSpec.IPBlock != nil && (destSpec.PodSelector != nil || destSpec.NamespaceSelector != nil) {
-				return fmt.Errorf("specifying both ipBlock and podSelector/namespaceSelector is not allowed")
+				return nil, fmt.Errorf("specifying both ipBlock and podSelector/namespaceSelector is not allowed")
 			}
 			destState := &Destination{}
 			destState.IpBlock = destSpec.IPBlock.DeepCopy()
 			if destSpec.NamespaceSelector != nil && (len(destSpec.NamespaceSelector.MatchLabels) > 0 || len(destSpec.NamespaceSelector.MatchExpressions) > 0) {
 				if selector, err := metav1.LabelSelectorAsSelector(destSpec.NamespaceSelector); err != nil {
-					return fmt.Errorf("error parsing destination namespace selector: %v", err)
+					return nil, fmt.Errorf("error parsing %s peer namespace selector: %v", strings.ToLower(string(direction)), err)
 				} else {
 					destState.NamespaceSelector = selector
 				}
 			}
 			if destSpec.PodSelector != nil && (len(destSpec.PodSelector.MatchLabels) > 0 || len(destSpec.PodSelector.MatchExpressions) > 0) {
 				if selector, err := metav1.LabelSelectorAsSelector(destSpec.PodSelector); err != nil {
-					return fmt.Errorf("error parsing destination pod selector: %v", err)
+					return nil, fmt.Errorf("error parsing %s peer pod selector: %v", strings.ToLower(string(direction)), err)
 				} else {
 					destState.PodSelector = selector
 				}
@@ -153,22 +189,7 @@ func (c *Controller) ensureNetworkQos(nqos *networkqosapi.NetworkQoS) error {
 		ruleState.Classifier.Ports = ruleSpec.Classifier.Ports
 		rules = append(rules, ruleState)
 	}
-	desiredNQOSState.EgressRules = rules
-	if err := desiredNQOSState.initAddressSets(c.addressSetFactory, c.controllerName); err != nil {
-		return err
-	}
-	if err := c.resyncPods(desiredNQOSState); err != nil {
-		return fmt.Errorf("failed to resync pods: %w", err)
-	}
-	// delete stale rules left from previous NetworkQoS definition, along with the address sets
-	if err := c.cleanupStaleOvnObjects(desiredNQOSState); err != nil {
-		return fmt.Errorf("failed to delete stale QoSes: %w", err)
-	}
-	c.nqosCache.Store(joinMetaNamespaceAndName(nqos.Namespace, nqos.Name), desiredNQOSState)
-	if e := c.updateNQOSStatusToReady(nqos.Namespace, nqos.Name); e != nil {
-		return fmt.Errorf("successfully reconciled NetworkQoS %s/%s, but failed to patch status: %v", nqos.Namespace, nqos.Name, e)
-	}
-	return nil
+	return rules, nil
 }
 
 // clearNetworkQos will handle the logic for deleting all db objects related
@@ -72,8 +72,8 @@ func (c *Controller) getNetworkQosForNamespaceChange(eventData *eventData[*corev
 			networkQoSes.Insert(joinMetaNamespaceAndName(nqos.Namespace, nqos.Name))
 			continue
 		}
-		// check if any egress rule matches the namespace, or ns label change affects the egress selection
-		if namespaceMatchesEgressRule(ns, nqos) || egressSelectionChanged(nqos, eventData.new, eventData.old) {
+		// check if any egress or ingress rule matches the namespace, or ns label change affects the peer selection
+		if namespaceMatchesPeerRule(ns, nqos) || peerSelectionChanged(nqos, eventData.new, eventData.old) {
 			networkQoSes.Insert(joinMetaNamespaceAndName(nqos.Namespace, nqos.Name))
 		}
 	}
@@ -117,15 +117,15 @@ func namespaceMatchesNetworkSelector(namespace *corev1.Namespace, nqos *nqosv1al
 	return false
 }
 
-func namespaceMatchesEgressRule(namespace *corev1.Namespace, nqos *nqosv1alpha1.NetworkQoS) bool {
-	for _, egress := range nqos.Spec.Egress {
-		for _, dest := range egress.Classifier.To {
+func namespaceMatchesPeerRule(namespace *corev1.Namespace, nqos *nqosv1alpha1.NetworkQoS) bool {
+	for _, rule := range allRules(nqos) {
+		for _, dest := range rulePeers(&rule) {
 			if dest.NamespaceSelector == nil || dest.NamespaceSelector.Size() == 0 {
 				// namespace selector is empty, match all
 				return true
 			}
 			if ls, err := metav1.LabelSelectorAsSelector(dest.NamespaceSelector); err != nil {
-				klog.Errorf("%s/%s - failed to convert egress namespace selector %s: %v", nqos.Namespace, nqos.Name, dest.NamespaceSelector.String(), err)
+				klog.Errorf("%s/%s - failed to convert peer namespace selector %s: %v", nqos.Namespace, nqos.Name, dest.NamespaceSelector.String(), err)
 			} else if ls != nil && ls.Matches(labels.Set(namespace.Labels)) {
 				return true
 			}
@@ -166,9 +166,9 @@ func networkSelectionChanged(nqos *nqosv1alpha1.NetworkQoS, new *corev1.Namespac
 	return false
 }
 
-func egressSelectionChanged(nqos *nqosv1alpha1.NetworkQoS, new *corev1.Namespace, old *corev1.Namespace) bool {
-	for _, egress := range nqos.Spec.Egress {
-		for _, dest := range egress.Classifier.To {
+func peerSelectionChanged(nqos *nqosv1alpha1.NetworkQoS, new *corev1.Namespace, old *corev1.Namespace) bool {
+	for _, rule := range allRules(nqos) {
+		for _, dest := range rulePeers(&rule) {
 			if dest.NamespaceSelector == nil || dest.NamespaceSelector.Size() == 0 {
 				// empty namespace selector won't make difference
 				continue
@@ -9,6 +9,8 @@ import (
 	libovsdbclient "github.com/ovn-org/libovsdb/client"
 	"github.com/ovn-org/libovsdb/ovsdb"
 
+	knet "k8s.io/api/networking/v1"
+
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
@@ -35,8 +37,8 @@ func (c *Controller) addQoSToLogicalSwitch(qosState *networkQoSState, switchName
 	// construct qoses
 	qoses := []*nbdb.QoS{}
 	ipv4Enabled, ipv6Enabled := c.IPMode()
-	for index, rule := range qosState.EgressRules {
-		dbIDs := qosState.getDbObjectIDs(c.controllerName, index)
+	for _, rule := range qosState.gressRules() {
+		dbIDs := qosState.getDbObjectIDs(c.controllerName, rule.Direction, rule.Index)
 		qos := &nbdb.QoS{
 			Action:      map[string]int{},
 			Bandwidth:   map[string]int{},
@@ -120,12 +122,16 @@ func (c *Controller) cleanupStaleOvnObjects(qosState *networkQoSState) error {
 		return fmt.Errorf("error looking up existing QoSes for %s/%s: %v", qosState.namespace, qosState.name, err)
 	}
 	staleSwitchQoSMap := map[string][]*nbdb.QoS{}
-	totalNumOfRules := len(qosState.EgressRules)
+	totalNumOfRules := map[string]int{
+		string(knet.PolicyTypeEgress):  len(qosState.EgressRules),
+		string(knet.PolicyTypeIngress): len(qosState.IngressRules),
+	}
 	for _, qos := range existingQoSes {
 		index := qos.ExternalIDs[libovsdbops.RuleIndex.String()]
+		direction := qos.ExternalIDs[libovsdbops.PolicyDirectionKey.String()]
 		numIndex, convError := strconv.Atoi(index)
 		indexWithinRange := false
-		if index != "" && convError == nil && numIndex < totalNumOfRules {
+		if index != "" && convError == nil && numIndex < totalNumOfRules[direction] {
 			// rule index is valid
 			indexWithinRange = true
 		}
@@ -71,7 +71,8 @@ func TestNetworkQoS(t *testing.T) {
 	RunSpecs(t, "NetworkQoS Controller")
 }
 
-func tableEntrySetup(enableInterconnect bool) {
+// tableEntrySetup starts the controllers, node1QoSes are added to the node1 switch before the start
+func tableEntrySetup(enableInterconnect bool, node1QoSes ...*nbdb.QoS) {
 	config.OVNKubernetesFeature.EnableInterconnect = enableInterconnect
 
 	ns0 := &corev1.Namespace{
@@ -244,11 +245,12 @@ func tableEntrySetup(enableInterconnect bool) {
 		"name": "stream",
 	}
 
+	node1Switch := &nbdb.LogicalSwitch{
+		Name: "node1",
+	}
 	initialDB := &libovsdbtest.TestSetup{
 		NBData: []libovsdbtest.TestData{
-			&nbdb.LogicalSwitch{
-				Name: "node1",
-			},
+			node1Switch,
 			&nbdb.LogicalSwitch{
 				Name: "node2",
 			},
@@ -257,6 +259,10 @@ func tableEntrySetup(enableInterconnect bool) {
 			},
 		},
 	}
+	for _, qos := range node1QoSes {
+		node1Switch.QOSRules = append(node1Switch.QOSRules, qos.UUID)
+		initialDB.NBData = append(initialDB.NBData, qos)
+	}
 
 	ovnClientset := util.GetOVNClientset(ns0, ns1, ns3, node1, node2, clientPod, nqos, nad)
 	fakeKubeClient = ovnClientset.KubeClient
@@ -781,6 +787,207 @@ var _ = Describe("NetworkQoS Controller", func() {
 			Entry("Interconnect Disabled", false),
 			Entry("Interconnect Enabled", true),
 		)
+
+		DescribeTable("When NetworkQoS has ingress rules",
+			func(enableInterconnect bool) {
+				tableEntrySetup(enableInterconnect)
+				eventuallyExpectQoS(defaultControllerName, nqosNamespace, nqosName, 0)
+
+				By("creates to-lport QoS rules matching traffic towards the selected pods")
+				{
+					nqosUpdate, err := fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Get(context.TODO(), nqosName, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					nqosUpdate.ResourceVersion = time.Now().String()
+					nqosUpdate.Spec.Ingress = []nqostype.Rule{
+						{
+							DSCP: 20,
+							Bandwidth: nqostype.Bandwidth{
+								Rate:  5000,
+								Burst: 50000,
+							},
+							Classifier: nqostype.Classifier{
+								From: []nqostype.Destination{
+									{
+										NamespaceSelector: &metav1.LabelSelector{
+											MatchLabels: map[string]string{
+												"app": "app1",
+											},
+										},
+									},
+									{
+										IPBlock: &networkingv1.IPBlock{
+											CIDR: "128.120.0.0/17",
+										},
+									},
+								},
+								Ports: []*nqostype.Port{
+									{
+										Protocol: "tcp",
+										Port:     &port8080,
+									},
+								},
+							},
+						},
+					}
+					_, err = fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Update(context.TODO(), nqosUpdate, metav1.UpdateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					eventuallyExpectAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "ingress-0", "0", defaultControllerName)
+					qos := eventuallyExpectIngressQoS(defaultControllerName, nqosNamespace, nqosName, 0)
+					eventuallySwitchHasQoS("node1", qos)
+					// egress rule with the same index is kept separately
+					egressQoS := eventuallyExpectQoS(defaultControllerName, nqosNamespace, nqosName, 0)
+					Expect(egressQoS.UUID).NotTo(Equal(qos.UUID))
+
+					sourceAddrSet, err := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "src", "0", defaultControllerName)
+					Expect(err).NotTo(HaveOccurred())
+					peerAddrSet, err := findAddressSet(defaultAddrsetFactory, nqosNamespace, nqosName, "ingress-0", "0", defaultControllerName)
+					Expect(err).NotTo(HaveOccurred())
+					srcHashName4, _ := sourceAddrSet.GetASHashNames()
+					peerHashName4, _ := peerAddrSet.GetASHashNames()
+					Expect(qos.Match).To(Equal(fmt.Sprintf("ip4.dst == {$%s} && (ip4.src == {$%s} || ip4.src == 128.120.0.0/17) && tcp && tcp.dst == 8080", srcHashName4, peerHashName4)))
+					Expect(qos.Direction).To(Equal(nbdb.QoSDirectionToLport))
+					Expect(qos.Action).To(HaveKeyWithValue(nbdb.QoSActionDSCP, 20))
+					Expect(qos.Priority).To(Equal(6000))
+					Expect(qos.Bandwidth).To(HaveKeyWithValue(nbdb.QoSBandwidthRate, 5000))
+					Expect(qos.Bandwidth).To(HaveKeyWithValue(nbdb.QoSBandwidthBurst, 50000))
+				}
+
+				By("adds IP of a matching pod to the ingress peer address set")
+				{
+					app1Pod := &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: app1Namespace,
+							Name:      "app1-pod",
+							Annotations: map[string]string{
+								"k8s.ovn.org/pod-networks": `{"default":{"ip_addresses":["10.194.188.4/26"],"mac_address":"0a:58:0a:c2:bc:04","gateway_ips":["10.194.188.1"],"mtu":"1500","ip_address":"10.194.188.4/26","gateway_ip":"10.194.188.1"}}`,
+							},
+						},
+						Spec: corev1.PodSpec{
+							NodeName: "node2",
+						},
+					}
+					_, err := fakeKubeClient.CoreV1().Pods(app1Pod.Namespace).Create(context.TODO(), app1Pod, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					eventuallyAddressSetHas(defaultAddrsetFactory, nqosNamespace, nqosName, "ingress-0", "0", defaultControllerName, "10.194.188.4")
+				}
+
+				By("gives precedence to the egress rule when an ingress rule matches the same pod pair")
+				{
+					serverNQoS := &nqostype.NetworkQoS{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: app1Namespace,
+							Name:      "server-qos",
+						},
+						Spec: nqostype.Spec{
+							Priority: 100,
+							PodSelector: metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"component": "service1",
+								},
+							},
+							Ingress: []nqostype.Rule{
+								{
+									DSCP: 30,
+									Classifier: nqostype.Classifier{
+										From: []nqostype.Destination{
+											{
+												NamespaceSelector: &metav1.LabelSelector{
+													MatchLabels: map[string]string{
+														"app": "client",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					}
+					serverPod := &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: app1Namespace,
+							Name:      "server-pod",
+							Labels: map[string]string{
+								"component": "service1",
+							},
+							Annotations: map[string]string{
+								"k8s.ovn.org/pod-networks": `{"default":{"ip_addresses":["10.192.177.5/26"],"mac_address":"0a:58:0a:c0:b1:05","gateway_ips":["10.192.177.1"],"mtu":"1500","ip_address":"10.192.177.5/26","gateway_ip":"10.192.177.1"}}`,
+							},
+						},
+						Spec: corev1.PodSpec{
+							NodeName: "node1",
+						},
+					}
+					_, err := fakeKubeClient.CoreV1().Pods(serverPod.Namespace).Create(context.TODO(), serverPod, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					_, err = fakeNQoSClient.K8sV1alpha1().NetworkQoSes(app1Namespace).Create(context.TODO(), serverNQoS, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					ingressQoS := eventuallyExpectIngressQoS(defaultControllerName, app1Namespace, serverNQoS.Name, 0)
+					egressQoS := eventuallyExpectQoS(defaultControllerName, nqosNamespace, nqosName, 0)
+					// client -> service1 traffic is matched by the egress rule of the client and the ingress
+					// rule of service1, both with the same NetworkQoS priority and rule index
+					Expect(ingressQoS.Priority).To(Equal(6000))
+					Expect(egressQoS.Priority).To(Equal(11000))
+					Expect(egressQoS.Priority).To(BeNumerically(">", ingressQoS.Priority))
+				}
+
+				By("deletes ingress QoS when ingress rules are removed")
+				{
+					qos, err := findGressQoS(defaultControllerName, nqosNamespace, nqosName, networkingv1.PolicyTypeIngress, 0)
+					Expect(err).NotTo(HaveOccurred())
+					nqosUpdate, err := fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Get(context.TODO(), nqosName, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					nqosUpdate.ResourceVersion = time.Now().String()
+					nqosUpdate.Spec.Ingress = nil
+					_, err = fakeNQoSClient.K8sV1alpha1().NetworkQoSes(nqosNamespace).Update(context.TODO(), nqosUpdate, metav1.UpdateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					eventuallySwitchHasNoQoS("node1", qos)
+					eventuallyExpectNoIngressQoS(defaultControllerName, nqosNamespace, nqosName, 0)
+					eventuallyExpectQoS(defaultControllerName, nqosNamespace, nqosName, 0)
+				}
+			},
+			Entry("Interconnect Disabled", false),
+			Entry("Interconnect Enabled", true),
+		)
+
+		DescribeTable("When QoS rules created before ingress rules were supported exist",
+			func(enableInterconnect bool) {
+				legacyQoS := &nbdb.QoS{
+					UUID:      "8a86f6d8-7972-4253-b0bd-ddbef66e9303",
+					Action:    map[string]int{nbdb.QoSActionDSCP: 50},
+					Direction: nbdb.QoSDirectionToLport,
+					Match:     "ip4.src == 10.192.177.4",
+					Priority:  11000,
+					ExternalIDs: map[string]string{
+						libovsdbops.OwnerControllerKey.String(): defaultControllerName,
+						libovsdbops.OwnerTypeKey.String():       string(libovsdbops.NetworkQoSOwnerType),
+						libovsdbops.ObjectNameKey.String():      joinMetaNamespaceAndName(nqosNamespace, nqosName, ":"),
+						libovsdbops.RuleIndex.String():          "0",
+						libovsdbops.PrimaryIDKey.String():       fmt.Sprintf("%s:NetworkQoS:%s:%s:0", defaultControllerName, nqosNamespace, nqosName),
+					},
+				}
+				tableEntrySetup(enableInterconnect, legacyQoS)
+
+				By("migrates them to egress rules instead of recreating them")
+				{
+					qos := eventuallyExpectQoS(defaultControllerName, nqosNamespace, nqosName, 0)
+					Expect(qos.UUID).To(Equal(legacyQoS.UUID))
+					Expect(qos.ExternalIDs).To(HaveKeyWithValue(libovsdbops.PolicyDirectionKey.String(), string(networkingv1.PolicyTypeEgress)))
+					eventuallySwitchHasQoS("node1", qos)
+					Eventually(func() string {
+						qos, _ = findQoS(defaultControllerName, nqosNamespace, nqosName, 0)
+						if qos == nil {
+							return ""
+						}
+						return qos.Match
+					}).WithTimeout(10 * time.Second).WithPolling(1 * time.Second).Should(ContainSubstring("tcp.dst == {8080,8081}"))
+					Expect(qos.UUID).To(Equal(legacyQoS.UUID))
+				}
+			},
+			Entry("Interconnect Disabled", false),
+			Entry("Interconnect Enabled", true),
+		)
 	})
 })
 
@@ -836,10 +1043,32 @@ func eventuallyExpectNoQoS(controllerName, qosNamespace, qosName string, index i
 }
 
 func findQoS(controllerName, qosNamespace, qosName string, index int) (*nbdb.QoS, error) {
+	return findGressQoS(controllerName, qosNamespace, qosName, networkingv1.PolicyTypeEgress, index)
+}
+
+func eventuallyExpectIngressQoS(controllerName, qosNamespace, qosName string, index int) *nbdb.QoS {
+	var qos *nbdb.QoS
+	Eventually(func() bool {
+		qos, _ = findGressQoS(controllerName, qosNamespace, qosName, networkingv1.PolicyTypeIngress, index)
+		return qos != nil
+	}).WithTimeout(10*time.Second).WithPolling(1*time.Second).Should(BeTrue(), fmt.Sprintf("ingress QoS not found for %s/%s", qosNamespace, qosName))
+	return qos
+}
+
+func eventuallyExpectNoIngressQoS(controllerName, qosNamespace, qosName string, index int) {
+	var qos *nbdb.QoS
+	Eventually(func() bool {
+		qos, _ = findGressQoS(controllerName, qosNamespace, qosName, networkingv1.PolicyTypeIngress, index)
+		return qos == nil
+	}).WithTimeout(10*time.Second).WithPolling(1*time.Second).Should(BeTrue(), fmt.Sprintf("Unexpected ingress QoS found for %s/%s, index %d", qosNamespace, qosName, index))
+}
+
+func findGressQoS(controllerName, qosNamespace, qosName string, direction networkingv1.PolicyType, index int) (*nbdb.QoS, error) {
 	qosKey := joinMetaNamespaceAndName(qosNamespace, qosName, ":")
 	dbIDs := libovsdbops.NewDbObjectIDs(libovsdbops.NetworkQoS, controllerName, map[libovsdbops.ExternalIDKey]string{
-		libovsdbops.ObjectNameKey: qosKey,
-		libovsdbops.RuleIndex:     fmt.Sprintf("%d", index),
+		libovsdbops.ObjectNameKey:      qosKey,
+		libovsdbops.PolicyDirectionKey: string(direction),
+		libovsdbops.RuleIndex:          fmt.Sprintf("%d", index),
 	})
 	predicate := libovsdbops.GetPredicate(dbIDs, func(item *nbdb.QoS) bool {
 		return item.ExternalIDs[libovsdbops.OwnerControllerKey.String()] == controllerName &&
@@ -1,8 +1,11 @@
 package networkqos
 
 import (
+	"fmt"
+	"maps"
 	"time"
 
+	knet "k8s.io/api/networking/v1"
 	"k8s.io/klog/v2"
 
 	networkqosapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1"
@@ -28,9 +31,10 @@ func (c *Controller) repairNetworkQoSes() error {
 	}
 
 	// delete stale ovn qos objects owned by NetworkQoS
-	if err := libovsdbops.DeleteQoSesWithPredicate(c.nbClient, func(qos *nbdb.QoS) bool {
+	legacyQoSes := []*nbdb.QoS{}
+	staleQoSes, err := libovsdbops.FindQoSesWithPredicate(c.nbClient, func(qos *nbdb.QoS) bool {
 		if qos.ExternalIDs[libovsdbops.OwnerControllerKey.String()] != c.controllerName ||
-			qos.ExternalIDs[libovsdbops.OwnerTypeKey.String()] == string(libovsdbops.NetworkQoSOwnerType) {
+			qos.ExternalIDs[libovsdbops.OwnerTypeKey.String()] != string(libovsdbops.NetworkQoSOwnerType) {
 			return false
 		}
 		objName := qos.ExternalIDs[libovsdbops.ObjectNameKey.String()]
@@ -44,9 +48,19 @@ func (c *Controller) repairNetworkQoSes() error {
 			klog.Warningf("OVN QoS %s doesn't have expected NetworkQoS object %s", qos.UUID, objName)
 			return true
 		}
+		// created before ingress rules were supported, only egress rules existed then
+		if _, ok := qos.ExternalIDs[libovsdbops.PolicyDirectionKey.String()]; !ok {
+			legacyQoSes = append(legacyQoSes, qos)
+		}
 		return false
-	}); err != nil {
-		klog.Errorf("Failed to get ops to clean up stale QoSes: %v", err)
+	})
+	if err != nil {
+		klog.Errorf("Failed to look up stale QoSes: %v", err)
+	} else if err := c.deleteOvnQoSes(staleQoSes); err != nil {
+		klog.Errorf("Failed to clean up stale QoSes: %v", err)
+	}
+	if err := c.migrateLegacyQoSes(legacyQoSes); err != nil {
+		klog.Errorf("Failed to migrate QoSes without rule direction: %v", err)
 	}
 
 	// delete address sets whose networkqos object has gone in k8s
@@ -73,3 +87,31 @@ func (c *Controller) repairNetworkQoSes() error {
 
 	return nil
 }
+
+// migrateLegacyQoSes adds the egress direction to the db IDs of the given QoSes, which were created
+// before ingress rules were supported, so that they are updated in place instead of being recreated.
+func (c *Controller) migrateLegacyQoSes(qoses []*nbdb.QoS) error {
+	if len(qoses) == 0 {
+		return nil
+	}
+	migrated := make([]*nbdb.QoS, 0, len(qoses))
+	for _, qos := range qoses {
+		dbIDs := libovsdbops.NewDbObjectIDs(libovsdbops.NetworkQoS, c.controllerName, map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey:      qos.ExternalIDs[libovsdbops.ObjectNameKey.String()],
+			libovsdbops.PolicyDirectionKey: string(knet.PolicyTypeEgress),
+			libovsdbops.RuleIndex:          qos.ExternalIDs[libovsdbops.RuleIndex.String()],
+		})
+		klog.Infof("Migrating OVN QoS %s without key %s to egress rule", qos.UUID, libovsdbops.PolicyDirectionKey.String())
+		qos = qos.DeepCopy()
+		maps.Copy(qos.ExternalIDs, dbIDs.GetExternalIDs())
+		migrated = append(migrated, qos)
+	}
+	ops, err := libovsdbops.UpdateQoSesOps(c.nbClient, nil, migrated...)
+	if err != nil {
+		return fmt.Errorf("failed to get ops to migrate QoSes: %w", err)
+	}
+	if _, err := libovsdbops.TransactAndCheck(c.nbClient, ops); err != nil {
+		return fmt.Errorf("failed to migrate QoSes: %w", err)
+	}
+	return nil
+}
@@ -37,19 +37,27 @@ type networkQoSState struct {
 
 	// egressRules stores the objects needed to track .Spec.Egress changes
 	EgressRules []*GressRule
+	// ingressRules stores the objects needed to track .Spec.Ingress changes
+	IngressRules []*GressRule
 }
 
 func (nqosState *networkQoSState) getObjectNameKey() string {
 	return joinMetaNamespaceAndName(nqosState.namespace, nqosState.name, ":")
 }
 
-func (nqosState *networkQoSState) getDbObjectIDs(controller string, ruleIndex int) *libovsdbops.DbObjectIDs {
+func (nqosState *networkQoSState) getDbObjectIDs(controller string, direction knet.PolicyType, ruleIndex int) *libovsdbops.DbObjectIDs {
 	return libovsdbops.NewDbObjectIDs(libovsdbops.NetworkQoS, controller, map[libovsdbops.ExternalIDKey]string{
-		libovsdbops.ObjectNameKey: nqosState.getObjectNameKey(),
-		libovsdbops.RuleIndex:     fmt.Sprintf("%d", ruleIndex),
+		libovsdbops.ObjectNameKey:      nqosState.getObjectNameKey(),
+		libovsdbops.PolicyDirectionKey: string(direction),
+		libovsdbops.RuleIndex:          fmt.Sprintf("%d", ruleIndex),
 	})
 }
 
+// gressRules returns both egress and ingress rules of the network qos
+func (nqosState *networkQoSState) gressRules() []*GressRule {
+	return slices.Concat(nqosState.EgressRules, nqosState.IngressRules)
+}
+
 func (nqosState *networkQoSState) emptyPodSelector() bool {
 	return nqosState.PodSelector == nil || nqosState.PodSelector.Empty()
 }
@@ -65,13 +73,13 @@ func (nqosState *networkQoSState) initAddressSets(addressSetFactory addressset.A
 	if err != nil {
 		return fmt.Errorf("failed to init source address set for %s/%s: %w", nqosState.namespace, nqosState.name, err)
 	}
-	// ensure destination address sets
-	for ruleIndex, rule := range nqosState.EgressRules {
+	// ensure peer address sets
+	for _, rule := range nqosState.gressRules() {
 		for destIndex, dest := range rule.Classifier.Destinations {
 			if dest.NamespaceSelector == nil && dest.PodSelector == nil {
 				continue
 			}
-			dest.DestAddrSet, err = addressSetFactory.EnsureAddressSet(GetNetworkQoSAddrSetDbIDs(nqosState.namespace, nqosState.name, strconv.Itoa(ruleIndex), strconv.Itoa(destIndex), controllerName))
+			dest.DestAddrSet, err = addressSetFactory.EnsureAddressSet(GetNetworkQoSAddrSetDbIDs(nqosState.namespace, nqosState.name, rule.addrSetRuleIndex(), strconv.Itoa(destIndex), controllerName))
 			if err != nil {
 				return fmt.Errorf("failed to init destination address set for %s/%s: %w", nqosState.namespace, nqosState.name, err)
 			}
@@ -179,7 +187,7 @@ func (nqosState *networkQoSState) getAddressSetHashNames() []string {
 		v4Hash, v6Hash := nqosState.SrcAddrSet.GetASHashNames()
 		addrsetNames = append(addrsetNames, v4Hash, v6Hash)
 	}
-	for _, rule := range nqosState.EgressRules {
+	for _, rule := range nqosState.gressRules() {
 		for _, dest := range rule.Classifier.Destinations {
 			if dest.DestAddrSet != nil {
 				v4Hash, v6Hash := dest.DestAddrSet.GetASHashNames()
@@ -206,8 +214,8 @@ func (nqosState *networkQoSState) cleanupStaleAddresses(addressSetMap map[string
 			}
 		}
 	}
-	for _, egress := range nqosState.EgressRules {
-		for _, dest := range egress.Classifier.Destinations {
+	for _, rule := range nqosState.gressRules() {
+		for _, dest := range rule.Classifier.Destinations {
 			if dest.DestAddrSet == nil {
 				continue
 			}
@@ -230,6 +238,8 @@ func (nqosState *networkQoSState) cleanupStaleAddresses(addressSetMap map[string
 }
 
 type GressRule struct {
+	Direction  knet.PolicyType
+	Index      int
 	Priority   int
 	Dscp       int
 	Classifier *Classifier
@@ -239,6 +249,26 @@ type GressRule struct {
 	Burst *int
 }
 
+// addrSetRuleIndex returns the rule index used to identify the address sets of the rule's peers.
+// Egress rules keep the plain rule index, while ingress rules are prefixed to avoid clashing with them.
+func (rule *GressRule) addrSetRuleIndex() string {
+	if rule.Direction == knet.PolicyTypeIngress {
+		return ingressAddrSetPrefix + strconv.Itoa(rule.Index)
+	}
+	return strconv.Itoa(rule.Index)
+}
+
+// directions returns the traffic directions of the pods selected by the network qos
+// and of the rule's peers, respectively.
+func (rule *GressRule) directions() (trafficDirection, trafficDirection) {
+	if rule.Direction == knet.PolicyTypeIngress {
+		return trafficDirDest, trafficDirSource
+	}
+	return trafficDirSource, trafficDirDest
+}
+
+const ingressAddrSetPrefix = "ingress-"
+
 type trafficDirection string
 
 const (
@@ -246,34 +276,36 @@ const (
 	trafficDirDest   trafficDirection = "dst"
 )
 
+// Classifier holds the peers of a rule, which are the destinations of an egress rule
+// or the sources of an ingress rule, along with the protocols and ports to match.
 type Classifier struct {
 	Destinations []*Destination
 	Ports        []*networkqosv1alpha1.Port
 }
 
-// ToQosMatchString generates dest and protocol/port part of QoS match string, based on
-// Classifier's destinations, protocol and port fields, example:
+// ToQosMatchString generates peer and protocol/port part of QoS match string, based on
+// Classifier's destinations, protocol and port fields, example for egress (peerDir is dst):
 // (ip4.dst == $addr_set_name || (ip4.dst == 128.116.0.0/17 && ip4.dst != {128.116.0.0,128.116.0.255})) && tcp && tcp.dst == 8080
-// Multiple destinations will be connected by "||".
+// Multiple destinations will be connected by "||". Ports always match the destination port.
 // See https://github.com/ovn-org/ovn/blob/2bdf1129c19d5bd2cd58a3ddcb6e2e7254b05054/ovn-nb.xml#L2942-L3025 for details
-func (c *Classifier) ToQosMatchString(ipv4Enabled, ipv6Enabled bool) string {
+func (c *Classifier) ToQosMatchString(peerDir trafficDirection, ipv4Enabled, ipv6Enabled bool) string {
 	if c == nil {
 		return ""
 	}
 	destMatchStrings := []string{}
 	for _, dest := range c.Destinations {
-		match := "ip4.dst == 0.0.0.0/0 || ip6.dst == ::/0"
+		match := fmt.Sprintf("ip4.%s == 0.0.0.0/0 || ip6.%s == ::/0", peerDir, peerDir)
 		if dest.DestAddrSet != nil {
-			match = addressSetToMatchString(dest.DestAddrSet, trafficDirDest, ipv4Enabled, ipv6Enabled)
+			match = addressSetToMatchString(dest.DestAddrSet, peerDir, ipv4Enabled, ipv6Enabled)
 		} else if dest.IpBlock != nil && dest.IpBlock.CIDR != "" {
 			ipVersion := "ip4"
 			if utilnet.IsIPv6CIDRString(dest.IpBlock.CIDR) {
 				ipVersion = "ip6"
 			}
 			if len(dest.IpBlock.Except) == 0 {
-				match = fmt.Sprintf("%s.%s == %s", ipVersion, trafficDirDest, dest.IpBlock.CIDR)
+				match = fmt.Sprintf("%s.%s == %s", ipVersion, peerDir, dest.IpBlock.CIDR)
 			} else {
-				match = fmt.Sprintf("%s.%s == %s && %s.%s != {%s}", ipVersion, trafficDirDest, dest.IpBlock.CIDR, ipVersion, trafficDirDest, strings.Join(dest.IpBlock.Except, ","))
+				match = fmt.Sprintf("%s.%s == %s && %s.%s != {%s}", ipVersion, peerDir, dest.IpBlock.CIDR, ipVersion, peerDir, strings.Join(dest.IpBlock.Except, ","))
 			}
 		}
 		destMatchStrings = append(destMatchStrings, match)
@@ -383,6 +415,16 @@ func (dest *Destination) removePod(fullPodName string, addresses []string) error
 	return nil
 }
 
-func getQoSRulePriority(qosPriority, ruleIndex int) int {
-	return 10000 + qosPriority*10 + ruleIndex
+const (
+	egressQoSRuleStartPriority = 10000
+	// ingress rules get their own, lower priority band, so that traffic matched by both an egress rule
+	// of the source pod and an ingress rule of the destination pod is always marked by the egress rule
+	ingressQoSRuleStartPriority = 5000
+)
+
+func getQoSRulePriority(direction knet.PolicyType, qosPriority, ruleIndex int) int {
+	if direction == knet.PolicyTypeIngress {
+		return ingressQoSRuleStartPriority + qosPriority*10 + ruleIndex
+	}
+	return egressQoSRuleStartPriority + qosPriority*10 + ruleIndex
 }
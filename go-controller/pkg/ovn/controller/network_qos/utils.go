@@ -2,9 +2,11 @@ package networkqos
 
 import (
 	"fmt"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 
+	nqosv1alpha1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
 	ovnkutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -52,9 +54,10 @@ func getPodAddresses(pod *corev1.Pod, networkInfo ovnkutil.NetInfo) ([]string, e
 }
 
 func generateNetworkQoSMatch(qosState *networkQoSState, rule *GressRule, ipv4Enabled, ipv6Enabled bool) string {
-	match := addressSetToMatchString(qosState.SrcAddrSet, trafficDirSource, ipv4Enabled, ipv6Enabled)
+	selectedDir, peerDir := rule.directions()
+	match := addressSetToMatchString(qosState.SrcAddrSet, selectedDir, ipv4Enabled, ipv6Enabled)
 
-	classiferMatchString := rule.Classifier.ToQosMatchString(ipv4Enabled, ipv6Enabled)
+	classiferMatchString := rule.Classifier.ToQosMatchString(peerDir, ipv4Enabled, ipv6Enabled)
 	if classiferMatchString != "" {
 		match = match + " && " + classiferMatchString
 	}
@@ -62,6 +65,18 @@ func generateNetworkQoSMatch(qosState *networkQoSState, rule *GressRule, ipv4Ena
 	return match
 }
 
+// allRules returns both egress and ingress rules of the given NetworkQoS
+func allRules(nqos *nqosv1alpha1.NetworkQoS) []nqosv1alpha1.Rule {
+	return slices.Concat(nqos.Spec.Egress, nqos.Spec.Ingress)
+}
+
+// rulePeers returns the peers of the given rule, i.e. the destinations of an
+// egress rule or the sources of an ingress rule. Validation ensures a rule only
+// sets the field matching its direction.
+func rulePeers(rule *nqosv1alpha1.Rule) []nqosv1alpha1.Destination {
+	return slices.Concat(rule.Classifier.To, rule.Classifier.From)
+}
+
 func addressSetToMatchString(addrset addressset.AddressSet, dir trafficDirection, ipv4Enabled, ipv6Enabled bool) string {
 	ipv4AddrSetHashName, ipv6AddrSetHashName := addrset.GetASHashNames()
 	output := ""
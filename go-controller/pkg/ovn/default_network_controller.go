@@ -10,16 +10,12 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	knet "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
-	corev1listers "k8s.io/client-go/listers/core/v1"
-	"k8s.io/client-go/tools/cache"
-	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
 	egressfirewall "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
 	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
-	egressqoslisters "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressqos/v1/apis/listers/egressqos/v1"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
@@ -62,20 +58,11 @@ type DefaultNetworkController struct {
 
 	// egressFirewalls is a map of namespaces and the egressFirewall attached to it
 	egressFirewalls sync.Map
-
-	// EgressQoS
-	egressQoSLister egressqoslisters.EgressQoSLister
-	egressQoSSynced cache.InformerSynced
-	egressQoSQueue  workqueue.TypedRateLimitingInterface[string]
-	egressQoSCache  sync.Map
-
-	egressQoSPodLister corev1listers.PodLister
-	egressQoSPodSynced cache.InformerSynced
-	egressQoSPodQueue  workqueue.TypedRateLimitingInterface[string]
-
-	egressQoSNodeLister corev1listers.NodeLister
-	egressQoSNodeSynced cache.InformerSynced
-	egressQoSNodeQueue  workqueue.TypedRateLimitingInterface[string]
+	// cefNamespaces is a map of ClusterEgressFirewall names to the set of namespaces selected by it
+	cefNamespaces sync.Map
+	// cefLock serializes the ClusterEgressFirewall port group updates done for ClusterEgressFirewall,
+	// namespace and pod events
+	cefLock sync.Mutex
 
 	// Cluster wide Load_Balancer_Group UUID.
 	// Includes all node switches and node gateway routers.
@@ -110,6 +97,10 @@ type DefaultNetworkController struct {
 	// used in egress firewall rules
 	dnsNameResolver  dnsnameresolver.DNSNameResolver
 	efNodeController controller.Controller
+	// controllers handling ClusterEgressFirewalls
+	cefController          controller.Controller
+	cefNamespaceController controller.Controller
+	cefPodController       controller.Controller
 
 	// retry framework for egress firewall
 	retryEgressFirewalls *retry.RetryFramework
@@ -368,6 +359,9 @@ func (oc *DefaultNetworkController) Stop() {
 	if oc.efNodeController != nil {
 		controller.Stop(oc.efNodeController)
 	}
+	if oc.cefController != nil {
+		controller.Stop(oc.cefController, oc.cefNamespaceController, oc.cefPodController)
+	}
 	if oc.routeImportManager != nil {
 		oc.routeImportManager.ForgetNetwork(oc.GetNetworkName())
 	}
@@ -527,6 +521,10 @@ func (oc *DefaultNetworkController) run(_ context.Context) error {
 		if err != nil {
 			return err
 		}
+		err = WithSyncDurationMetric("cluster egress firewall", oc.startClusterEgressFirewallControllers)
+		if err != nil {
+			return err
+		}
 		oc.efNodeController = oc.newEFNodeController(oc.watchFactory.NodeCoreInformer())
 		err = controller.Start(oc.efNodeController)
 		if err != nil {
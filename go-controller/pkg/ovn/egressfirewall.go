@@ -44,6 +44,9 @@ const (
 	// is ~3 sec
 	// Therefore this limit is safe enough to not exceed 10 sec transaction timeout.
 	aclChangePGBatchSize = 80000
+
+	egressFirewallProtocolICMP   = "ICMP"
+	egressFirewallProtocolICMPv6 = "ICMPv6"
 )
 
 type egressFirewall struct {
@@ -115,6 +118,9 @@ func (oc *DefaultNetworkController) newEgressFirewallRule(rawEgressFirewallRule
 			efr.to.nodeAddrs[node.Name] = hostAddresses
 		}
 	}
+	if err = util.ValidateEgressFirewallPorts(rawEgressFirewallRule.Ports); err != nil {
+		return efr, err
+	}
 	efr.ports = rawEgressFirewallRule.Ports
 
 	return efr, nil
@@ -395,28 +401,8 @@ func (oc *DefaultNetworkController) addEgressFirewallRules(ef *egressFirewall, p
 		} else {
 			action = nbdb.ACLActionDrop
 		}
-		if len(rule.to.nodeAddrs) > 0 {
-			// sort node ips to ensure the same order when no changes are present
-			// this ensure ACL recalculation won't happen just because of the order changes
-			allIPs := []string{}
-			for _, nodeIPs := range rule.to.nodeAddrs {
-				allIPs = append(allIPs, nodeIPs...)
-			}
-			slices.Sort(allIPs)
-
-			for _, addr := range allIPs {
-				if utilnet.IsIPv6String(addr) {
-					matchTargets = append(matchTargets, matchTarget{matchKindV6CIDR, addr, false})
-				} else {
-					matchTargets = append(matchTargets, matchTarget{matchKindV4CIDR, addr, false})
-				}
-			}
-		} else if rule.to.cidrSelector != "" {
-			if utilnet.IsIPv6CIDRString(rule.to.cidrSelector) {
-				matchTargets = []matchTarget{{matchKindV6CIDR, rule.to.cidrSelector, rule.to.clusterSubnetIntersection}}
-			} else {
-				matchTargets = []matchTarget{{matchKindV4CIDR, rule.to.cidrSelector, rule.to.clusterSubnetIntersection}}
-			}
+		if len(rule.to.nodeAddrs) > 0 || rule.to.cidrSelector != "" {
+			matchTargets = rule.addressMatchTargets()
 		} else if len(rule.to.dnsName) > 0 {
 			// rule based on DNS NAME
 			dnsName := rule.to.dnsName
@@ -538,6 +524,35 @@ func (oc *DefaultNetworkController) deleteEgressFirewallRules(namespace string)
 	return nil
 }
 
+// addressMatchTargets returns the match targets for a rule with nodeSelector or cidrSelector destination.
+func (rule *egressFirewallRule) addressMatchTargets() []matchTarget {
+	var matchTargets []matchTarget
+	if len(rule.to.nodeAddrs) > 0 {
+		// sort node ips to ensure the same order when no changes are present
+		// this ensure ACL recalculation won't happen just because of the order changes
+		allIPs := []string{}
+		for _, nodeIPs := range rule.to.nodeAddrs {
+			allIPs = append(allIPs, nodeIPs...)
+		}
+		slices.Sort(allIPs)
+
+		for _, addr := range allIPs {
+			if utilnet.IsIPv6String(addr) {
+				matchTargets = append(matchTargets, matchTarget{matchKindV6CIDR, addr, false})
+			} else {
+				matchTargets = append(matchTargets, matchTarget{matchKindV4CIDR, addr, false})
+			}
+		}
+	} else if rule.to.cidrSelector != "" {
+		if utilnet.IsIPv6CIDRString(rule.to.cidrSelector) {
+			matchTargets = []matchTarget{{matchKindV6CIDR, rule.to.cidrSelector, rule.to.clusterSubnetIntersection}}
+		} else {
+			matchTargets = []matchTarget{{matchKindV4CIDR, rule.to.cidrSelector, rule.to.clusterSubnetIntersection}}
+		}
+	}
+	return matchTargets
+}
+
 type matchTarget struct {
 	kind  matchKind
 	value string
@@ -622,24 +637,38 @@ func egressGetL4Match(ports []egressfirewallapi.EgressFirewallPort) string {
 	var udpString string
 	var tcpString string
 	var sctpString string
+	var icmp4String string
+	var icmp6String string
 	for _, port := range ports {
 		if corev1.Protocol(port.Protocol) == corev1.ProtocolUDP && udpString != "udp" {
 			if port.Port == 0 {
 				udpString = "udp"
 			} else {
-				udpString = fmt.Sprintf("%s udp.dst == %d ||", udpString, port.Port)
+				udpString = fmt.Sprintf("%s %s ||", udpString, egressGetPortMatch("udp", port))
 			}
 		} else if corev1.Protocol(port.Protocol) == corev1.ProtocolTCP && tcpString != "tcp" {
 			if port.Port == 0 {
 				tcpString = "tcp"
 			} else {
-				tcpString = fmt.Sprintf("%s tcp.dst == %d ||", tcpString, port.Port)
+				tcpString = fmt.Sprintf("%s %s ||", tcpString, egressGetPortMatch("tcp", port))
 			}
 		} else if corev1.Protocol(port.Protocol) == corev1.ProtocolSCTP && sctpString != "sctp" {
 			if port.Port == 0 {
 				sctpString = "sctp"
 			} else {
-				sctpString = fmt.Sprintf("%s sctp.dst == %d ||", sctpString, port.Port)
+				sctpString = fmt.Sprintf("%s %s ||", sctpString, egressGetPortMatch("sctp", port))
+			}
+		} else if port.Protocol == egressFirewallProtocolICMP && icmp4String != "icmp4" {
+			if port.ICMPType == nil {
+				icmp4String = "icmp4"
+			} else {
+				icmp4String = fmt.Sprintf("%s %s ||", icmp4String, egressGetICMPMatch("icmp4", port))
+			}
+		} else if port.Protocol == egressFirewallProtocolICMPv6 && icmp6String != "icmp6" {
+			if port.ICMPType == nil {
+				icmp6String = "icmp6"
+			} else {
+				icmp6String = fmt.Sprintf("%s %s ||", icmp6String, egressGetICMPMatch("icmp6", port))
 			}
 		}
 	}
@@ -662,6 +691,14 @@ func egressGetL4Match(ports []egressfirewallapi.EgressFirewallPort) string {
 			protocolName:     "sctp",
 			protocolFormated: sctpString,
 		},
+		{
+			protocolName:     "icmp4",
+			protocolFormated: icmp4String,
+		},
+		{
+			protocolName:     "icmp6",
+			protocolFormated: icmp6String,
+		},
 	}
 	for _, entry := range list {
 		if entry.protocolName == entry.protocolFormated {
@@ -681,6 +718,22 @@ func egressGetL4Match(ports []egressfirewallapi.EgressFirewallPort) string {
 	return fmt.Sprintf("(%s)", l4Match)
 }
 
+// egressGetPortMatch returns the match for a single port or, when endPort is set, a port range
+func egressGetPortMatch(protocol string, port egressfirewallapi.EgressFirewallPort) string {
+	if port.EndPort != nil && *port.EndPort > port.Port {
+		return fmt.Sprintf("%d<=%s.dst<=%d", port.Port, protocol, *port.EndPort)
+	}
+	return fmt.Sprintf("%s.dst == %d", protocol, port.Port)
+}
+
+// egressGetICMPMatch returns the match for an ICMP type and, if set, an ICMP code
+func egressGetICMPMatch(protocol string, port egressfirewallapi.EgressFirewallPort) string {
+	if port.ICMPCode == nil {
+		return fmt.Sprintf("%s.type == %d", protocol, *port.ICMPType)
+	}
+	return fmt.Sprintf("(%s.type == %d && %s.code == %d)", protocol, *port.ICMPType, protocol, *port.ICMPCode)
+}
+
 func getV4ClusterSubnetsExclusion() string {
 	var exclusions []string
 	for _, clusterSubnet := range config.Default.ClusterSubnets {
@@ -822,8 +875,11 @@ func (oc *DefaultNetworkController) updateEgressFirewallForNode(nodeName string)
 		}
 		return true
 	})
+	if efErr != nil {
+		return efErr
+	}
 
-	return efErr
+	return oc.updateClusterEgressFirewallsForNode()
 }
 
 func (oc *DefaultNetworkController) setEgressFirewallStatus(egressFirewall *egressfirewallapi.EgressFirewall, handlerErr error) error {
@@ -19,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilnet "k8s.io/utils/net"
+	"k8s.io/utils/ptr"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/controller"
@@ -1536,6 +1537,37 @@ var _ = ginkgo.Describe("OVN test basic functions", func() {
 				},
 				expectedMatch: "((udp && ( udp.dst == 400 )) || (tcp && ( tcp.dst == 100 || tcp.dst == 102 )) || (sctp && ( sctp.dst == 13 )))",
 			},
+			{
+				ports: []egressfirewallapi.EgressFirewallPort{
+					{
+						Protocol: "TCP",
+						Port:     30000,
+						EndPort:  ptr.To[int32](32767),
+					},
+					{
+						Protocol: "TCP",
+						Port:     80,
+					},
+				},
+				expectedMatch: "((tcp && ( 30000<=tcp.dst<=32767 || tcp.dst == 80 )))",
+			},
+			{
+				ports: []egressfirewallapi.EgressFirewallPort{
+					{
+						Protocol: "ICMP",
+						ICMPType: ptr.To[int32](8),
+					},
+					{
+						Protocol: "ICMP",
+						ICMPType: ptr.To[int32](3),
+						ICMPCode: ptr.To[int32](4),
+					},
+					{
+						Protocol: "ICMPv6",
+					},
+				},
+				expectedMatch: "((icmp4 && ( icmp4.type == 8 || (icmp4.type == 3 && icmp4.code == 4) )) || (icmp6))",
+			},
 		}
 		for _, test := range testcases {
 			l4Match := egressGetL4Match(test.ports)
@@ -247,11 +247,12 @@ func NewEIPController(
 //	  CASE 3.2: Only Namespace selectors on Spec changed
 //	  CASE 3.3: Only Pod Selectors on Spec changed
 //	  CASE 3.4: Both Namespace && Pod Selectors on Spec changed
+//	  CASE 3.5: Only the Strategy on Spec changed
 //	}
 //
 // NOTE: `Spec.EgressIPs“ updates for EIP object are not processed here, that is the job of cluster manager
 //
-//	We only care about `Spec.NamespaceSelector`, `Spec.PodSelector` and `Status` field
+//	We only care about `Spec.NamespaceSelector`, `Spec.PodSelector`, `Spec.Strategy` and `Status` field
 func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (err error) {
 	var egressIPName string
 	if old != nil {
@@ -280,7 +281,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 	if old == nil && new != nil {
 		addStatus := new.Status.Items
 		if len(addStatus) > 0 {
-			if err := e.addEgressIPAssignments(new.Name, addStatus, mark, new.Spec.NamespaceSelector, new.Spec.PodSelector); err != nil {
+			if err := e.addEgressIPAssignments(new.Name, addStatus, mark, &new.Spec); err != nil {
 				return err
 			}
 		}
@@ -323,11 +324,23 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 				}
 				statusToAdd = append(statusToAdd, newStatus)
 			}
+			// With an assignment strategy, any change of the statuses may
+			// change which egress IPs the pods use, and when the strategy is
+			// removed the pods use all of them again: all of them are provided.
+			if newEIP.Spec.Strategy != nil || !reflect.DeepEqual(oldEIP.Spec.Strategy, newEIP.Spec.Strategy) {
+				statusToAdd = newEIP.Status.Items
+			}
 			if len(statusToAdd) > 0 {
-				if err := e.addEgressIPAssignments(new.Name, statusToAdd, mark, new.Spec.NamespaceSelector, new.Spec.PodSelector); err != nil {
+				if err := e.addEgressIPAssignments(new.Name, statusToAdd, mark, &new.Spec); err != nil {
 					return err
 				}
 			}
+		} else if !reflect.DeepEqual(oldEIP.Spec.Strategy, newEIP.Spec.Strategy) && len(newEIP.Status.Items) > 0 {
+			// CASE 3.5: only the strategy changed, the pods may now use other
+			// egress IPs.
+			if err := e.addEgressIPAssignments(new.Name, newEIP.Status.Items, mark, &new.Spec); err != nil {
+				return err
+			}
 		}
 
 		oldNamespaceSelector, err := metav1.LabelSelectorAsSelector(&oldEIP.Spec.NamespaceSelector)
@@ -371,7 +384,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 					if err != nil {
 						return fmt.Errorf("failed to get active network for namespace %s: %v", namespace.Name, err)
 					}
-					if err := e.addNamespaceEgressIPAssignments(ni, newEIP.Name, newEIP.Status.Items, mark, namespace, newEIP.Spec.PodSelector); err != nil {
+					if err := e.addNamespaceEgressIPAssignments(ni, newEIP.Name, newEIP.Status.Items, mark, namespace, &newEIP.Spec); err != nil {
 						return fmt.Errorf("network %s: failed to add namespace %s egress IP config: %v", ni.GetNetworkName(), namespace.Name, err)
 					}
 				}
@@ -409,7 +422,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 						if err != nil {
 							return fmt.Errorf("failed to get active network for namespace %s: %v", namespace.Name, err)
 						}
-						if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP.Name, newEIP.Status.Items, mark, pod); err != nil {
+						if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP.Name, newEIP.Status.Items, mark, &newEIP.Spec, pod); err != nil {
 							return fmt.Errorf("network %s: failed to add pod %s/%s egress IP config: %v", ni.GetNetworkName(), pod.Namespace, pod.Name, err)
 						}
 					}
@@ -449,7 +462,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 					for _, pod := range pods {
 						podLabels := labels.Set(pod.Labels)
 						if newPodSelector.Matches(podLabels) {
-							if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP.Name, newEIP.Status.Items, mark, pod); err != nil {
+							if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP.Name, newEIP.Status.Items, mark, &newEIP.Spec, pod); err != nil {
 								return fmt.Errorf("network %s: failed to add pod %s/%s egress IP config: %v", ni.GetNetworkName(), pod.Namespace, pod.Name, err)
 							}
 						}
@@ -473,7 +486,7 @@ func (e *EgressIPController) reconcileEgressIP(old, new *egressipv1.EgressIP) (e
 							continue
 						}
 						if newPodSelector.Matches(podLabels) && !oldPodSelector.Matches(podLabels) {
-							if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP.Name, newEIP.Status.Items, mark, pod); err != nil {
+							if err := e.addPodEgressIPAssignmentsWithLock(ni, newEIP.Name, newEIP.Status.Items, mark, &newEIP.Spec, pod); err != nil {
 								return fmt.Errorf("network %s: failed to add pod %s/%s egress IP config: %v", ni.GetNetworkName(), pod.Namespace, pod.Name, err)
 							}
 						}
@@ -552,7 +565,7 @@ func (e *EgressIPController) reconcileEgressIPNamespace(old, new *corev1.Namespa
 				if err != nil {
 					return fmt.Errorf("failed to get active network for namespace %s: %v", namespaceName, err)
 				}
-				if err := e.addNamespaceEgressIPAssignments(ni, eIP.Name, eIP.Status.Items, mark, newNamespace, eIP.Spec.PodSelector); err != nil {
+				if err := e.addNamespaceEgressIPAssignments(ni, eIP.Name, eIP.Status.Items, mark, newNamespace, &eIP.Spec); err != nil {
 					return fmt.Errorf("network %s: failed to add namespace %q for egress IP %q: %w",
 						ni.GetNetworkName(), namespaceName, eIP.Name, err)
 				}
@@ -685,7 +698,7 @@ func (e *EgressIPController) reconcileEgressIPPod(old, new *corev1.Pod) (err err
 					// IPs assigned at that point and we need to continue trying the
 					// pod setup for every pod update as to make sure we process the
 					// pod IP assignment.
-					if err := e.addPodEgressIPAssignmentsWithLock(ni, eIP.Name, eIP.Status.Items, mark, newPod); err != nil {
+					if err := e.addPodEgressIPAssignmentsWithLock(ni, eIP.Name, eIP.Status.Items, mark, &eIP.Spec, newPod); err != nil {
 						return fmt.Errorf("network %s: failed to add pod %s/%s for egress IP %q: %w",
 							ni.GetNetworkName(), newPod.Namespace, newPod.Name, eIP.Name, err)
 					}
@@ -702,7 +715,7 @@ func (e *EgressIPController) reconcileEgressIPPod(old, new *corev1.Pod) (err err
 					return nil
 				}
 				// For all else, perform a setup for the pod
-				if err := e.addPodEgressIPAssignmentsWithLock(ni, eIP.Name, eIP.Status.Items, mark, newPod); err != nil {
+				if err := e.addPodEgressIPAssignmentsWithLock(ni, eIP.Name, eIP.Status.Items, mark, &eIP.Spec, newPod); err != nil {
 					return fmt.Errorf("network %s: failed to add pod %s/%s for egress IP %q: %w",
 						ni.GetNetworkName(), newPod.Namespace, newPod.Name, eIP.Name, err)
 				}
@@ -718,8 +731,8 @@ func (e *EgressIPController) reconcileEgressIPPod(old, new *corev1.Pod) (err err
 
 // main reconcile functions end here and local zone controller functions begin
 
-func (e *EgressIPController) addEgressIPAssignments(name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark, namespaceSelector, podSelector metav1.LabelSelector) error {
-	namespaces, err := e.watchFactory.GetNamespacesBySelector(namespaceSelector)
+func (e *EgressIPController) addEgressIPAssignments(name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark, spec *egressipv1.EgressIPSpec) error {
+	namespaces, err := e.watchFactory.GetNamespacesBySelector(spec.NamespaceSelector)
 	if err != nil {
 		return err
 	}
@@ -728,7 +741,7 @@ func (e *EgressIPController) addEgressIPAssignments(name string, statusAssignmen
 		if err != nil {
 			return fmt.Errorf("failed to get active network for namespace %s: %v", namespace.Name, err)
 		}
-		if err := e.addNamespaceEgressIPAssignments(ni, name, statusAssignments, mark, namespace, podSelector); err != nil {
+		if err := e.addNamespaceEgressIPAssignments(ni, name, statusAssignments, mark, namespace, spec); err != nil {
 			return err
 		}
 	}
@@ -736,15 +749,15 @@ func (e *EgressIPController) addEgressIPAssignments(name string, statusAssignmen
 }
 
 func (e *EgressIPController) addNamespaceEgressIPAssignments(ni util.NetInfo, name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark,
-	namespace *corev1.Namespace, podSelector metav1.LabelSelector) error {
+	namespace *corev1.Namespace, spec *egressipv1.EgressIPSpec) error {
 	var pods []*corev1.Pod
 	var err error
-	selector, err := metav1.LabelSelectorAsSelector(&podSelector)
+	selector, err := metav1.LabelSelectorAsSelector(&spec.PodSelector)
 	if err != nil {
 		return err
 	}
 	if !selector.Empty() {
-		pods, err = e.watchFactory.GetPodsBySelector(namespace.Name, podSelector)
+		pods, err = e.watchFactory.GetPodsBySelector(namespace.Name, spec.PodSelector)
 		if err != nil {
 			return err
 		}
@@ -755,26 +768,27 @@ func (e *EgressIPController) addNamespaceEgressIPAssignments(ni util.NetInfo, na
 		}
 	}
 	for _, pod := range pods {
-		if err := e.addPodEgressIPAssignmentsWithLock(ni, name, statusAssignments, mark, pod); err != nil {
+		if err := e.addPodEgressIPAssignmentsWithLock(ni, name, statusAssignments, mark, spec, pod); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (e *EgressIPController) addPodEgressIPAssignmentsWithLock(ni util.NetInfo, name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark, pod *corev1.Pod) error {
+func (e *EgressIPController) addPodEgressIPAssignmentsWithLock(ni util.NetInfo, name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark, spec *egressipv1.EgressIPSpec, pod *corev1.Pod) error {
 	e.podAssignment.LockKey(getPodKey(pod))
 	defer e.podAssignment.UnlockKey(getPodKey(pod))
 	e.deletePreviousNetworkPodEgressIPAssignments(ni, name, statusAssignments, pod)
-	return e.addPodEgressIPAssignments(ni, name, statusAssignments, mark, pod)
+	return e.addPodEgressIPAssignments(ni, name, statusAssignments, mark, spec, pod)
 }
 
 // addPodEgressIPAssignments tracks the setup made for each egress IP matching
 // pod w.r.t to each status. This is mainly done to avoid a lot of duplicated
 // work on ovnkube-master restarts when all egress IP handlers will most likely
 // match and perform the setup for the same pod and status multiple times over.
+// The spec of the EgressIP object decides which of the statuses the pod uses.
 // requires holding the podAssignmentMutex lock
-func (e *EgressIPController) addPodEgressIPAssignments(ni util.NetInfo, name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark, pod *corev1.Pod) error {
+func (e *EgressIPController) addPodEgressIPAssignments(ni util.NetInfo, name string, statusAssignments []egressipv1.EgressIPStatusItem, mark util.EgressIPMark, spec *egressipv1.EgressIPSpec, pod *corev1.Pod) error {
 	podKey := getPodKey(pod)
 	// If pod is already in succeeded or failed state, return it without proceeding further.
 	if util.PodCompleted(pod) {
@@ -805,7 +819,15 @@ func (e *EgressIPController) addPodEgressIPAssignments(ni util.NetInfo, name str
 	if !proceed && !e.isPodScheduledinLocalZone(pod) {
 		return nil // nothing to do if none of the status nodes are local to this master and pod is also remote
 	}
-	var remainingAssignments []egressipv1.EgressIPStatusItem
+	// With an assignment strategy the pod only uses some of the assigned
+	// egress IPs, the ones it used before and are not selected anymore are
+	// removed once the selected ones are set up.
+	var selectedAssignments sets.Set[egressipv1.EgressIPStatusItem]
+	if spec.Strategy != nil {
+		statusAssignments = util.SelectEgressIPStatusItems(spec, podKey, statusAssignments)
+		selectedAssignments = sets.New(statusAssignments...)
+	}
+	var remainingAssignments, staleAssignments []egressipv1.EgressIPStatusItem
 	nadName := ni.GetNetworkName()
 	if ni.IsSecondary() {
 		nadNames := ni.GetNADs()
@@ -845,6 +867,13 @@ func (e *EgressIPController) addPodEgressIPAssignments(ni util.NetInfo, name str
 				remainingAssignments = append(remainingAssignments, status)
 			}
 		}
+		if selectedAssignments != nil && podState.egressIPName == name {
+			for status := range podState.egressStatuses.statusMap {
+				if !selectedAssignments.Has(status) {
+					staleAssignments = append(staleAssignments, status)
+				}
+			}
+		}
 		podState.podIPs = podIPs
 		podState.egressIPName = name
 		podState.network = ni
@@ -889,6 +918,11 @@ func (e *EgressIPController) addPodEgressIPAssignments(ni util.NetInfo, name str
 			return err
 		}
 	}
+	if len(staleAssignments) > 0 {
+		if err := e.deletePodEgressIPAssignments(ni, name, staleAssignments, pod); err != nil {
+			return fmt.Errorf("unable to delete unselected egressip configuration for pod %s/%s/%v, err: %w", pod.Namespace, pod.Name, podIPNets, err)
+		}
+	}
 	if e.isPodScheduledinLocalZone(pod) {
 		if err := e.addPodIPsToAddressSet(ni.GetNetworkName(), e.controllerName, podIPs...); err != nil {
 			return fmt.Errorf("cannot add egressPodIPs for the pod %s/%s to the address set: err: %v", pod.Namespace, pod.Name, err)
@@ -2308,7 +2342,7 @@ func (e *EgressIPController) addStandByEgressIPAssignment(ni util.NetInfo, podKe
 	}
 	e.podAssignment.Store(podKey, podState)
 	// NOTE: We let addPodEgressIPAssignments take care of setting egressIPName and egressStatuses and removing it from standBy
-	err = e.addPodEgressIPAssignments(ni, eipToAssign, eip.Status.Items, mark, pod)
+	err = e.addPodEgressIPAssignments(ni, eipToAssign, eip.Status.Items, mark, &eip.Spec, pod)
 	if err != nil {
 		return fmt.Errorf("failed to add standby pod %s/%s for network %s: %v", pod.Namespace, pod.Name, ni.GetNetworkName(), err)
 	}
@@ -5944,6 +5944,237 @@ var _ = ginkgo.Describe("OVN master EgressIP Operations cluster default network"
 			ginkgo.Entry("interconnect enabled; node1 in remote and node2 in local zones", true, "remote", "local"),
 		)
 
+		ginkgo.It("should only use the active egress IP with the ActiveStandby strategy", func() {
+			app.Action = func(*cli.Context) error {
+
+				egressIP1 := "192.168.126.101"
+				egressIP2 := "192.168.126.102"
+				node1IPv4CIDR := "192.168.126.202/24"
+				node2IPv4CIDR := "192.168.126.51/24"
+				_, node1Subnet, _ := net.ParseCIDR(v4Node1Subnet)
+				_, node2Subnet, _ := net.ParseCIDR(v4Node2Subnet)
+				egressPod := *newPodWithLabels(eipNamespace, podName, node1Name, podV4IP, egressPodLabel)
+				egressNamespace := newNamespace(eipNamespace)
+				labels := map[string]string{
+					"k8s.ovn.org/egress-assignable": "",
+				}
+				node1 := getNodeObj(node1Name, map[string]string{
+					"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\"}", node1IPv4CIDR),
+					"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":\"%s\"}", v4Node1Subnet),
+					util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", node1IPv4CIDR),
+				}, labels)
+				node2 := getNodeObj(node2Name, map[string]string{
+					"k8s.ovn.org/node-primary-ifaddr": fmt.Sprintf("{\"ipv4\": \"%s\"}", node2IPv4CIDR),
+					"k8s.ovn.org/node-subnets":        fmt.Sprintf("{\"default\":\"%s\"}", v4Node2Subnet),
+					util.OVNNodeHostCIDRs:             fmt.Sprintf("[\"%s\"]", node2IPv4CIDR),
+				}, labels)
+
+				eIP := egressipv1.EgressIP{
+					ObjectMeta: newEgressIPMeta(egressIPName),
+					Spec: egressipv1.EgressIPSpec{
+						EgressIPs: []string{egressIP1, egressIP2},
+						PodSelector: metav1.LabelSelector{
+							MatchLabels: egressPodLabel,
+						},
+						NamespaceSelector: metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"name": egressNamespace.Name,
+							},
+						},
+						Strategy: &egressipv1.EgressIPStrategy{
+							Type: egressipv1.EgressIPStrategyActiveStandby,
+							EgressIPs: []egressipv1.EgressIPPreference{
+								{EgressIP: egressIP2},
+							},
+						},
+					},
+				}
+				fakeOvn.startWithDBSetup(
+					libovsdbtest.TestSetup{
+						NBData: []libovsdbtest.TestData{
+							&nbdb.LogicalRouterPort{
+								UUID:     types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node2.Name + "-UUID",
+								Name:     types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node2.Name,
+								Networks: []string{node2LogicalRouterIfAddrV4},
+							},
+							&nbdb.LogicalRouterPort{
+								UUID:     types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node1.Name + "-UUID",
+								Name:     types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node1.Name,
+								Networks: []string{nodeLogicalRouterIfAddrV4},
+							},
+							&nbdb.LogicalRouter{
+								Name: types.OVNClusterRouter,
+								UUID: types.OVNClusterRouter + "-UUID",
+							},
+							&nbdb.LogicalRouter{
+								Name:  types.GWRouterPrefix + node1.Name,
+								UUID:  types.GWRouterPrefix + node1.Name + "-UUID",
+								Ports: []string{types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node1.Name + "-UUID"},
+							},
+							&nbdb.LogicalRouter{
+								Name:  types.GWRouterPrefix + node2.Name,
+								UUID:  types.GWRouterPrefix + node2.Name + "-UUID",
+								Ports: []string{types.GWRouterToJoinSwitchPrefix + types.GWRouterPrefix + node2.Name + "-UUID"},
+							},
+							&nbdb.LogicalSwitchPort{
+								UUID:      "k8s-" + node1Name + "-UUID",
+								Name:      "k8s-" + node1Name,
+								Addresses: []string{"fe:1a:b2:3f:0e:fb " + util.GetNodeManagementIfAddr(node1Subnet).IP.String()},
+							},
+							&nbdb.LogicalSwitchPort{
+								UUID:      "k8s-" + node2Name + "-UUID",
+								Name:      "k8s-" + node2Name,
+								Addresses: []string{"fe:1a:c2:3f:0e:fb " + util.GetNodeManagementIfAddr(node2Subnet).IP.String()},
+							},
+							&nbdb.LogicalSwitch{
+								UUID:  node1Name + "-UUID",
+								Name:  node1Name,
+								Ports: []string{"k8s-" + node1Name + "-UUID"},
+							},
+							&nbdb.LogicalSwitch{
+								UUID:  node2Name + "-UUID",
+								Name:  node2Name,
+								Ports: []string{"k8s-" + node2Name + "-UUID"},
+							},
+						},
+					},
+					&corev1.NodeList{
+						Items: []corev1.Node{node1, node2},
+					},
+					&corev1.NamespaceList{
+						Items: []corev1.Namespace{*egressNamespace},
+					},
+					&corev1.PodList{
+						Items: []corev1.Pod{egressPod},
+					})
+
+				i, n, _ := net.ParseCIDR(podV4IP + "/23")
+				n.IP = i
+				fakeOvn.controller.logicalPortCache.add(&egressPod, "", types.DefaultNetworkName, "", nil, []*net.IPNet{n})
+				err := fakeOvn.controller.WatchEgressIPNamespaces()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOvn.controller.WatchEgressIPPods()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOvn.controller.WatchEgressNodes()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				err = fakeOvn.controller.WatchEgressIP()
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				_, err = fakeOvn.fakeClient.EgressIPClient.K8sV1().EgressIPs().Create(context.TODO(), &eIP, metav1.CreateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				// NOTE: Cluster manager is the one who patches the egressIP object.
+				// For the sake of unit testing egressip zone controller we need to patch egressIP object manually
+				// There are tests in cluster-manager package covering the patch logic.
+				err = fakeOvn.controller.eIPC.patchReplaceEgressIPStatus(eIP.Name, []egressipv1.EgressIPStatusItem{
+					{
+						Node:     node1Name,
+						EgressIP: egressIP1,
+					},
+					{
+						Node:     node2Name,
+						EgressIP: egressIP2,
+					},
+				})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+				getRerouteNexthops := func() []string {
+					policies, err := libovsdbops.FindLogicalRouterPoliciesWithPredicate(fakeOvn.nbClient, func(item *nbdb.LogicalRouterPolicy) bool {
+						return item.Priority == types.EgressIPReroutePriority && item.Match == fmt.Sprintf("ip4.src == %s", podV4IP)
+					})
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+					if len(policies) != 1 {
+						return nil
+					}
+					return policies[0].Nexthops
+				}
+				getSNATExternalIPs := func() []string {
+					nats, err := libovsdbops.FindNATsWithPredicate(fakeOvn.nbClient, func(item *nbdb.NAT) bool {
+						return item.LogicalIP == podV4IP && item.Type == nbdb.NATTypeSNAT
+					})
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+					externalIPs := []string{}
+					for _, nat := range nats {
+						externalIPs = append(externalIPs, nat.ExternalIP)
+					}
+					return externalIPs
+				}
+
+				// only the preferred egress IP, hosted by node2, is used
+				gomega.Eventually(getRerouteNexthops).Should(gomega.ConsistOf("100.64.0.3"))
+				gomega.Eventually(getSNATExternalIPs).Should(gomega.ConsistOf(egressIP2))
+
+				// the active egress IP is unassigned: the standby one takes over
+				err = fakeOvn.controller.eIPC.patchReplaceEgressIPStatus(eIP.Name, []egressipv1.EgressIPStatusItem{
+					{
+						Node:     node1Name,
+						EgressIP: egressIP1,
+					},
+				})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getRerouteNexthops).Should(gomega.ConsistOf("100.64.0.2"))
+				gomega.Eventually(getSNATExternalIPs).Should(gomega.ConsistOf(egressIP1))
+
+				// the active egress IP is assigned again: traffic moves back to it
+				err = fakeOvn.controller.eIPC.patchReplaceEgressIPStatus(eIP.Name, []egressipv1.EgressIPStatusItem{
+					{
+						Node:     node1Name,
+						EgressIP: egressIP1,
+					},
+					{
+						Node:     node2Name,
+						EgressIP: egressIP2,
+					},
+				})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getRerouteNexthops).Should(gomega.ConsistOf("100.64.0.3"))
+				gomega.Eventually(getSNATExternalIPs).Should(gomega.ConsistOf(egressIP2))
+
+				// without strategy, both egress IPs are used
+				latest, err := fakeOvn.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), eIP.Name, metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				latest.Spec.Strategy = nil
+				_, err = fakeOvn.fakeClient.EgressIPClient.K8sV1().EgressIPs().Update(context.TODO(), latest, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getRerouteNexthops).Should(gomega.ConsistOf("100.64.0.2", "100.64.0.3"))
+				gomega.Eventually(getSNATExternalIPs).Should(gomega.ConsistOf(egressIP1, egressIP2))
+
+				// the strategy is set again: only the active egress IP is used
+				latest, err = fakeOvn.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), eIP.Name, metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				latest.Spec.Strategy = eIP.Spec.Strategy
+				_, err = fakeOvn.fakeClient.EgressIPClient.K8sV1().EgressIPs().Update(context.TODO(), latest, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getRerouteNexthops).Should(gomega.ConsistOf("100.64.0.3"))
+				gomega.Eventually(getSNATExternalIPs).Should(gomega.ConsistOf(egressIP2))
+
+				// the strategy is removed while the active egress IP moves to
+				// node1: the standby egress IP, whose status did not change, is
+				// used again as well
+				latest, err = fakeOvn.fakeClient.EgressIPClient.K8sV1().EgressIPs().Get(context.TODO(), eIP.Name, metav1.GetOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				latest.Spec.Strategy = nil
+				latest.Status.Items = []egressipv1.EgressIPStatusItem{
+					{
+						Node:     node1Name,
+						EgressIP: egressIP1,
+					},
+					{
+						Node:     node1Name,
+						EgressIP: egressIP2,
+					},
+				}
+				_, err = fakeOvn.fakeClient.EgressIPClient.K8sV1().EgressIPs().Update(context.TODO(), latest, metav1.UpdateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				gomega.Eventually(getRerouteNexthops).Should(gomega.ConsistOf("100.64.0.2"))
+				gomega.Eventually(getSNATExternalIPs).Should(gomega.ConsistOf(egressIP1, egressIP2))
+				return nil
+			}
+
+			err := app.Run([]string{app.Name})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
 		ginkgo.It("should delete and re-create and delete", func() {
 			app.Action = func(*cli.Context) error {
 
@@ -6985,7 +7216,7 @@ var _ = ginkgo.Describe("OVN master EgressIP Operations cluster default network"
 				// recreate pod with same name immediately; simulating handler race (pods v/s egressip) condition,
 				// so instead of proper pod create, we try out egressIP pod setup which will be a no-op since pod doesn't exist
 				ginkgo.By("should not add egress IP setup for a deleted pod whose entry exists in logicalPortCache")
-				err = fakeOvn.controller.eIPC.addPodEgressIPAssignments(fakeOvn.controller, egressIPName, eIP.Status.Items, util.EgressIPMark{}, &egressPod1)
+				err = fakeOvn.controller.eIPC.addPodEgressIPAssignments(fakeOvn.controller, egressIPName, eIP.Status.Items, util.EgressIPMark{}, &eIP.Spec, &egressPod1)
 				gomega.Expect(err).To(gomega.HaveOccurred())
 				// pod is gone but logicalPortCache holds the entry for 60seconds
 				egressPodPortInfo, err = fakeOvn.controller.logicalPortCache.get(&egressPod1, types.DefaultNetworkName)
@@ -75,15 +75,15 @@ func getEgressQosAddrSetDbIDs(namespace, priority, controller string) *libovsdbo
 	})
 }
 
-func getEgressQoSRuleDbIDs(namespace string, rulePriority int) *libovsdbops.DbObjectIDs {
-	return libovsdbops.NewDbObjectIDs(libovsdbops.QoSEgressQoS, DefaultNetworkControllerName, map[libovsdbops.ExternalIDKey]string{
+func getEgressQoSRuleDbIDs(namespace string, rulePriority int, controller string) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.QoSEgressQoS, controller, map[libovsdbops.ExternalIDKey]string{
 		libovsdbops.ObjectNameKey: namespace,
 		libovsdbops.PriorityKey:   fmt.Sprintf("%d", rulePriority),
 	})
 }
 
 // shallow copies the EgressQoS object provided.
-func (oc *DefaultNetworkController) cloneEgressQoS(raw *egressqosapi.EgressQoS) (*egressQoS, error) {
+func (oc *BaseNetworkController) cloneEgressQoS(raw *egressqosapi.EgressQoS) (*egressQoS, error) {
 	eq := &egressQoS{
 		name:      raw.Name,
 		namespace: raw.Namespace,
@@ -113,7 +113,7 @@ func (oc *DefaultNetworkController) cloneEgressQoS(raw *egressqosapi.EgressQoS)
 }
 
 // shallow copies the EgressQoSRule object provided.
-func (oc *DefaultNetworkController) cloneEgressQoSRule(raw egressqosapi.EgressQoSRule, priority int) (*egressQoSRule, error) {
+func (oc *BaseNetworkController) cloneEgressQoSRule(raw egressqosapi.EgressQoSRule, priority int) (*egressQoSRule, error) {
 	dst := ""
 	if raw.DstCIDR != nil {
 		_, _, err := net.ParseCIDR(*raw.DstCIDR)
@@ -138,7 +138,7 @@ func (oc *DefaultNetworkController) cloneEgressQoSRule(raw egressqosapi.EgressQo
 	return eqr, nil
 }
 
-func (oc *DefaultNetworkController) createASForEgressQoSRule(podSelector metav1.LabelSelector, namespace string, priority int) (addressset.AddressSet, *sync.Map, error) {
+func (oc *BaseNetworkController) createASForEgressQoSRule(podSelector metav1.LabelSelector, namespace string, priority int) (addressset.AddressSet, *sync.Map, error) {
 	var addrSet addressset.AddressSet
 
 	selector, err := metav1.LabelSelectorAsSelector(&podSelector)
@@ -186,11 +186,11 @@ func (oc *DefaultNetworkController) createASForEgressQoSRule(podSelector metav1.
 }
 
 // initEgressQoSController initializes the EgressQoS controller.
-func (oc *DefaultNetworkController) initEgressQoSController(
+func (oc *BaseNetworkController) initEgressQoSController(
 	eqInformer egressqosinformer.EgressQoSInformer,
 	podInformer v1coreinformers.PodInformer,
 	nodeInformer v1coreinformers.NodeInformer) error {
-	klog.Info("Setting up event handlers for EgressQoS")
+	klog.Infof("Setting up event handlers for EgressQoS for network %s", oc.GetNetworkName())
 	oc.egressQoSLister = eqInformer.Lister()
 	oc.egressQoSSynced = eqInformer.Informer().HasSynced
 	oc.egressQoSQueue = workqueue.NewTypedRateLimitingQueueWithConfig(
@@ -239,10 +239,10 @@ func (oc *DefaultNetworkController) initEgressQoSController(
 	return nil
 }
 
-func (oc *DefaultNetworkController) runEgressQoSController(wg *sync.WaitGroup, threadiness int, stopCh <-chan struct{}) error {
+func (oc *BaseNetworkController) runEgressQoSController(wg *sync.WaitGroup, threadiness int, stopCh <-chan struct{}) error {
 	defer utilruntime.HandleCrash()
 
-	klog.Infof("Starting EgressQoS Controller")
+	klog.Infof("Starting EgressQoS Controller for network %s", oc.GetNetworkName())
 
 	if !util.WaitForInformerCacheSyncWithTimeout("egressqosnodes", stopCh, oc.egressQoSNodeSynced) {
 		return fmt.Errorf("timed out waiting for egress QoS node caches to sync")
@@ -299,7 +299,7 @@ func (oc *DefaultNetworkController) runEgressQoSController(wg *sync.WaitGroup, t
 		// wait until we're told to stop
 		<-stopCh
 
-		klog.Infof("Shutting down EgressQoS controller")
+		klog.Infof("Shutting down EgressQoS controller for network %s", oc.GetNetworkName())
 		oc.egressQoSQueue.ShutDown()
 		oc.egressQoSPodQueue.ShutDown()
 		oc.egressQoSNodeQueue.ShutDown()
@@ -309,7 +309,7 @@ func (oc *DefaultNetworkController) runEgressQoSController(wg *sync.WaitGroup, t
 }
 
 // onEgressQoSAdd queues the EgressQoS for processing.
-func (oc *DefaultNetworkController) onEgressQoSAdd(obj interface{}) {
+func (oc *BaseNetworkController) onEgressQoSAdd(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
 	if err != nil {
 		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
@@ -319,7 +319,7 @@ func (oc *DefaultNetworkController) onEgressQoSAdd(obj interface{}) {
 }
 
 // onEgressQoSUpdate queues the EgressQoS for processing.
-func (oc *DefaultNetworkController) onEgressQoSUpdate(oldObj, newObj interface{}) {
+func (oc *BaseNetworkController) onEgressQoSUpdate(oldObj, newObj interface{}) {
 	oldEQ := oldObj.(*egressqosapi.EgressQoS)
 	newEQ := newObj.(*egressqosapi.EgressQoS)
 
@@ -335,7 +335,7 @@ func (oc *DefaultNetworkController) onEgressQoSUpdate(oldObj, newObj interface{}
 }
 
 // onEgressQoSDelete queues the EgressQoS for processing.
-func (oc *DefaultNetworkController) onEgressQoSDelete(obj interface{}) {
+func (oc *BaseNetworkController) onEgressQoSDelete(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
 	if err != nil {
 		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
@@ -344,12 +344,12 @@ func (oc *DefaultNetworkController) onEgressQoSDelete(obj interface{}) {
 	oc.egressQoSQueue.Add(key)
 }
 
-func (oc *DefaultNetworkController) runEgressQoSWorker(wg *sync.WaitGroup) {
+func (oc *BaseNetworkController) runEgressQoSWorker(wg *sync.WaitGroup) {
 	for oc.processNextEgressQoSWorkItem(wg) {
 	}
 }
 
-func (oc *DefaultNetworkController) processNextEgressQoSWorkItem(wg *sync.WaitGroup) bool {
+func (oc *BaseNetworkController) processNextEgressQoSWorkItem(wg *sync.WaitGroup) bool {
 	wg.Add(1)
 	defer wg.Done()
 
@@ -367,9 +367,24 @@ func (oc *DefaultNetworkController) processNextEgressQoSWorkItem(wg *sync.WaitGr
 		return true
 	}
 
-	err = oc.syncEgressQoS(key, eq)
+	// the EgressQoS is only handled by the controller of the primary network of its namespace,
+	// the other controllers only clean up what they might have configured for it.
+	served := true
+	if eq != nil {
+		served, err = oc.isEgressQoSNamespaceServed(eq.Namespace)
+	}
+	if err == nil {
+		if served {
+			err = oc.syncEgressQoS(key, eq)
+		} else {
+			err = oc.syncEgressQoS(key, nil)
+		}
+	}
 	if err == nil {
 		oc.egressQoSQueue.Forget(key)
+		if !served {
+			return true
+		}
 		if err = oc.updateEgressQoSZoneStatusToReady(eq); err != nil {
 			utilruntime.HandleError(fmt.Errorf("failed to update EgressQoS object %s with status: %v", key, err))
 		}
@@ -383,18 +398,35 @@ func (oc *DefaultNetworkController) processNextEgressQoSWorkItem(wg *sync.WaitGr
 		return true
 	}
 
-	if err = oc.updateEgressQoSZoneStatusToNotReady(eq, err); err != nil {
-		utilruntime.HandleError(fmt.Errorf("failed to update EgressQoS object %s with status: %v", key, err))
+	if served {
+		if err = oc.updateEgressQoSZoneStatusToNotReady(eq, err); err != nil {
+			utilruntime.HandleError(fmt.Errorf("failed to update EgressQoS object %s with status: %v", key, err))
+		}
 	}
 
 	oc.egressQoSQueue.Forget(key)
 	return true
 }
 
+// isEgressQoSNamespaceServed returns true if the given namespace uses the network of this controller as its
+// primary network, and hence if the EgressQoS of the namespace should be applied to the switches of this network.
+func (oc *BaseNetworkController) isEgressQoSNamespaceServed(namespace string) (bool, error) {
+	netInfo, err := oc.networkManager.GetActiveNetworkForNamespace(namespace)
+	if err != nil {
+		// The InvalidPrimaryNetworkError is returned when the UDN of the namespace does not exist, and
+		// NotFound when the namespace does not exist: no network serves the namespace in both cases.
+		if util.IsInvalidPrimaryNetworkError(err) || apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("could not get active network for namespace %s: %w", namespace, err)
+	}
+	return netInfo.GetNetworkName() == oc.GetNetworkName(), nil
+}
+
 // This takes care of syncing stale data which we might have in OVN if
 // there's no ovnkube-master running for a while.
 // It deletes all QoSes and Address Sets from OVN that belong to deleted EgressQoSes.
-func (oc *DefaultNetworkController) repairEgressQoSes() error {
+func (oc *BaseNetworkController) repairEgressQoSes() error {
 	startTime := time.Now()
 	klog.V(4).Infof("Starting repairing loop for egressqos")
 	defer func() {
@@ -408,7 +440,11 @@ func (oc *DefaultNetworkController) repairEgressQoSes() error {
 
 	nsWithQoS := map[string]bool{}
 	for _, q := range existing {
-		nsWithQoS[q.Namespace] = true
+		served, err := oc.isEgressQoSNamespaceServed(q.Namespace)
+		if err != nil {
+			return err
+		}
+		nsWithQoS[q.Namespace] = served
 	}
 	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.QoSEgressQoS, oc.controllerName, nil)
 	predicateQoSFunc := func(q *nbdb.QoS) bool {
@@ -454,7 +490,7 @@ func (oc *DefaultNetworkController) repairEgressQoSes() error {
 	return nil
 }
 
-func (oc *DefaultNetworkController) syncEgressQoS(key string, eq *egressqosapi.EgressQoS) error {
+func (oc *BaseNetworkController) syncEgressQoS(key string, eq *egressqosapi.EgressQoS) error {
 	startTime := time.Now()
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -487,7 +523,7 @@ func (oc *DefaultNetworkController) syncEgressQoS(key string, eq *egressqosapi.E
 	return oc.addEgressQoS(eq)
 }
 
-func (oc *DefaultNetworkController) getEgressQoS(key string) (*egressqosapi.EgressQoS, error) {
+func (oc *BaseNetworkController) getEgressQoS(key string) (*egressqosapi.EgressQoS, error) {
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
 		return nil, err
@@ -500,7 +536,7 @@ func (oc *DefaultNetworkController) getEgressQoS(key string) (*egressqosapi.Egre
 	return eq, nil
 }
 
-func (oc *DefaultNetworkController) cleanEgressQoSNS(namespace string) error {
+func (oc *BaseNetworkController) cleanEgressQoSNS(namespace string) error {
 	obj, loaded := oc.egressQoSCache.Load(namespace)
 	if !loaded {
 		// the namespace is clean
@@ -566,7 +602,7 @@ func (oc *DefaultNetworkController) cleanEgressQoSNS(namespace string) error {
 	return nil
 }
 
-func (oc *DefaultNetworkController) addEgressQoS(eqObj *egressqosapi.EgressQoS) error {
+func (oc *BaseNetworkController) addEgressQoS(eqObj *egressqosapi.EgressQoS) error {
 	eq, err := oc.cloneEgressQoS(eqObj)
 	if err != nil {
 		return err
@@ -605,7 +641,7 @@ func (oc *DefaultNetworkController) addEgressQoS(eqObj *egressqosapi.EgressQoS)
 			Match:       match,
 			Priority:    r.priority,
 			Action:      map[string]int{nbdb.QoSActionDSCP: r.dscp},
-			ExternalIDs: getEgressQoSRuleDbIDs(eq.namespace, r.priority).GetExternalIDs(),
+			ExternalIDs: getEgressQoSRuleDbIDs(eq.namespace, r.priority, oc.controllerName).GetExternalIDs(),
 		}
 		qoses = append(qoses, qos)
 	}
@@ -656,13 +692,22 @@ func generateEgressQoSMatch(eq *egressQoSRule, hashedAddressSetNameIPv4, hashedA
 	return fmt.Sprintf("(%s) && %s", dst, src)
 }
 
-func (oc *DefaultNetworkController) egressQoSSwitches() ([]string, error) {
+func (oc *BaseNetworkController) egressQoSSwitches() ([]string, error) {
 	logicalSwitches := []string{}
 
-	// Find all node switches
+	// the switches of the default network have no network external ID
+	networkName := ""
+	if oc.IsSecondary() {
+		networkName = oc.GetNetworkName()
+	}
+	// Find all node switches of the network
 	p := func(item *nbdb.LogicalSwitch) bool {
-		// Ignore external and Join switches(both legacy and current)
-		return !(strings.HasPrefix(item.Name, types.JoinSwitchPrefix) || oc.RemoveNetworkScopeFromName(item.Name) == types.OVNJoinSwitch || item.Name == types.TransitSwitch || strings.HasPrefix(item.Name, types.ExternalSwitchPrefix))
+		if item.ExternalIDs[types.NetworkExternalID] != networkName {
+			return false
+		}
+		// Ignore external, transit and Join switches(both legacy and current)
+		return !(strings.HasPrefix(item.Name, types.JoinSwitchPrefix) || item.Name == oc.GetNetworkScopedJoinSwitchName() ||
+			item.Name == oc.GetNetworkScopedName(types.TransitSwitch) || strings.HasPrefix(item.Name, types.ExternalSwitchPrefix))
 	}
 
 	nodeLocalSwitches, err := libovsdbops.FindLogicalSwitchesWithPredicate(oc.nbClient, p)
@@ -689,7 +734,7 @@ type mapAndOp struct {
 	op mapOp
 }
 
-func (oc *DefaultNetworkController) syncEgressQoSPod(key string) error {
+func (oc *BaseNetworkController) syncEgressQoSPod(key string) error {
 	startTime := time.Now()
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -810,7 +855,7 @@ func (oc *DefaultNetworkController) syncEgressQoSPod(key string) error {
 }
 
 // onEgressQoSPodAdd queues the pod for processing.
-func (oc *DefaultNetworkController) onEgressQoSPodAdd(obj interface{}) {
+func (oc *BaseNetworkController) onEgressQoSPodAdd(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
 	if err != nil {
 		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
@@ -835,7 +880,7 @@ func (oc *DefaultNetworkController) onEgressQoSPodAdd(obj interface{}) {
 }
 
 // onEgressQoSPodUpdate queues the pod for processing.
-func (oc *DefaultNetworkController) onEgressQoSPodUpdate(oldObj, newObj interface{}) {
+func (oc *BaseNetworkController) onEgressQoSPodUpdate(oldObj, newObj interface{}) {
 	oldPod := oldObj.(*corev1.Pod)
 	newPod := newObj.(*corev1.Pod)
 
@@ -869,7 +914,7 @@ func (oc *DefaultNetworkController) onEgressQoSPodUpdate(oldObj, newObj interfac
 	oc.egressQoSPodQueue.Add(key)
 }
 
-func (oc *DefaultNetworkController) onEgressQoSPodDelete(obj interface{}) {
+func (oc *BaseNetworkController) onEgressQoSPodDelete(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
 	if err != nil {
 		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
@@ -893,12 +938,12 @@ func (oc *DefaultNetworkController) onEgressQoSPodDelete(obj interface{}) {
 	oc.egressQoSPodQueue.Add(key)
 }
 
-func (oc *DefaultNetworkController) runEgressQoSPodWorker(wg *sync.WaitGroup) {
+func (oc *BaseNetworkController) runEgressQoSPodWorker(wg *sync.WaitGroup) {
 	for oc.processNextEgressQoSPodWorkItem(wg) {
 	}
 }
 
-func (oc *DefaultNetworkController) processNextEgressQoSPodWorkItem(wg *sync.WaitGroup) bool {
+func (oc *BaseNetworkController) processNextEgressQoSPodWorkItem(wg *sync.WaitGroup) bool {
 	wg.Add(1)
 	defer wg.Done()
 	key, quit := oc.egressQoSPodQueue.Get()
@@ -925,7 +970,7 @@ func (oc *DefaultNetworkController) processNextEgressQoSPodWorkItem(wg *sync.Wai
 }
 
 // onEgressQoSAdd queues the node for processing.
-func (oc *DefaultNetworkController) onEgressQoSNodeAdd(obj interface{}) {
+func (oc *BaseNetworkController) onEgressQoSNodeAdd(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
 	if err != nil {
 		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
@@ -939,7 +984,7 @@ func (oc *DefaultNetworkController) onEgressQoSNodeAdd(obj interface{}) {
 }
 
 // onEgressQoSNodeUpdate queues the node for processing if it changed zones
-func (oc *DefaultNetworkController) onEgressQoSNodeUpdate(oldObj, newObj interface{}) {
+func (oc *BaseNetworkController) onEgressQoSNodeUpdate(oldObj, newObj interface{}) {
 	oldNode := oldObj.(*corev1.Node)
 	newNode := newObj.(*corev1.Node)
 	if oldNode.ResourceVersion == newNode.ResourceVersion ||
@@ -967,12 +1012,12 @@ func (oc *DefaultNetworkController) onEgressQoSNodeUpdate(oldObj, newObj interfa
 	oc.egressQoSNodeQueue.Add(key)
 }
 
-func (oc *DefaultNetworkController) runEgressQoSNodeWorker(wg *sync.WaitGroup) {
+func (oc *BaseNetworkController) runEgressQoSNodeWorker(wg *sync.WaitGroup) {
 	for oc.processNextEgressQoSNodeWorkItem(wg) {
 	}
 }
 
-func (oc *DefaultNetworkController) processNextEgressQoSNodeWorkItem(wg *sync.WaitGroup) bool {
+func (oc *BaseNetworkController) processNextEgressQoSNodeWorkItem(wg *sync.WaitGroup) bool {
 	wg.Add(1)
 	defer wg.Done()
 	key, quit := oc.egressQoSNodeQueue.Get()
@@ -998,7 +1043,7 @@ func (oc *DefaultNetworkController) processNextEgressQoSNodeWorkItem(wg *sync.Wa
 	return true
 }
 
-func (oc *DefaultNetworkController) syncEgressQoSNode(key string) error {
+func (oc *BaseNetworkController) syncEgressQoSNode(key string) error {
 	startTime := time.Now()
 	_, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
@@ -1053,7 +1098,7 @@ func (oc *DefaultNetworkController) syncEgressQoSNode(key string) error {
 
 // updateEgressQoSZoneStatusToReady updates the status of the EgressQoS to reflect that it is ready
 // Each zone's ovnkube-controller will call this, hence let's update status using server side apply.
-func (oc *DefaultNetworkController) updateEgressQoSZoneStatusToReady(egressQoS *egressqosapi.EgressQoS) error {
+func (oc *BaseNetworkController) updateEgressQoSZoneStatusToReady(egressQoS *egressqosapi.EgressQoS) error {
 	if egressQoS == nil {
 		return nil
 	}
@@ -1069,7 +1114,7 @@ func (oc *DefaultNetworkController) updateEgressQoSZoneStatusToReady(egressQoS *
 
 // updateEgressQoSZoneStatusToNotReady updates the status of the EgressQoS to reflect that it is not ready
 // Each zone's ovnkube-controller will call this, hence let's update status using server side apply.
-func (oc *DefaultNetworkController) updateEgressQoSZoneStatusToNotReady(egressQoS *egressqosapi.EgressQoS,
+func (oc *BaseNetworkController) updateEgressQoSZoneStatusToNotReady(egressQoS *egressqosapi.EgressQoS,
 	handlerErr error) error {
 	if egressQoS == nil {
 		return nil
@@ -1084,7 +1129,7 @@ func (oc *DefaultNetworkController) updateEgressQoSZoneStatusToNotReady(egressQo
 	return oc.updateEgressQoSZoneStatusCondition(notReadyCondition, egressQoS.Namespace, egressQoS.Name)
 }
 
-func (oc *DefaultNetworkController) updateEgressQoSZoneStatusCondition(newCondition metav1.Condition,
+func (oc *BaseNetworkController) updateEgressQoSZoneStatusCondition(newCondition metav1.Condition,
 	namespace, name string) error {
 	eq, err := oc.egressQoSLister.EgressQoSes(namespace).Get(name)
 	if err != nil {
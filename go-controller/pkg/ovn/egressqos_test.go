@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	nadapi "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 	"github.com/urfave/cli/v2"
@@ -22,8 +23,10 @@ import (
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	addressset "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/ovn/address_set"
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
 	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
 func newEgressQoSObject(name, namespace string, egressRules []egressqosapi.EgressQoSRule) *egressqosapi.EgressQoS {
@@ -84,7 +87,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					Match:       "some-match",
 					Priority:    EgressQoSFlowStartPriority,
 					Action:      map[string]int{nbdb.QoSActionDSCP: 50},
-					ExternalIDs: getEgressQoSRuleDbIDs("staleNS", EgressQoSFlowStartPriority).GetExternalIDs(),
+					ExternalIDs: getEgressQoSRuleDbIDs("staleNS", EgressQoSFlowStartPriority, controllerName).GetExternalIDs(),
 					UUID:        "staleQoS-UUID",
 				}
 				staleAddrSet, _ := addressset.GetTestDbAddrSets(
@@ -148,7 +151,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					Match:       match1,
 					Priority:    EgressQoSFlowStartPriority,
 					Action:      map[string]int{nbdb.QoSActionDSCP: 50},
-					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, controllerName).GetExternalIDs(),
 					UUID:        "qos1-UUID",
 				}
 				qos2 := &nbdb.QoS{
@@ -156,7 +159,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					Match:       match2,
 					Priority:    EgressQoSFlowStartPriority - 1,
 					Action:      map[string]int{nbdb.QoSActionDSCP: 60},
-					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1).GetExternalIDs(),
+					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1, controllerName).GetExternalIDs(),
 					UUID:        "qos2-UUID",
 				}
 				node1Switch.QOSRules = []string{qos1.UUID, qos2.UUID}
@@ -190,7 +193,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					Match:       match1,
 					Priority:    EgressQoSFlowStartPriority,
 					Action:      map[string]int{nbdb.QoSActionDSCP: 40},
-					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, controllerName).GetExternalIDs(),
 					UUID:        "qos3-UUID",
 				}
 				node1Switch.QOSRules = []string{qos3.UUID}
@@ -255,7 +258,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					Match:       "some-match",
 					Priority:    EgressQoSFlowStartPriority,
 					Action:      map[string]int{nbdb.QoSActionDSCP: 50},
-					ExternalIDs: getEgressQoSRuleDbIDs("staleNS", EgressQoSFlowStartPriority).GetExternalIDs(),
+					ExternalIDs: getEgressQoSRuleDbIDs("staleNS", EgressQoSFlowStartPriority, controllerName).GetExternalIDs(),
 					UUID:        "staleQoS-UUID",
 				}
 				staleAddrSet, _ := addressset.GetTestDbAddrSets(
@@ -342,7 +345,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					Match:       match1,
 					Priority:    EgressQoSFlowStartPriority,
 					Action:      map[string]int{nbdb.QoSActionDSCP: 50},
-					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, controllerName).GetExternalIDs(),
 					UUID:        "qos1-UUID",
 				}
 				qos2 := &nbdb.QoS{
@@ -350,7 +353,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					Match:       match2,
 					Priority:    EgressQoSFlowStartPriority - 1,
 					Action:      map[string]int{nbdb.QoSActionDSCP: 60},
-					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1).GetExternalIDs(),
+					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1, controllerName).GetExternalIDs(),
 					UUID:        "qos2-UUID",
 				}
 				node1Switch.QOSRules = []string{qos1.UUID, qos2.UUID}
@@ -389,7 +392,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 					Match:       match1,
 					Priority:    EgressQoSFlowStartPriority,
 					Action:      map[string]int{nbdb.QoSActionDSCP: 40},
-					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+					ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, controllerName).GetExternalIDs(),
 					UUID:        "qos3-UUID",
 				}
 				node1Switch.QOSRules = []string{qos3.UUID}
@@ -512,6 +515,130 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 		gomega.Expect(err).NotTo(gomega.HaveOccurred())
 	})
 
+	ginkgo.It("applies EgressQoS to the switches of the primary user defined network of the namespace", func() {
+		app.Action = func(*cli.Context) error {
+			config.IPv4Mode = true
+			config.OVNKubernetesFeature.EnableMultiNetwork = true
+			config.OVNKubernetesFeature.EnableNetworkSegmentation = true
+
+			udnNamespace := newUDNNamespace("udn-namespace")
+			nad := ovntest.GenerateNAD("bluenet", "rednad", udnNamespace.Name,
+				types.Layer3Topology, "100.128.0.0/16", types.NetworkRolePrimary)
+			nad.Annotations = map[string]string{types.OvnNetworkIDAnnotation: "50"}
+			netInfo, err := util.ParseNADInfo(nad)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			udnControllerName := getNetworkControllerName(netInfo.GetNetworkName())
+
+			node1Switch := &nbdb.LogicalSwitch{
+				UUID: "node1-UUID",
+				Name: node1Name,
+			}
+			udnNode1Switch := &nbdb.LogicalSwitch{
+				UUID:        "udn-node1-UUID",
+				Name:        netInfo.GetNetworkScopedSwitchName(node1Name),
+				ExternalIDs: util.GenerateExternalIDsForSwitchOrRouter(netInfo),
+			}
+			udnJoinSwitch := &nbdb.LogicalSwitch{
+				UUID:        "udn-join-UUID",
+				Name:        netInfo.GetNetworkScopedJoinSwitchName(),
+				ExternalIDs: util.GenerateExternalIDsForSwitchOrRouter(netInfo),
+			}
+			dbSetup := libovsdbtest.TestSetup{
+				NBData: []libovsdbtest.TestData{
+					node1Switch,
+					udnNode1Switch,
+					udnJoinSwitch,
+				},
+			}
+
+			dst := "1.2.3.4/32"
+			eq := newEgressQoSObject("default", namespaceT.Name, []egressqosapi.EgressQoSRule{
+				{
+					DstCIDR: &dst,
+					DSCP:    50,
+				},
+			})
+			udnEq := newEgressQoSObject("default", udnNamespace.Name, []egressqosapi.EgressQoSRule{
+				{
+					DstCIDR: &dst,
+					DSCP:    60,
+				},
+			})
+			fakeOVN.startWithDBSetup(dbSetup,
+				&corev1.NamespaceList{
+					Items: []corev1.Namespace{
+						namespaceT,
+						*udnNamespace,
+					},
+				},
+				&egressqosapi.EgressQoSList{
+					Items: []egressqosapi.EgressQoS{
+						*eq,
+						*udnEq,
+					},
+				},
+				&nadapi.NetworkAttachmentDefinitionList{
+					Items: []nadapi.NetworkAttachmentDefinition{*nad},
+				},
+			)
+			gomega.Expect(fakeOVN.networkManager.Start()).To(gomega.Succeed())
+			defer fakeOVN.networkManager.Stop()
+
+			udnController, ok := fakeOVN.secondaryControllers[netInfo.GetNetworkName()]
+			gomega.Expect(ok).To(gomega.BeTrue())
+			gomega.Expect(fakeOVN.InitAndRunEgressQoSController()).To(gomega.Succeed())
+			gomega.Expect(initAndRunEgressQoSController(fakeOVN, &udnController.bnc.BaseNetworkController)).To(gomega.Succeed())
+
+			// each EgressQoS is only applied to the node switches of the primary network of its namespace
+			udnASv4, _ := addressset.GetHashNamesForAS(getNamespaceAddrSetDbIDs(udnNamespace.Name, udnControllerName))
+			qos := &nbdb.QoS{
+				Direction:   nbdb.QoSDirectionToLport,
+				Match:       fmt.Sprintf("(ip4.dst == %s) && ip4.src == $%s", dst, asv4),
+				Priority:    EgressQoSFlowStartPriority,
+				Action:      map[string]int{nbdb.QoSActionDSCP: 50},
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, controllerName).GetExternalIDs(),
+				UUID:        "qos-UUID",
+			}
+			udnQoS := &nbdb.QoS{
+				Direction:   nbdb.QoSDirectionToLport,
+				Match:       fmt.Sprintf("(ip4.dst == %s) && ip4.src == $%s", dst, udnASv4),
+				Priority:    EgressQoSFlowStartPriority,
+				Action:      map[string]int{nbdb.QoSActionDSCP: 60},
+				ExternalIDs: getEgressQoSRuleDbIDs(udnNamespace.Name, EgressQoSFlowStartPriority, udnControllerName).GetExternalIDs(),
+				UUID:        "udn-qos-UUID",
+			}
+			node1Switch.QOSRules = []string{qos.UUID}
+			udnNode1Switch.QOSRules = []string{udnQoS.UUID}
+			expectedDatabaseState := []libovsdbtest.TestData{
+				qos,
+				udnQoS,
+				node1Switch,
+				udnNode1Switch,
+				udnJoinSwitch,
+			}
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveDataIgnoringUUIDs(expectedDatabaseState))
+			expectEgressQoSStatusMessageEventually(fakeOVN, namespaceT.Name, false)
+			expectEgressQoSStatusMessageEventually(fakeOVN, udnNamespace.Name, false)
+
+			// Delete the EgressQoS of the UDN namespace
+			err = fakeOVN.fakeClient.EgressQoSClient.K8sV1().EgressQoSes(udnNamespace.Name).Delete(context.TODO(), udnEq.Name, metav1.DeleteOptions{})
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			udnNode1Switch.QOSRules = []string{}
+			expectedDatabaseState = []libovsdbtest.TestData{
+				qos,
+				node1Switch,
+				udnNode1Switch,
+				udnJoinSwitch,
+			}
+			gomega.Eventually(fakeOVN.nbClient).Should(libovsdbtest.HaveDataIgnoringUUIDs(expectedDatabaseState))
+
+			return nil
+		}
+
+		err := app.Run([]string{app.Name})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	})
+
 	ginkgo.It("should respond to node events correctly", func() {
 		app.Action = func(*cli.Context) error {
 			namespaceT := *newNamespace("namespace1")
@@ -568,7 +695,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 1.2.3.4/32) && ip4.src == $%s", asv4),
 				Priority:    EgressQoSFlowStartPriority,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 50},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, controllerName).GetExternalIDs(),
 				UUID:        "qos1-UUID",
 			}
 			qos2 := &nbdb.QoS{
@@ -576,7 +703,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 5.6.7.8/32) && ip4.src == $%s", asv4),
 				Priority:    EgressQoSFlowStartPriority - 1,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 60},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1, controllerName).GetExternalIDs(),
 				UUID:        "qos2-UUID",
 			}
 			node1Switch.QOSRules = append(node1Switch.QOSRules, qos1.UUID, qos2.UUID)
@@ -694,7 +821,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 1.2.3.4/32) && ip4.src == $%s", asv4),
 				Priority:    EgressQoSFlowStartPriority,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 50},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, controllerName).GetExternalIDs(),
 				UUID:        "qos1-UUID",
 			}
 			qos2 := &nbdb.QoS{
@@ -702,7 +829,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 5.6.7.8/32) && ip4.src == $%s", asv4),
 				Priority:    EgressQoSFlowStartPriority - 1,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 60},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1, controllerName).GetExternalIDs(),
 				UUID:        "qos2-UUID",
 			}
 			node1Switch.QOSRules = append(node1Switch.QOSRules, qos1.UUID, qos2.UUID)
@@ -855,7 +982,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 1.2.3.4/32) && ip4.src == $%s", asv4),
 				Priority:    EgressQoSFlowStartPriority,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 40},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, controllerName).GetExternalIDs(),
 				UUID:        "qos1-UUID",
 			}
 			qosAS := getEgressQosAddrSetDbIDs(namespaceT.Name, fmt.Sprintf("%d", EgressQoSFlowStartPriority-1), controllerName)
@@ -865,7 +992,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 5.6.7.8/32) && ip4.src == $%s", qosASv4),
 				Priority:    EgressQoSFlowStartPriority - 1,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 50},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1, controllerName).GetExternalIDs(),
 				UUID:        "qos2-UUID",
 			}
 			qosAS = getEgressQosAddrSetDbIDs(namespaceT.Name, fmt.Sprintf("%d", EgressQoSFlowStartPriority-2), controllerName)
@@ -875,7 +1002,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 5.6.7.8/32) && ip4.src == $%s", qosASv4),
 				Priority:    EgressQoSFlowStartPriority - 2,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 60},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-2).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-2, controllerName).GetExternalIDs(),
 				UUID:        "qos3-UUID",
 			}
 			node1Switch.QOSRules = append(node1Switch.QOSRules, qos1.UUID, qos2.UUID, qos3.UUID)
@@ -998,7 +1125,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 1.2.3.4/32) && ip4.src == $%s", asv4),
 				Priority:    EgressQoSFlowStartPriority,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 40},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority, controllerName).GetExternalIDs(),
 				UUID:        "qos1-UUID",
 			}
 			qosAS := getEgressQosAddrSetDbIDs(namespaceT.Name, fmt.Sprintf("%d", EgressQoSFlowStartPriority-1), controllerName)
@@ -1008,7 +1135,7 @@ var _ = ginkgo.Describe("OVN EgressQoS Operations", func() {
 				Match:       fmt.Sprintf("(ip4.dst == 5.6.7.8/32) && ip4.src == $%s", qosASv4),
 				Priority:    EgressQoSFlowStartPriority - 1,
 				Action:      map[string]int{nbdb.QoSActionDSCP: 50},
-				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1).GetExternalIDs(),
+				ExternalIDs: getEgressQoSRuleDbIDs(namespaceT.Name, EgressQoSFlowStartPriority-1, controllerName).GetExternalIDs(),
 				UUID:        "qos2-UUID",
 			}
 			nodeSwitch.QOSRules = append(nodeSwitch.QOSRules, qos1.UUID, qos2.UUID)
@@ -1061,6 +1188,16 @@ func (o *FakeOVN) InitAndRunEgressQoSController() error {
 	return o.controller.runEgressQoSController(o.egressQoSWg, 1, o.stopChan)
 }
 
+// initAndRunEgressQoSController runs the EgressQoS controller of a user defined network controller, which is
+// stopped with that network controller.
+func initAndRunEgressQoSController(o *FakeOVN, bnc *BaseNetworkController) error {
+	err := bnc.initEgressQoSController(o.watcher.EgressQoSInformer(), o.watcher.PodCoreInformer(), o.watcher.NodeCoreInformer())
+	if err != nil {
+		return err
+	}
+	return bnc.runEgressQoSController(bnc.wg, 1, bnc.stopChan)
+}
+
 func createNodeAndLS(fakeOVN *FakeOVN, name, zone string) (*corev1.Node, *nbdb.LogicalSwitch, error) {
 	node := corev1.Node{
 		ObjectMeta: metav1.ObjectMeta{
@@ -63,6 +63,14 @@ func (manager *LogicalSwitchManager) AddOrUpdateSwitch(switchName string, hostSu
 	return manager.allocator.AddOrUpdateSubnet(switchName, hostSubnets, excludeSubnets...)
 }
 
+// ReserveSubnets reserves the IPs of the provided subnets on a switch so that
+// they are only allocated when explicitly requested. Only the parts of the
+// subnets that fall within the switch host subnets are reserved.
+func (manager *LogicalSwitchManager) ReserveSubnets(switchName string, reservedSubnets ...*net.IPNet) error {
+	hostSubnets := manager.GetSwitchSubnets(switchName)
+	return manager.allocator.ReserveSubnets(switchName, util.IntersectCIDRs(hostSubnets, reservedSubnets...)...)
+}
+
 // AddNoHostSubnetSwitch adds/updates a switch without any host subnets
 // to the logical switch manager
 func (manager *LogicalSwitchManager) AddNoHostSubnetSwitch(switchName string) error {
@@ -149,6 +149,34 @@ var _ = ginkgo.Describe("OVN Logical Switch Manager operations", func() {
 			gomega.Expect(err).NotTo(gomega.HaveOccurred())
 		})
 	})
+
+	ginkgo.Context("when reserving subnets", func() {
+		ginkgo.It("only reserves the parts of the subnets within the switch host subnets", func() {
+			const switchName = "testNode1"
+			err := lsManager.AddOrUpdateSwitch(switchName, ovntest.MustParseIPNets("10.1.1.0/24"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			// 10.2.0.0/24 is out of the host subnet and ignored
+			err = lsManager.ReserveSubnets(switchName, ovntest.MustParseIPNets("10.1.1.0/28", "10.2.0.0/24")...)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			ips, err := lsManager.AllocateNextIPs(switchName)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(ips).To(gomega.ConsistOf(ovntest.MustParseIPNet("10.1.1.16/24")))
+
+			// the reserved IPs can still be explicitly allocated, once
+			err = lsManager.AllocateIPs(switchName, ovntest.MustParseIPNets("10.1.1.5/24"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			gomega.Expect(lsManager.isAllocatedIP(switchName, "10.1.1.5/24")).To(gomega.BeTrue())
+
+			// a subnet containing the whole host subnet reserves all of it
+			err = lsManager.AddOrUpdateSwitch("testNode2", ovntest.MustParseIPNets("10.1.2.0/24"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			err = lsManager.ReserveSubnets("testNode2", ovntest.MustParseIPNets("10.1.0.0/16")...)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			_, err = lsManager.AllocateNextIPs("testNode2")
+			gomega.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(ipallocator.ErrFull.Error())))
+		})
+	})
 })
 
 var _ = ginkgo.Describe("OVN Logical Switch Manager operations for layer2 user defined networks", func() {
@@ -359,6 +359,23 @@ var _ = Describe("OVN Multicast with IP Address Family", func() {
 			nad.Annotations = map[string]string{types.OvnNetworkIDAnnotation: networkID}
 			return nad
 		}
+
+		secondaryNADFromTopology = func(namespace, topology, subnets string) *nadapi.NetworkAttachmentDefinition {
+			nad := ovntest.GenerateNADWithConfig(nadName, namespace, fmt.Sprintf(`
+{
+        "cniVersion": "0.4.0",
+        "name": %q,
+        "type": "ovn-k8s-cni-overlay",
+        "topology": %q,
+        "subnets": %q,
+        "netAttachDefName": %q,
+        "role": %q,
+        "multicast": true
+}
+`, networkName, topology, subnets, namespace+"/"+nadName, types.NetworkRoleSecondary))
+			nad.Annotations = map[string]string{types.OvnNetworkIDAnnotation: networkID}
+			return nad
+		}
 	)
 
 	BeforeEach(func() {
@@ -420,6 +437,12 @@ var _ = Describe("OVN Multicast with IP Address Family", func() {
 			Entry("IPv6", false, true, nil),
 			Entry("[Network Segmentation] IPv4", true, false, nadFromIPMode(namespaceName1, true, false)),
 			Entry("[Network Segmentation] IPv6", false, true, nadFromIPMode(namespaceName1, false, true)),
+			Entry("[Secondary network] layer3 IPv4", true, false,
+				secondaryNADFromTopology(namespaceName1, types.Layer3Topology, "100.128.0.0/16/24")),
+			Entry("[Secondary network] layer2 IPv4", true, false,
+				secondaryNADFromTopology(namespaceName1, types.Layer2Topology, "100.128.0.0/16")),
+			Entry("[Secondary network] localnet IPv6", false, true,
+				secondaryNADFromTopology(namespaceName1, types.LocalnetTopology, "ae70::66/60")),
 		)
 
 		DescribeTable("updates stale default Multicast ACLs", func(useIPv4, useIPv6 bool, nad *nadapi.NetworkAttachmentDefinition) {
@@ -544,6 +567,12 @@ var _ = Describe("OVN Multicast with IP Address Family", func() {
 			Entry("IPv6", false, true, nil),
 			Entry("[Network Segmentation] IPv4", true, false, nadFromIPMode(namespaceName1, true, false)),
 			Entry("[Network Segmentation] IPv6", false, true, nadFromIPMode(namespaceName1, false, true)),
+			Entry("[Secondary network] layer3 IPv4", true, false,
+				secondaryNADFromTopology(namespaceName1, types.Layer3Topology, "100.128.0.0/16/24")),
+			Entry("[Secondary network] layer2 IPv4", true, false,
+				secondaryNADFromTopology(namespaceName1, types.Layer2Topology, "100.128.0.0/16")),
+			Entry("[Secondary network] localnet IPv6", false, true,
+				secondaryNADFromTopology(namespaceName1, types.LocalnetTopology, "ae70::66/60")),
 		)
 
 		DescribeTable("updates stale namespace Multicast ACLs", func(useIPv4, useIPv6 bool, nad *nadapi.NetworkAttachmentDefinition) {
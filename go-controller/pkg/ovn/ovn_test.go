@@ -135,7 +135,7 @@ func (o *FakeOVN) start(objects ...runtime.Object) {
 		switch o := object.(type) {
 		case *egressip.EgressIPList:
 			egressIPObjects = append(egressIPObjects, object)
-		case *egressfirewall.EgressFirewallList:
+		case *egressfirewall.EgressFirewallList, *egressfirewall.ClusterEgressFirewallList:
 			egressFirewallObjects = append(egressFirewallObjects, object)
 		case *ocpnetworkapiv1alpha1.DNSNameResolverList:
 			dnsNameResolverObjects = append(dnsNameResolverObjects, object)
@@ -529,6 +529,7 @@ func (o *FakeOVN) NewSecondaryNetworkController(netattachdef *nettypes.NetworkAt
 				Kube:                 kube.Kube{KClient: o.fakeClient.KubeClient},
 				EIPClient:            o.fakeClient.EgressIPClient,
 				EgressFirewallClient: o.fakeClient.EgressFirewallClient,
+				EgressQoSClient:      o.fakeClient.EgressQoSClient,
 				IPAMClaimsClient:     o.fakeClient.IPAMClaimsClient,
 			},
 			o.watcher,
@@ -376,6 +376,7 @@ func NewSecondaryLayer2NetworkController(
 				oc.kube,
 				oc.GetNetInfo(),
 				oc.watchFactory.IPAMClaimsInformer().Lister(),
+				nil,
 			)
 			oc.ipamClaimsReconciler = ipamClaimsReconciler
 			claimsReconciler = ipamClaimsReconciler
@@ -387,9 +388,9 @@ func NewSecondaryLayer2NetworkController(
 			claimsReconciler)
 	}
 
-	// enable multicast support for UDN only for primaries + multicast enabled
-	// TBD: changes needs to be made to support multicast beyond primary UDN
-	oc.multicastSupport = oc.IsPrimaryNetwork() && util.IsNetworkSegmentationSupportEnabled() && config.EnableMulticast
+	// enable multicast support for primary UDNs when multicast is enabled, and
+	// for secondary networks which opt in through their NAD
+	oc.multicastSupport = isMulticastSupportedForUserDefinedNetwork(oc.GetNetInfo())
 
 	oc.initRetryFramework()
 	return oc, nil
@@ -493,8 +494,9 @@ func (oc *SecondaryLayer2NetworkController) init() error {
 		return err
 	}
 
-	// Configure cluster port groups and multicast default policies for user defined primary networks.
-	if oc.IsPrimaryNetwork() && util.IsNetworkSegmentationSupportEnabled() {
+	// Configure cluster port groups and multicast default policies for user defined primary networks,
+	// and for secondary networks with multicast enabled.
+	if oc.IsPrimaryNetwork() && util.IsNetworkSegmentationSupportEnabled() || oc.multicastSupport {
 		if err := oc.setupClusterPortGroups(); err != nil {
 			return fmt.Errorf("failed to create cluster port groups for network %q: %w", oc.GetNetworkName(), err)
 		}
@@ -540,6 +542,11 @@ func (oc *SecondaryLayer2NetworkController) initRetryFramework() {
 		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
 		oc.retryMultiNetworkPolicies = oc.newRetryFramework(factory.MultiNetworkPolicyType)
 	}
+
+	// Multicast is allowed per namespace, based on the namespace annotation.
+	if oc.multicastSupport {
+		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
+	}
 }
 
 // newRetryFramework builds and returns a retry framework for the input resource type;
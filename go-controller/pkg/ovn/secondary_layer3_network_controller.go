@@ -398,9 +398,9 @@ func NewSecondaryLayer3NetworkController(
 		oc.podAnnotationAllocator = podAnnotationAllocator
 	}
 
-	// enable multicast support for UDN only for primaries + multicast enabled
-	// TBD: changes needs to be made to support multicast beyond primary UDN
-	oc.multicastSupport = oc.IsPrimaryNetwork() && util.IsNetworkSegmentationSupportEnabled() && config.EnableMulticast
+	// enable multicast support for primary UDNs when multicast is enabled, and
+	// for secondary networks which opt in through their NAD
+	oc.multicastSupport = isMulticastSupportedForUserDefinedNetwork(oc.GetNetInfo())
 
 	oc.initRetryFramework()
 	return oc, nil
@@ -424,6 +424,11 @@ func (oc *SecondaryLayer3NetworkController) initRetryFramework() {
 		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
 		oc.retryMultiNetworkPolicies = oc.newRetryFramework(factory.MultiNetworkPolicyType)
 	}
+
+	// Multicast is allowed per namespace, based on the namespace annotation.
+	if oc.multicastSupport {
+		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
+	}
 }
 
 // newRetryFramework builds and returns a retry framework for the input resource type;
@@ -602,6 +607,19 @@ func (oc *SecondaryLayer3NetworkController) run() error {
 		}
 	}
 
+	if config.OVNKubernetesFeature.EnableEgressQoS && oc.IsPrimaryNetwork() {
+		err := oc.initEgressQoSController(
+			oc.watchFactory.EgressQoSInformer(),
+			oc.watchFactory.PodCoreInformer(),
+			oc.watchFactory.NodeCoreInformer())
+		if err != nil {
+			return err
+		}
+		if err = oc.runEgressQoSController(oc.wg, 1, oc.stopChan); err != nil {
+			return err
+		}
+	}
+
 	// Add ourselves to the route import manager
 	if oc.routeImportManager != nil {
 		err := oc.routeImportManager.AddNetwork(oc.GetNetInfo())
@@ -684,6 +702,17 @@ func (oc *SecondaryLayer3NetworkController) init() error {
 		}
 	}
 
+	// Secondary networks only need the cluster port groups for their default multicast policies.
+	if !oc.IsPrimaryNetwork() && oc.multicastSupport {
+		if err := oc.setupClusterPortGroups(); err != nil {
+			return fmt.Errorf("failed to create cluster port groups for network %q: %w", oc.GetNetworkName(), err)
+		}
+
+		if err := oc.syncDefaultMulticastPolicies(); err != nil {
+			return fmt.Errorf("failed to sync default multicast policies for network %q: %w", oc.GetNetworkName(), err)
+		}
+	}
+
 	// FIXME: When https://github.com/ovn-org/libovsdb/issues/235 is fixed,
 	// use IsTableSupported(nbdb.LoadBalancerGroup).
 	if _, _, err := util.RunOVNNbctl("--columns=_uuid", "list", "Load_Balancer_Group"); err != nil {
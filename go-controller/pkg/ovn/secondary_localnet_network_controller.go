@@ -4,17 +4,20 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
 	mnpapi "github.com/k8snetworkplumbingwg/multi-networkpolicy/pkg/apis/k8s.cni.cncf.io/v1beta1"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/pod"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/factory"
 	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/metrics"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/networkmanager"
@@ -27,6 +30,13 @@ import (
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
 )
 
+const (
+	// vlanTrunkTaggedACL drops tagged traffic for VLANs not allowed in the trunk
+	vlanTrunkTaggedACL = "tagged"
+	// vlanTrunkUntaggedACL drops untagged traffic when the trunk has no native VLAN
+	vlanTrunkUntaggedACL = "untagged"
+)
+
 type secondaryLocalnetNetworkControllerEventHandler struct {
 	baseHandler  baseNetworkControllerEventHandler
 	watchFactory *factory.WatchFactory
@@ -231,6 +241,7 @@ func NewSecondaryLocalnetNetworkController(
 				oc.kube,
 				oc.GetNetInfo(),
 				oc.watchFactory.IPAMClaimsInformer().Lister(),
+				nil,
 			)
 			oc.ipamClaimsReconciler = ipamClaimsReconciler
 			claimsReconciler = ipamClaimsReconciler
@@ -242,9 +253,9 @@ func NewSecondaryLocalnetNetworkController(
 			claimsReconciler)
 	}
 
-	// disable multicast support for secondary networks
-	// TBD: changes needs to be made to support multicast in secondary networks
-	oc.multicastSupport = false
+	// enable multicast support for primary UDNs when multicast is enabled, and
+	// for secondary networks which opt in through their NAD
+	oc.multicastSupport = isMulticastSupportedForUserDefinedNetwork(oc.GetNetInfo())
 
 	oc.initRetryFramework()
 	return oc
@@ -304,7 +315,112 @@ func (oc *SecondaryLocalnetNetworkController) init() error {
 		return err
 	}
 
-	return nil
+	if oc.multicastSupport {
+		if err := oc.setupClusterPortGroups(); err != nil {
+			return fmt.Errorf("failed to create cluster port groups for network %q: %w", oc.GetNetworkName(), err)
+		}
+
+		if err := oc.syncDefaultMulticastPolicies(); err != nil {
+			return fmt.Errorf("failed to sync default multicast policies for network %q: %w", oc.GetNetworkName(), err)
+		}
+	}
+
+	return oc.syncVLANTrunk(switchName)
+}
+
+// syncVLANTrunk lets the pods send and receive tagged traffic for the allowed VLANs when the network is a
+// VLAN trunk, and removes that configuration otherwise.
+// In trunk mode the localnet port is untagged, so the tagged traffic reaches the physical network as is.
+func (oc *SecondaryLocalnetNetworkController) syncVLANTrunk(switchName string) error {
+	var acls []*nbdb.ACL
+	vlanPassthru := ""
+	if vlanTrunk := oc.VlanTrunk(); vlanTrunk != nil {
+		vlanPassthru = "true"
+		acls = oc.buildVLANTrunkACLs(vlanTrunk)
+	}
+
+	// OVN drops tagged traffic coming from the pods unless vlan-passthru is enabled
+	logicalSwitch := &nbdb.LogicalSwitch{
+		Name:        switchName,
+		OtherConfig: map[string]string{"vlan-passthru": vlanPassthru},
+	}
+	if err := libovsdbops.UpdateLogicalSwitchSetOtherConfig(oc.nbClient, logicalSwitch); err != nil {
+		return fmt.Errorf("failed to update vlan-passthru on switch %s: %w", switchName, err)
+	}
+
+	ops, err := libovsdbops.CreateOrUpdateACLsOps(oc.nbClient, nil, oc.GetSamplingConfig(), acls...)
+	if err != nil {
+		return fmt.Errorf("failed to create VLAN trunk ACLs: %w", err)
+	}
+	ops, err = libovsdbops.AddACLsToLogicalSwitchOps(oc.nbClient, ops, switchName, acls...)
+	if err != nil {
+		return fmt.Errorf("failed to add VLAN trunk ACLs to switch %s: %w", switchName, err)
+	}
+
+	keepACLs := sets.New[string]()
+	for _, acl := range acls {
+		keepACLs.Insert(acl.ExternalIDs[libovsdbops.PrimaryIDKey.String()])
+	}
+	predicateIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLLocalnetVLANTrunk, oc.controllerName, nil)
+	aclP := libovsdbops.GetPredicate[*nbdb.ACL](predicateIDs, func(acl *nbdb.ACL) bool {
+		return !keepACLs.Has(acl.ExternalIDs[libovsdbops.PrimaryIDKey.String()])
+	})
+	staleACLs, err := libovsdbops.FindACLsWithPredicate(oc.nbClient, aclP)
+	if err != nil {
+		return fmt.Errorf("failed to find stale VLAN trunk ACLs: %w", err)
+	}
+	if len(staleACLs) > 0 {
+		ops, err = libovsdbops.RemoveACLsFromLogicalSwitchesWithPredicateOps(oc.nbClient, ops,
+			func(item *nbdb.LogicalSwitch) bool { return item.Name == switchName }, staleACLs...)
+		if err != nil {
+			return fmt.Errorf("failed to remove stale VLAN trunk ACLs from switch %s: %w", switchName, err)
+		}
+	}
+
+	_, err = libovsdbops.TransactAndCheck(oc.nbClient, ops)
+	return err
+}
+
+// buildVLANTrunkACLs returns the ACLs dropping tagged traffic for VLANs not allowed in the trunk, and
+// untagged traffic when the trunk has no native VLAN, both sent and received by the pods.
+func (oc *SecondaryLocalnetNetworkController) buildVLANTrunkACLs(vlanTrunk *util.VLANTrunk) []*nbdb.ACL {
+	var acls []*nbdb.ACL
+	for _, aclDir := range []libovsdbutil.ACLDirection{libovsdbutil.ACLEgress, libovsdbutil.ACLIngress} {
+		aclPipeline := libovsdbutil.LportEgress
+		if aclDir == libovsdbutil.ACLIngress {
+			aclPipeline = libovsdbutil.LportIngress
+		}
+		acls = append(acls, libovsdbutil.BuildACL(oc.getVLANTrunkACLDbIDs(vlanTrunkTaggedACL, aclDir),
+			types.LocalnetVLANTrunkDenyPriority, getVLANTrunkTaggedDenyMatch(vlanTrunk.AllowedVLANs),
+			nbdb.ACLActionDrop, nil, aclPipeline))
+		if !vlanTrunk.AllowUntagged {
+			acls = append(acls, libovsdbutil.BuildACL(oc.getVLANTrunkACLDbIDs(vlanTrunkUntaggedACL, aclDir),
+				types.LocalnetVLANTrunkDenyPriority, "!vlan.present", nbdb.ACLActionDrop, nil, aclPipeline))
+		}
+	}
+	return acls
+}
+
+func (oc *SecondaryLocalnetNetworkController) getVLANTrunkACLDbIDs(name string, aclDir libovsdbutil.ACLDirection) *libovsdbops.DbObjectIDs {
+	return libovsdbops.NewDbObjectIDs(libovsdbops.ACLLocalnetVLANTrunk, oc.controllerName,
+		map[libovsdbops.ExternalIDKey]string{
+			libovsdbops.ObjectNameKey:      name,
+			libovsdbops.PolicyDirectionKey: string(aclDir),
+		})
+}
+
+// getVLANTrunkTaggedDenyMatch returns a match for tagged traffic of any VLAN not in the given ranges, e.g.
+// "vlan.present && !(vlan.vid == 100 || (200 <= vlan.vid && vlan.vid <= 210))"
+func getVLANTrunkTaggedDenyMatch(allowedVLANs []util.VLANRange) string {
+	allowed := make([]string, 0, len(allowedVLANs))
+	for _, vlanRange := range allowedVLANs {
+		if vlanRange.Start == vlanRange.End {
+			allowed = append(allowed, fmt.Sprintf("vlan.vid == %d", vlanRange.Start))
+			continue
+		}
+		allowed = append(allowed, fmt.Sprintf("(%d <= vlan.vid && vlan.vid <= %d)", vlanRange.Start, vlanRange.End))
+	}
+	return fmt.Sprintf("vlan.present && !(%s)", strings.Join(allowed, " || "))
 }
 
 func (oc *SecondaryLocalnetNetworkController) Stop() {
@@ -333,6 +449,11 @@ func (oc *SecondaryLocalnetNetworkController) initRetryFramework() {
 		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
 		oc.retryMultiNetworkPolicies = oc.newRetryFramework(factory.MultiNetworkPolicyType)
 	}
+
+	// Multicast is allowed per namespace, based on the namespace annotation.
+	if oc.multicastSupport {
+		oc.retryNamespaces = oc.newRetryFramework(factory.NamespaceType)
+	}
 }
 
 // newRetryFramework builds and returns a retry framework for the input resource type;
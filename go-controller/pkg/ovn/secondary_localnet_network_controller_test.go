@@ -0,0 +1,153 @@
+package ovn
+
+import (
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+
+	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
+	libovsdbops "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/ops"
+	libovsdbutil "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/libovsdb/util"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/nbdb"
+	libovsdbtest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/libovsdb"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+var _ = ginkgo.Describe("OVN Localnet VLAN trunk", func() {
+	const (
+		netName    = "localnet-network"
+		switchName = "localnet.network_ovn_localnet_switch"
+	)
+	var nbCleanup *libovsdbtest.Context
+
+	ginkgo.AfterEach(func() {
+		if nbCleanup != nil {
+			nbCleanup.Cleanup()
+		}
+	})
+
+	newController := func(initialNbdb libovsdbtest.TestSetup, vlanTrunk string, nativeVLANID int) *SecondaryLocalnetNetworkController {
+		nbClient, cleanup, err := libovsdbtest.NewNBTestHarness(initialNbdb, nil)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		nbCleanup = cleanup
+
+		netInfo, err := util.NewNetInfo(&ovncnitypes.NetConf{
+			NetConf:      cnitypes.NetConf{Name: netName},
+			Topology:     types.LocalnetTopology,
+			NADName:      "ns1/nad1",
+			VLANTrunk:    vlanTrunk,
+			NativeVLANID: nativeVLANID,
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		return &SecondaryLocalnetNetworkController{
+			BaseSecondaryLayer2NetworkController: BaseSecondaryLayer2NetworkController{
+				BaseSecondaryNetworkController: BaseSecondaryNetworkController{
+					BaseNetworkController: BaseNetworkController{
+						CommonNetworkControllerInfo: CommonNetworkControllerInfo{nbClient: nbClient},
+						controllerName:              getNetworkControllerName(netName),
+						ReconcilableNetInfo:         util.NewReconcilableNetInfo(netInfo),
+					},
+				},
+			},
+		}
+	}
+
+	getExpectedACL := func(name string, aclDir libovsdbutil.ACLDirection, match string) *nbdb.ACL {
+		dbIDs := libovsdbops.NewDbObjectIDs(libovsdbops.ACLLocalnetVLANTrunk, getNetworkControllerName(netName),
+			map[libovsdbops.ExternalIDKey]string{
+				libovsdbops.ObjectNameKey:      name,
+				libovsdbops.PolicyDirectionKey: string(aclDir),
+			})
+		aclPipeline := libovsdbutil.LportEgress
+		if aclDir == libovsdbutil.ACLIngress {
+			aclPipeline = libovsdbutil.LportIngress
+		}
+		acl := libovsdbutil.BuildACL(dbIDs, types.LocalnetVLANTrunkDenyPriority, match,
+			nbdb.ACLActionDrop, nil, aclPipeline)
+		acl.UUID = name + string(aclDir) + "-UUID"
+		return acl
+	}
+
+	ginkgo.It("enables vlan-passthru and drops traffic for VLANs not allowed in the trunk", func() {
+		oc := newController(libovsdbtest.TestSetup{
+			NBData: []libovsdbtest.TestData{&nbdb.LogicalSwitch{Name: switchName, UUID: switchName + "-UUID"}},
+		}, "100,200-210", 0)
+		gomega.Expect(oc.syncVLANTrunk(switchName)).To(gomega.Succeed())
+
+		taggedMatch := "vlan.present && !(vlan.vid == 100 || (200 <= vlan.vid && vlan.vid <= 210))"
+		taggedEgressACL := getExpectedACL(vlanTrunkTaggedACL, libovsdbutil.ACLEgress, taggedMatch)
+		taggedIngressACL := getExpectedACL(vlanTrunkTaggedACL, libovsdbutil.ACLIngress, taggedMatch)
+		untaggedEgressACL := getExpectedACL(vlanTrunkUntaggedACL, libovsdbutil.ACLEgress, "!vlan.present")
+		untaggedIngressACL := getExpectedACL(vlanTrunkUntaggedACL, libovsdbutil.ACLIngress, "!vlan.present")
+		gomega.Expect(oc.nbClient).Should(libovsdbtest.HaveData(
+			&nbdb.LogicalSwitch{
+				Name:        switchName,
+				UUID:        switchName + "-UUID",
+				OtherConfig: map[string]string{"vlan-passthru": "true"},
+				ACLs: []string{taggedEgressACL.UUID, untaggedEgressACL.UUID,
+					taggedIngressACL.UUID, untaggedIngressACL.UUID},
+			},
+			taggedEgressACL,
+			taggedIngressACL,
+			untaggedEgressACL,
+			untaggedIngressACL,
+		))
+	})
+
+	ginkgo.It("allows untagged traffic when the trunk has a native VLAN", func() {
+		staleUntaggedACL := getExpectedACL(vlanTrunkUntaggedACL, libovsdbutil.ACLIngress, "!vlan.present")
+		oc := newController(libovsdbtest.TestSetup{
+			NBData: []libovsdbtest.TestData{
+				&nbdb.LogicalSwitch{
+					Name:        switchName,
+					UUID:        switchName + "-UUID",
+					OtherConfig: map[string]string{"vlan-passthru": "true"},
+					ACLs:        []string{staleUntaggedACL.UUID},
+				},
+				staleUntaggedACL,
+			},
+		}, "100", 10)
+		gomega.Expect(oc.syncVLANTrunk(switchName)).To(gomega.Succeed())
+
+		taggedEgressACL := getExpectedACL(vlanTrunkTaggedACL, libovsdbutil.ACLEgress, "vlan.present && !(vlan.vid == 100)")
+		taggedIngressACL := getExpectedACL(vlanTrunkTaggedACL, libovsdbutil.ACLIngress, "vlan.present && !(vlan.vid == 100)")
+		gomega.Expect(oc.nbClient).Should(libovsdbtest.HaveData(
+			&nbdb.LogicalSwitch{
+				Name:        switchName,
+				UUID:        switchName + "-UUID",
+				OtherConfig: map[string]string{"vlan-passthru": "true"},
+				ACLs:        []string{taggedEgressACL.UUID, taggedIngressACL.UUID},
+			},
+			taggedEgressACL,
+			taggedIngressACL,
+		))
+	})
+
+	ginkgo.It("removes the VLAN trunk configuration when the network is not a trunk", func() {
+		staleTaggedACL := getExpectedACL(vlanTrunkTaggedACL, libovsdbutil.ACLEgress, "vlan.present && !(vlan.vid == 100)")
+		staleUntaggedACL := getExpectedACL(vlanTrunkUntaggedACL, libovsdbutil.ACLIngress, "!vlan.present")
+		oc := newController(libovsdbtest.TestSetup{
+			NBData: []libovsdbtest.TestData{
+				&nbdb.LogicalSwitch{
+					Name:        switchName,
+					UUID:        switchName + "-UUID",
+					OtherConfig: map[string]string{"vlan-passthru": "true"},
+					ACLs:        []string{staleTaggedACL.UUID, staleUntaggedACL.UUID},
+				},
+				staleTaggedACL,
+				staleUntaggedACL,
+			},
+		}, "", 0)
+		gomega.Expect(oc.syncVLANTrunk(switchName)).To(gomega.Succeed())
+
+		gomega.Expect(oc.nbClient).Should(libovsdbtest.HaveData(
+			&nbdb.LogicalSwitch{
+				Name: switchName,
+				UUID: switchName + "-UUID",
+			},
+		))
+	})
+})
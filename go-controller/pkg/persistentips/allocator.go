@@ -9,7 +9,10 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	ipamclaimsapi "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1"
 	ipamclaimslister "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1/apis/listers/ipamclaims/v1alpha1"
+	nadapi "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
 
+	corev1 "k8s.io/api/core/v1"
+	appslisters "k8s.io/client-go/listers/apps/v1"
 	"k8s.io/klog/v2"
 
 	ipam "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip"
@@ -35,6 +38,10 @@ type PersistentAllocations interface {
 	FindIPAMClaim(claimName string, namespace string) (*ipamclaimsapi.IPAMClaim, error)
 
 	Reconcile(oldIPAMClaim *ipamclaimsapi.IPAMClaim, newIPAMClaim *ipamclaimsapi.IPAMClaim, ipReleaser IPReleaser) error
+
+	CreateStatefulSetIPAMClaim(pod *corev1.Pod, network *nadapi.NetworkSelectionElement) (*ipamclaimsapi.IPAMClaim, error)
+
+	ReleaseStatefulSetIPAMClaim(pod *corev1.Pod, network *nadapi.NetworkSelectionElement) error
 }
 
 // IPAMClaimReconciler acts on IPAMClaim events handed off by the cluster network
@@ -49,14 +56,20 @@ type IPAMClaimReconciler struct {
 	netInfo util.NetInfo
 
 	lister ipamclaimslister.IPAMClaimLister
+
+	// statefulSetLister is used to release the IPAMClaims of StatefulSet pods
+	// on scale down. Only set when util.IsStatefulSetPersistentIPsEnabled.
+	statefulSetLister appslisters.StatefulSetLister
 }
 
 // NewIPAMClaimReconciler builds a new PersistentIPsAllocator
-func NewIPAMClaimReconciler(kube kube.InterfaceOVN, netConfig util.NetInfo, lister ipamclaimslister.IPAMClaimLister) *IPAMClaimReconciler {
+func NewIPAMClaimReconciler(kube kube.InterfaceOVN, netConfig util.NetInfo, lister ipamclaimslister.IPAMClaimLister,
+	statefulSetLister appslisters.StatefulSetLister) *IPAMClaimReconciler {
 	pipsAllocator := &IPAMClaimReconciler{
-		kube:    kube,
-		netInfo: netConfig,
-		lister:  lister,
+		kube:              kube,
+		netInfo:           netConfig,
+		lister:            lister,
+		statefulSetLister: statefulSetLister,
 	}
 	return pipsAllocator
 }
@@ -149,7 +162,12 @@ func (icr *IPAMClaimReconciler) FindIPAMClaim(claimName string, namespace string
 // Sync initializes the IPs allocator with the IPAMClaims already existing on
 // the cluster. For live pods, therse are already allocated, so no error will
 // be thrown (e.g. we ignore the `ipam.IsErrAllocated` error
+// IPAMClaims of StatefulSet pods that were scaled down in the meantime are
+// deleted, which releases their IPs once the delete event is handled. Failing
+// to delete them fails the sync so that it is retried.
 func (icr *IPAMClaimReconciler) Sync(objs []interface{}, ipAllocator IPAllocator) error {
+	retainedOrdinals := map[string]func(int) bool{}
+	var errs []error
 	for _, obj := range objs {
 		ipamClaim, ok := obj.(*ipamclaimsapi.IPAMClaim)
 		if !ok {
@@ -174,8 +192,14 @@ func (icr *IPAMClaimReconciler) Sync(objs []interface{}, ipAllocator IPAllocator
 				return fmt.Errorf("failed syncing persistent ips: %w", err)
 			}
 		}
+
+		if util.IsStatefulSetPersistentIPsEnabled() {
+			if err := icr.syncStatefulSetIPAMClaim(ipamClaim, retainedOrdinals); err != nil {
+				errs = append(errs, fmt.Errorf("failed to sync StatefulSet IPAMClaim %s/%s: %w", ipamClaim.Namespace, ipamClaim.Name, err))
+			}
+		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 func (icr *IPAMClaimReconciler) releaseIPs(ipamClaim *ipamclaimsapi.IPAMClaim, ipReleaser IPReleaser) error {
@@ -69,7 +69,7 @@ var _ = Describe("Persistent IP allocator operations", func() {
 			ipAllocator := subnet.NewAllocator()
 			Expect(ipAllocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("192.168.200.0/24", "fd10::/64"))).To(Succeed())
 			namedAllocator = ipAllocator.ForSubnet(subnetName)
-			ipamClaimsReconciler = NewIPAMClaimReconciler(ovnkapiclient, netInfo, nil)
+			ipamClaimsReconciler = NewIPAMClaimReconciler(ovnkapiclient, netInfo, nil, nil)
 			Expect(ipAllocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("192.168.200.0/24", "fd10::/64"))).To(Succeed())
 		})
 
@@ -142,7 +142,7 @@ var _ = Describe("Persistent IP allocator operations", func() {
 			}
 			Expect(ipAllocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("192.168.200.0/24", "fd10::/64"))).To(Succeed())
 			namedAllocator = ipAllocator.ForSubnet(subnetName)
-			ipamClaimsReconciler = NewIPAMClaimReconciler(ovnkapiclient, netInfo, nil)
+			ipamClaimsReconciler = NewIPAMClaimReconciler(ovnkapiclient, netInfo, nil, nil)
 		})
 
 		It("the IPAMClaim is *not* updated", func() {
@@ -173,7 +173,7 @@ var _ = Describe("Persistent IP allocator operations", func() {
 			netInfo, err := util.NewNetInfo(dummyNetconf(networkName))
 			Expect(err).NotTo(HaveOccurred())
 
-			ipamClaimsReconciler = NewIPAMClaimReconciler(ovnkapiclient, netInfo, nil)
+			ipamClaimsReconciler = NewIPAMClaimReconciler(ovnkapiclient, netInfo, nil, nil)
 		})
 
 		It("successfully handles being requested the same IPs again", func() {
@@ -250,7 +250,7 @@ var _ = Describe("Persistent IP allocator operations", func() {
 				netInfo, err := util.NewNetInfo(netConf)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(
-					NewIPAMClaimReconciler(nil, netInfo, lister).FindIPAMClaim(
+					NewIPAMClaimReconciler(nil, netInfo, lister, nil).FindIPAMClaim(
 						network.IPAMClaimReference,
 						network.Namespace,
 					),
@@ -289,7 +289,7 @@ var _ = Describe("Persistent IP allocator operations", func() {
 
 				netInfo, err := util.NewNetInfo(netConf)
 				Expect(err).NotTo(HaveOccurred())
-				_, actualError := NewIPAMClaimReconciler(nil, netInfo, lister).FindIPAMClaim(
+				_, actualError := NewIPAMClaimReconciler(nil, netInfo, lister, nil).FindIPAMClaim(
 					network.IPAMClaimReference,
 					network.Namespace,
 				)
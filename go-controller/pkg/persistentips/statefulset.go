@@ -0,0 +1,206 @@
+package persistentips
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	ipamclaimsapi "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1"
+	nadapi "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	ovnktypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/types"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+)
+
+// IsStatefulSetIPAMClaimReference tells whether the IPAMClaim referenced by
+// the network selection element is managed by ovnkube on behalf of a
+// StatefulSet pod.
+func IsStatefulSetIPAMClaimReference(pod *corev1.Pod, network *nadapi.NetworkSelectionElement) bool {
+	if !util.IsStatefulSetPersistentIPsEnabled() || network == nil || network.IPAMClaimReference == "" {
+		return false
+	}
+	if _, _, isStatefulSetPod := util.GetStatefulSetPodOrdinal(pod); !isStatefulSetPod {
+		return false
+	}
+	return network.IPAMClaimReference == util.GetStatefulSetIPAMClaimName(pod.Name, network.Name)
+}
+
+// CreateStatefulSetIPAMClaim creates the IPAMClaim persisting the IPs of a
+// StatefulSet pod. The claim is owned by the StatefulSet so that it is garbage
+// collected, and its IPs released, when the StatefulSet is deleted.
+func (icr *IPAMClaimReconciler) CreateStatefulSetIPAMClaim(pod *corev1.Pod, network *nadapi.NetworkSelectionElement) (*ipamclaimsapi.IPAMClaim, error) {
+	if !IsStatefulSetIPAMClaimReference(pod, network) {
+		return nil, fmt.Errorf("IPAMClaim %q is not managed for pod %s/%s", network.IPAMClaimReference, pod.Namespace, pod.Name)
+	}
+	owner := metav1.GetControllerOf(pod)
+	ipamClaim := &ipamclaimsapi.IPAMClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      network.IPAMClaimReference,
+			Namespace: pod.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: owner.APIVersion,
+					Kind:       owner.Kind,
+					Name:       owner.Name,
+					UID:        owner.UID,
+				},
+			},
+		},
+		Spec: ipamclaimsapi.IPAMClaimSpec{
+			Network:   icr.netInfo.GetNetworkName(),
+			Interface: ovnktypes.PrimaryUDNInterfaceName,
+		},
+	}
+	created, err := icr.kube.CreateIPAMClaim(ipamClaim)
+	if apierrors.IsAlreadyExists(err) {
+		// lister is lagging behind, get the claim from the apiserver
+		existing, err := icr.kube.GetIPAMClaim(ipamClaim.Namespace, ipamClaim.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get IPAMClaim %s/%s: %w", ipamClaim.Namespace, ipamClaim.Name, err)
+		}
+		return existing, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create IPAMClaim %s/%s: %w", ipamClaim.Namespace, ipamClaim.Name, err)
+	}
+	klog.Infof("Created IPAMClaim %s/%s for StatefulSet pod %s", created.Namespace, created.Name, pod.Name)
+	return created, nil
+}
+
+// ReleaseStatefulSetIPAMClaim deletes the IPAMClaim of a deleted StatefulSet
+// pod if its ordinal is no longer part of the StatefulSet, i.e. after a scale
+// down. The IPs are returned to the pool when the IPAMClaim delete event is
+// handled. Nothing is done for pods that will be re-created with the same
+// identity.
+func (icr *IPAMClaimReconciler) ReleaseStatefulSetIPAMClaim(pod *corev1.Pod, network *nadapi.NetworkSelectionElement) error {
+	if !IsStatefulSetIPAMClaimReference(pod, network) {
+		return nil
+	}
+	stsName, ordinal, _ := util.GetStatefulSetPodOrdinal(pod)
+	retained, err := icr.isStatefulSetOrdinalRetained(pod.Namespace, stsName, ordinal)
+	if err != nil {
+		return err
+	}
+	if retained {
+		return nil
+	}
+	return icr.deleteIPAMClaim(pod.Namespace, network.IPAMClaimReference)
+}
+
+// syncStatefulSetIPAMClaim deletes the IPAMClaim if it was created for a
+// StatefulSet pod that was scaled down while we were not running.
+func (icr *IPAMClaimReconciler) syncStatefulSetIPAMClaim(ipamClaim *ipamclaimsapi.IPAMClaim, retainedOrdinals map[string]func(int) bool) error {
+	stsName, ordinal, isStatefulSetClaim := getStatefulSetIPAMClaimOrdinal(ipamClaim)
+	if !isStatefulSetClaim {
+		return nil
+	}
+	isRetained, cached := retainedOrdinals[stsName]
+	if !cached {
+		sts, err := icr.getStatefulSet(ipamClaim.Namespace, stsName)
+		if err != nil {
+			return err
+		}
+		isRetained = retainedOrdinalsOf(sts)
+		retainedOrdinals[stsName] = isRetained
+	}
+	if isRetained(ordinal) {
+		return nil
+	}
+	podName := fmt.Sprintf("%s-%d", stsName, ordinal)
+	_, err := icr.kube.GetPod(ipamClaim.Namespace, podName)
+	if err == nil {
+		// pod is still around, it will be released when deleted
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get pod %s/%s: %w", ipamClaim.Namespace, podName, err)
+	}
+	return icr.deleteIPAMClaim(ipamClaim.Namespace, ipamClaim.Name)
+}
+
+func (icr *IPAMClaimReconciler) isStatefulSetOrdinalRetained(namespace, stsName string, ordinal int) (bool, error) {
+	sts, err := icr.getStatefulSet(namespace, stsName)
+	if err != nil {
+		return false, err
+	}
+	return retainedOrdinalsOf(sts)(ordinal), nil
+}
+
+// getStatefulSet returns the StatefulSet, or nil if it does not exist
+func (icr *IPAMClaimReconciler) getStatefulSet(namespace, name string) (*appsv1.StatefulSet, error) {
+	if icr.statefulSetLister == nil {
+		return nil, fmt.Errorf("failed to get StatefulSet %s/%s: no StatefulSet lister", namespace, name)
+	}
+	sts, err := icr.statefulSetLister.StatefulSets(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get StatefulSet %s/%s: %w", namespace, name, err)
+	}
+	return sts, nil
+}
+
+func (icr *IPAMClaimReconciler) deleteIPAMClaim(namespace, name string) error {
+	err := icr.kube.DeleteIPAMClaim(namespace, name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete IPAMClaim %s/%s: %w", namespace, name, err)
+	}
+	klog.Infof("Deleted IPAMClaim %s/%s of scaled down StatefulSet pod", namespace, name)
+	return nil
+}
+
+// retainedOrdinalsOf returns a function telling whether a pod ordinal is part
+// of the StatefulSet. No ordinal is retained for a missing or deleted
+// StatefulSet.
+func retainedOrdinalsOf(sts *appsv1.StatefulSet) func(int) bool {
+	if sts == nil || sts.DeletionTimestamp != nil {
+		return func(int) bool { return false }
+	}
+	start := 0
+	if sts.Spec.Ordinals != nil {
+		start = int(sts.Spec.Ordinals.Start)
+	}
+	replicas := 1
+	if sts.Spec.Replicas != nil {
+		replicas = int(*sts.Spec.Replicas)
+	}
+	return func(ordinal int) bool {
+		return ordinal >= start && ordinal < start+replicas
+	}
+}
+
+// getStatefulSetIPAMClaimOrdinal returns the StatefulSet and ordinal of the pod
+// an IPAMClaim was created for by CreateStatefulSetIPAMClaim.
+func getStatefulSetIPAMClaimOrdinal(ipamClaim *ipamclaimsapi.IPAMClaim) (string, int, bool) {
+	var owner *metav1.OwnerReference
+	for i := range ipamClaim.OwnerReferences {
+		if ipamClaim.OwnerReferences[i].Kind == "StatefulSet" && strings.HasPrefix(ipamClaim.OwnerReferences[i].APIVersion, "apps/") {
+			owner = &ipamClaim.OwnerReferences[i]
+			break
+		}
+	}
+	if owner == nil {
+		return "", 0, false
+	}
+	// the claim is named <statefulset name>-<ordinal>.<nad name>
+	suffix, found := strings.CutPrefix(ipamClaim.Name, owner.Name+"-")
+	if !found {
+		return "", 0, false
+	}
+	ordinalStr, _, found := strings.Cut(suffix, ".")
+	if !found {
+		return "", 0, false
+	}
+	ordinal, err := strconv.Atoi(ordinalStr)
+	if err != nil || ordinal < 0 {
+		return "", 0, false
+	}
+	return owner.Name, ordinal, true
+}
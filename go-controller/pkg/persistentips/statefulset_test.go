@@ -0,0 +1,246 @@
+package persistentips
+
+import (
+	"context"
+	"fmt"
+
+	ipamclaimsapi "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1"
+	fakeipamclaimclient "github.com/k8snetworkplumbingwg/ipamclaims/pkg/crd/ipamclaims/v1alpha1/apis/clientset/versioned/fake"
+	nadapi "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/allocator/ip/subnet"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
+	ovnkclient "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube"
+	ovntest "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing"
+	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Persistent IPs for StatefulSet pods", func() {
+	const (
+		namespace   = "ns1"
+		networkName = "justanetwork"
+		nadName     = "primary-udn"
+		stsName     = "web"
+		stsUID      = "sts-uid"
+		subnetName  = "dummy-net"
+	)
+
+	var (
+		ipamClaimsReconciler *IPAMClaimReconciler
+		ovnkapiclient        *ovnkclient.KubeOVN
+	)
+
+	setup := func(kubeObjects []runtime.Object, ipamClaims ...runtime.Object) {
+		netInfo, err := util.NewNetInfo(dummyNetconf(networkName))
+		Expect(err).NotTo(HaveOccurred())
+		ovnkapiclient = &ovnkclient.KubeOVN{
+			Kube:             ovnkclient.Kube{KClient: fake.NewSimpleClientset(kubeObjects...)},
+			IPAMClaimsClient: fakeipamclaimclient.NewSimpleClientset(ipamClaims...),
+		}
+		statefulSetIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		for _, obj := range kubeObjects {
+			if sts, ok := obj.(*appsv1.StatefulSet); ok {
+				Expect(statefulSetIndexer.Add(sts)).To(Succeed())
+			}
+		}
+		ipamClaimsReconciler = NewIPAMClaimReconciler(ovnkapiclient, netInfo, nil, appslisters.NewStatefulSetLister(statefulSetIndexer))
+	}
+
+	getIPAMClaim := func(name string) (*ipamclaimsapi.IPAMClaim, error) {
+		return ovnkapiclient.IPAMClaimsClient.K8sV1alpha1().IPAMClaims(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	}
+
+	BeforeEach(func() {
+		Expect(config.PrepareTestConfig()).To(Succeed())
+		config.OVNKubernetesFeature.EnablePersistentIPs = true
+		config.OVNKubernetesFeature.EnableStatefulSetPersistentIPs = true
+		config.OVNKubernetesFeature.EnableInterconnect = true
+	})
+
+	It("creates an IPAMClaim owned by the StatefulSet", func() {
+		setup(nil)
+		pod := statefulSetPod(namespace, stsName, stsUID, 0)
+		network := statefulSetNetworkSelection(pod, nadName)
+
+		ipamClaim, err := ipamClaimsReconciler.CreateStatefulSetIPAMClaim(pod, network)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ipamClaim.Name).To(Equal("web-0.primary-udn"))
+		Expect(ipamClaim.Spec.Network).To(Equal(networkName))
+		Expect(ipamClaim.OwnerReferences).To(ConsistOf(metav1.OwnerReference{
+			APIVersion: "apps/v1",
+			Kind:       "StatefulSet",
+			Name:       stsName,
+			UID:        stsUID,
+		}))
+
+		_, err = getIPAMClaim(ipamClaim.Name)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("returns the existing IPAMClaim when it was already created", func() {
+		pod := statefulSetPod(namespace, stsName, stsUID, 0)
+		network := statefulSetNetworkSelection(pod, nadName)
+		setup(nil, statefulSetIPAMClaim(namespace, stsName, stsUID, 0, nadName, networkName, "192.168.200.10/24"))
+
+		ipamClaim, err := ipamClaimsReconciler.CreateStatefulSetIPAMClaim(pod, network)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ipamClaim.Name).To(Equal("web-0.primary-udn"))
+		Expect(ipamClaim.Status.IPs).To(ConsistOf("192.168.200.10/24"))
+	})
+
+	It("does not create an IPAMClaim for pods not managed by a StatefulSet", func() {
+		setup(nil)
+		pod := statefulSetPod(namespace, stsName, stsUID, 0)
+		pod.OwnerReferences = nil
+		network := &nadapi.NetworkSelectionElement{Namespace: namespace, Name: nadName, IPAMClaimReference: "web-0.primary-udn"}
+
+		_, err := ipamClaimsReconciler.CreateStatefulSetIPAMClaim(pod, network)
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("releasing the IPAMClaim of a deleted pod",
+		func(sts *appsv1.StatefulSet, ordinal int, expectDeleted bool) {
+			pod := statefulSetPod(namespace, stsName, stsUID, ordinal)
+			network := statefulSetNetworkSelection(pod, nadName)
+			var kubeObjects []runtime.Object
+			if sts != nil {
+				kubeObjects = append(kubeObjects, sts)
+			}
+			setup(kubeObjects, statefulSetIPAMClaim(namespace, stsName, stsUID, ordinal, nadName, networkName))
+
+			Expect(ipamClaimsReconciler.ReleaseStatefulSetIPAMClaim(pod, network)).To(Succeed())
+
+			_, err := getIPAMClaim(network.IPAMClaimReference)
+			if expectDeleted {
+				Expect(err).To(HaveOccurred())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		},
+		Entry("keeps it when the ordinal is still part of the StatefulSet",
+			statefulSet(namespace, stsName, 3, 0), 2, false),
+		Entry("deletes it when the StatefulSet was scaled down",
+			statefulSet(namespace, stsName, 2, 0), 2, true),
+		Entry("deletes it when the ordinal is below the StatefulSet start ordinal",
+			statefulSet(namespace, stsName, 2, 3), 2, true),
+		Entry("keeps it when the ordinal is within the StatefulSet start ordinal range",
+			statefulSet(namespace, stsName, 2, 3), 4, false),
+		Entry("deletes it when the StatefulSet is gone",
+			nil, 0, true),
+	)
+
+	It("cleans up the IPAMClaims of pods scaled down while not running on sync", func() {
+		retainedClaim := statefulSetIPAMClaim(namespace, stsName, stsUID, 0, nadName, networkName, "192.168.200.10/24")
+		scaledDownClaim := statefulSetIPAMClaim(namespace, stsName, stsUID, 1, nadName, networkName, "192.168.200.11/24")
+		terminatingClaim := statefulSetIPAMClaim(namespace, stsName, stsUID, 2, nadName, networkName, "192.168.200.12/24")
+		setup(
+			[]runtime.Object{
+				statefulSet(namespace, stsName, 1, 0),
+				statefulSetPod(namespace, stsName, stsUID, 0),
+				statefulSetPod(namespace, stsName, stsUID, 2),
+			},
+			retainedClaim, scaledDownClaim, terminatingClaim,
+		)
+		ipAllocator := subnet.NewAllocator()
+		Expect(ipAllocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("192.168.200.0/24"))).To(Succeed())
+
+		Expect(ipamClaimsReconciler.Sync(
+			[]interface{}{retainedClaim, scaledDownClaim, terminatingClaim},
+			ipAllocator.ForSubnet(subnetName),
+		)).To(Succeed())
+
+		// IPs remain allocated until the IPAMClaim deletion is handled
+		Expect(ipAllocator.ForSubnet(subnetName).AllocateIPs(ovntest.MustParseIPNets("192.168.200.11/24"))).NotTo(Succeed())
+
+		_, err := getIPAMClaim(retainedClaim.Name)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = getIPAMClaim(scaledDownClaim.Name)
+		Expect(err).To(HaveOccurred())
+		_, err = getIPAMClaim(terminatingClaim.Name)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("fails the sync when the IPAMClaim of a scaled down pod cannot be deleted", func() {
+		scaledDownClaim := statefulSetIPAMClaim(namespace, stsName, stsUID, 1, nadName, networkName, "192.168.200.11/24")
+		setup([]runtime.Object{statefulSet(namespace, stsName, 1, 0)}, scaledDownClaim)
+		ovnkapiclient.IPAMClaimsClient.(*fakeipamclaimclient.Clientset).PrependReactor("delete", "ipamclaims",
+			func(clienttesting.Action) (bool, runtime.Object, error) {
+				return true, nil, fmt.Errorf("injected error")
+			})
+		ipAllocator := subnet.NewAllocator()
+		Expect(ipAllocator.AddOrUpdateSubnet(subnetName, ovntest.MustParseIPNets("192.168.200.0/24"))).To(Succeed())
+
+		Expect(ipamClaimsReconciler.Sync(
+			[]interface{}{scaledDownClaim},
+			ipAllocator.ForSubnet(subnetName),
+		)).To(MatchError(ContainSubstring("injected error")))
+	})
+})
+
+func statefulSet(namespace, name string, replicas, start int32) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To(replicas),
+			Ordinals: &appsv1.StatefulSetOrdinals{Start: start},
+		},
+	}
+}
+
+func statefulSetPod(namespace, stsName, stsUID string, ordinal int) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", stsName, ordinal),
+			Namespace: namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "apps/v1",
+					Kind:       "StatefulSet",
+					Name:       stsName,
+					UID:        k8stypes.UID(stsUID),
+					Controller: ptr.To(true),
+				},
+			},
+		},
+	}
+}
+
+func statefulSetNetworkSelection(pod *corev1.Pod, nadName string) *nadapi.NetworkSelectionElement {
+	return &nadapi.NetworkSelectionElement{
+		Namespace:          pod.Namespace,
+		Name:               nadName,
+		IPAMClaimReference: util.GetStatefulSetIPAMClaimName(pod.Name, nadName),
+	}
+}
+
+func statefulSetIPAMClaim(namespace, stsName, stsUID string, ordinal int, nadName, networkName string, ips ...string) *ipamclaimsapi.IPAMClaim {
+	ipamClaim := ipamClaimWithIPs(
+		namespace,
+		util.GetStatefulSetIPAMClaimName(fmt.Sprintf("%s-%d", stsName, ordinal), nadName),
+		networkName,
+		ips...,
+	)
+	ipamClaim.OwnerReferences = []metav1.OwnerReference{
+		{
+			APIVersion: "apps/v1",
+			Kind:       "StatefulSet",
+			Name:       stsName,
+			UID:        k8stypes.UID(stsUID),
+		},
+	}
+	return ipamClaim
+}
@@ -356,6 +356,8 @@ type TestOvsdbServer struct {
 	*server.OvsdbServer
 	db    database.Database
 	dbMod model.DatabaseModel
+	// Address the server listens on, for tests that need their own client
+	Address string
 }
 
 func newOVSDBServer(cfg config.OvnAuthConfig, dbModel model.ClientDBModel, schema ovsdb.DatabaseSchema, data []TestData, ignoreConstraints bool) (*TestOvsdbServer, error) {
@@ -437,6 +439,7 @@ func newOVSDBServer(cfg config.OvnAuthConfig, dbModel model.ClientDBModel, schem
 		OvsdbServer: s,
 		db:          db,
 		dbMod:       dbMod,
+		Address:     cfg.Address,
 	}, nil
 }
 
@@ -20,6 +20,10 @@ const (
 	// K8sMgmtIntfName name to be used as an OVS internal port on the node
 	K8sMgmtIntfName = K8sMgmtIntfNamePrefix + "0"
 
+	// PrimaryUDNInterfaceName is the name of the pod interface attached to the
+	// primary user defined network
+	PrimaryUDNInterfaceName = "ovn-udn1"
+
 	// PhysicalNetworkName is the name that maps to an OVS bridge that provides
 	// access to physical/external network
 	PhysicalNetworkName     = "physnet"
@@ -86,6 +90,9 @@ const (
 	DefaultAllowPriority = 1001
 	// Default deny acl rule priority
 	DefaultDenyPriority = 1000
+	// Localnet VLAN trunk deny acl rule priority, drops traffic for VLANs not allowed in the trunk
+	// before any other default tier acl is evaluated
+	LocalnetVLANTrunkDenyPriority = 1014
 
 	// ACL PlaceHolderACL Tier Priorities
 	PrimaryUDNAllowPriority = 1001
@@ -105,6 +112,16 @@ const (
 	// Default Tier for all ACLs belonging to Baseline Admin Network Policy
 	DefaultBANPACLTier = 3
 
+	// ClusterEgressFirewall ACLs are in the default tier, like the namespace EgressFirewall ACLs, and are
+	// ordered around them by priority. Rules placed before the namespace EgressFirewall use priorities from
+	// 20000 (priority 0, rule 0) down to 19101 (priority 9, rule 89), above EgressFirewallStartPriority.
+	// Rules placed after it use priorities from 1999 down to 1100, below MinimumReservedEgressFirewallPriority
+	// and above the default tier network policy priorities.
+	ClusterEgressFirewallBeforeNamespaceStartPriority = 20000
+	ClusterEgressFirewallAfterNamespaceStartPriority  = 1999
+	ClusterEgressFirewallMaxRulesPerObject            = 90
+	ClusterEgressFirewallMaxSupportedPriority         = 9
+
 	// priority of logical router policies on the OVNClusterRouter
 	EgressFirewallStartPriority           = 10000
 	MinimumReservedEgressFirewallPriority = 2000
@@ -208,6 +225,8 @@ const (
 	// key for NAD name external-id, only used for secondary logical switch port of a pod
 	// key for network name external-id
 	NADExternalID = OvnK8sPrefix + "/" + "nad"
+	// key for the network namespace path external-id of the OVS interface of a pod
+	NetnsExternalID = OvnK8sPrefix + "/" + "netns"
 	// key for topology type external-id, only used for secondary network logical entities
 	TopologyExternalID = OvnK8sPrefix + "/" + "topology"
 	// key for load_balancer kind external-id
@@ -218,6 +237,12 @@ const (
 	UDNEnabledServiceExternalID = OvnK8sPrefix + "/" + "udn-enabled-default-service"
 	// RequiredUDNNamespaceLabel is the required namespace label for enabling primary UDNs
 	RequiredUDNNamespaceLabel = "k8s.ovn.org/primary-user-defined-network"
+	// HostSubnetLengthLabel is the node label requesting the prefix length of
+	// the IPv4 host subnet allocated to the node on the default network
+	HostSubnetLengthLabel = "k8s.ovn.org/host-subnet-length"
+	// MaxHostSubnetLength is the longest IPv4 host subnet a node can request,
+	// leaving room for a few pods next to the gateway and management port
+	MaxHostSubnetLength = 28
 
 	// different secondary network topology type defined in CNI netconf
 	Layer3Topology   = "layer3"
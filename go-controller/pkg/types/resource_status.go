@@ -13,6 +13,10 @@ const (
 	NetworkQoSErrorMsg     = "NetworkQoS Destinations not correctly applied"
 )
 
+// ClusterEgressFirewallAppliedCorrectly is the zone message and aggregated status of a successfully applied
+// ClusterEgressFirewall
+const ClusterEgressFirewallAppliedCorrectly = "ClusterEgressFirewall Rules applied"
+
 func GetZoneStatus(zoneID, message string) string {
 	return fmt.Sprintf("%s: %s", zoneID, message)
 }
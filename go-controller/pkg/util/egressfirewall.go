@@ -68,6 +68,33 @@ func ValidateAndGetEgressFirewallDestination(egressFirewallDestination egressfir
 	return
 }
 
+// ValidateEgressFirewallPorts validates the ports of an egress firewall rule. Port ranges
+// are only allowed for TCP, UDP and SCTP, while ICMP type and code are only allowed for
+// ICMP and ICMPv6. Ports of other protocols are logged and ignored.
+func ValidateEgressFirewallPorts(ports []egressfirewallv1.EgressFirewallPort) error {
+	for _, port := range ports {
+		switch port.Protocol {
+		case "TCP", "UDP", "SCTP":
+			if port.ICMPType != nil || port.ICMPCode != nil {
+				return fmt.Errorf("icmpType and icmpCode are not allowed for protocol %s", port.Protocol)
+			}
+			if port.EndPort != nil && (port.Port == 0 || *port.EndPort < port.Port) {
+				return fmt.Errorf("invalid port range %d-%d for protocol %s", port.Port, *port.EndPort, port.Protocol)
+			}
+		case "ICMP", "ICMPv6":
+			if port.Port != 0 || port.EndPort != nil {
+				return fmt.Errorf("port and endPort are not allowed for protocol %s", port.Protocol)
+			}
+			if port.ICMPCode != nil && port.ICMPType == nil {
+				return fmt.Errorf("icmpCode requires icmpType for protocol %s", port.Protocol)
+			}
+		default:
+			klog.Warningf("Ignoring egress firewall port with unsupported protocol %s", port.Protocol)
+		}
+	}
+	return nil
+}
+
 // IsWildcard checks if the domain name is wildcard.
 func IsWildcard(dnsName string) bool {
 	return strings.HasPrefix(dnsName, "*.")
@@ -9,6 +9,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	egressfirewallapi "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
@@ -310,3 +311,81 @@ func TestGetNames(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateEgressFirewallPorts(t *testing.T) {
+	testcases := []struct {
+		name        string
+		ports       []egressfirewallapi.EgressFirewallPort
+		expectedErr bool
+	}{
+		{
+			name: "should accept single ports and port ranges",
+			ports: []egressfirewallapi.EgressFirewallPort{
+				{Protocol: "TCP", Port: 80},
+				{Protocol: "TCP", Port: 30000, EndPort: ptr.To[int32](32767)},
+				{Protocol: "UDP"},
+			},
+		},
+		{
+			name: "should accept ICMP type and code",
+			ports: []egressfirewallapi.EgressFirewallPort{
+				{Protocol: "ICMP", ICMPType: ptr.To[int32](8)},
+				{Protocol: "ICMPv6", ICMPType: ptr.To[int32](1), ICMPCode: ptr.To[int32](4)},
+				{Protocol: "ICMP"},
+			},
+		},
+		{
+			name: "should ignore unsupported protocols",
+			ports: []egressfirewallapi.EgressFirewallPort{
+				{Protocol: "TCP", Port: 80},
+				{Protocol: "GRE"},
+			},
+		},
+		{
+			name: "should reject port range without start port",
+			ports: []egressfirewallapi.EgressFirewallPort{
+				{Protocol: "TCP", EndPort: ptr.To[int32](32767)},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "should reject inverted port range",
+			ports: []egressfirewallapi.EgressFirewallPort{
+				{Protocol: "SCTP", Port: 200, EndPort: ptr.To[int32](100)},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "should reject ICMP type for TCP",
+			ports: []egressfirewallapi.EgressFirewallPort{
+				{Protocol: "TCP", ICMPType: ptr.To[int32](8)},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "should reject port for ICMP",
+			ports: []egressfirewallapi.EgressFirewallPort{
+				{Protocol: "ICMP", Port: 80},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "should reject ICMP code without type",
+			ports: []egressfirewallapi.EgressFirewallPort{
+				{Protocol: "ICMPv6", ICMPCode: ptr.To[int32](0)},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateEgressFirewallPorts(tc.ports)
+			if tc.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package util
+
+import (
+	"hash/fnv"
+	"math"
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+)
+
+// NormalizeEgressIP returns the canonical representation of an egress IP, as
+// used in the EgressIP status, or the IP unchanged if it cannot be parsed.
+func NormalizeEgressIP(egressIP string) string {
+	if ip := net.ParseIP(egressIP); ip != nil {
+		return ip.String()
+	}
+	return egressIP
+}
+
+// GetEgressIPsByPreference returns the egress IPs requested by the EgressIP
+// spec ordered by preference: the egress IPs listed in the strategy first, in
+// their order, followed by the remaining ones in the order of the spec.
+func GetEgressIPsByPreference(spec *egressipv1.EgressIPSpec) []string {
+	requested := make(map[string]bool, len(spec.EgressIPs))
+	for _, egressIP := range spec.EgressIPs {
+		requested[NormalizeEgressIP(egressIP)] = true
+	}
+	ordered := make([]string, 0, len(spec.EgressIPs))
+	if spec.Strategy != nil {
+		for _, preference := range spec.Strategy.EgressIPs {
+			egressIP := NormalizeEgressIP(preference.EgressIP)
+			if requested[egressIP] {
+				ordered = append(ordered, egressIP)
+				delete(requested, egressIP)
+			}
+		}
+	}
+	for _, egressIP := range spec.EgressIPs {
+		egressIP = NormalizeEgressIP(egressIP)
+		if requested[egressIP] {
+			ordered = append(ordered, egressIP)
+			delete(requested, egressIP)
+		}
+	}
+	return ordered
+}
+
+// GetEgressIPPreferredNodeSelectors returns the preferred node selectors of the
+// egress IPs of the EgressIP spec that have one, keyed by egress IP.
+func GetEgressIPPreferredNodeSelectors(spec *egressipv1.EgressIPSpec) (map[string]labels.Selector, error) {
+	if spec.Strategy == nil {
+		return nil, nil
+	}
+	selectors := map[string]labels.Selector{}
+	for _, preference := range spec.Strategy.EgressIPs {
+		if preference.PreferredNodeSelector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
+			MatchLabels:      preference.PreferredNodeSelector.MatchLabels,
+			MatchExpressions: preference.PreferredNodeSelector.MatchExpressions,
+		})
+		if err != nil {
+			return nil, err
+		}
+		selectors[NormalizeEgressIP(preference.EgressIP)] = selector
+	}
+	return selectors, nil
+}
+
+// SelectEgressIPStatusItems returns the assigned egress IPs, out of items,
+// through which the traffic of the pod identified by podKey is sent according
+// to the strategy of the EgressIP spec:
+//   - without strategy, all of them are used.
+//   - with the ActiveStandby strategy, only the most preferred one is used.
+//   - with the Weighted strategy, a single one is used. It is chosen with
+//     weighted rendezvous hashing of the pod key, so that pods are spread
+//     following the weights and only the pods using an egress IP are moved
+//     when it is assigned or unassigned.
+func SelectEgressIPStatusItems(spec *egressipv1.EgressIPSpec, podKey string, items []egressipv1.EgressIPStatusItem) []egressipv1.EgressIPStatusItem {
+	if spec.Strategy == nil || len(items) <= 1 {
+		return items
+	}
+	switch spec.Strategy.Type {
+	case egressipv1.EgressIPStrategyActiveStandby:
+		for _, egressIP := range GetEgressIPsByPreference(spec) {
+			for _, item := range items {
+				if item.EgressIP == egressIP {
+					return []egressipv1.EgressIPStatusItem{item}
+				}
+			}
+		}
+	case egressipv1.EgressIPStrategyWeighted:
+		weights := make(map[string]int32, len(spec.Strategy.EgressIPs))
+		for _, preference := range spec.Strategy.EgressIPs {
+			if preference.Weight != nil {
+				weights[NormalizeEgressIP(preference.EgressIP)] = *preference.Weight
+			}
+		}
+		var selected egressipv1.EgressIPStatusItem
+		maxScore := math.Inf(-1)
+		for _, item := range items {
+			weight, ok := weights[item.EgressIP]
+			if !ok {
+				weight = 1
+			}
+			score := rendezvousScore(podKey, item.EgressIP, weight)
+			if score > maxScore || (score == maxScore && item.EgressIP < selected.EgressIP) {
+				selected = item
+				maxScore = score
+			}
+		}
+		return []egressipv1.EgressIPStatusItem{selected}
+	}
+	return items
+}
+
+// rendezvousScore returns the weighted rendezvous hashing score of egressIP for
+// the given key.
+func rendezvousScore(key, egressIP string, weight int32) float64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(egressIP))
+	// FNV does not spread small differences of the input to the high bits of
+	// the hash: finalize it as splitmix64 does.
+	x := h.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	// map the hash to a uniformly distributed float in (0, 1)
+	u := (float64(x>>11) + 0.5) / (1 << 53)
+	return -float64(weight) / math.Log(u)
+}
@@ -0,0 +1,163 @@
+package util
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	egressipv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+)
+
+func TestGetEgressIPsByPreference(t *testing.T) {
+	testcases := []struct {
+		name     string
+		spec     egressipv1.EgressIPSpec
+		expected []string
+	}{
+		{
+			name: "without strategy the order of the spec is kept",
+			spec: egressipv1.EgressIPSpec{
+				EgressIPs: []string{"192.168.126.11", "192.168.126.10"},
+			},
+			expected: []string{"192.168.126.11", "192.168.126.10"},
+		},
+		{
+			name: "egress IPs listed in the strategy come first",
+			spec: egressipv1.EgressIPSpec{
+				EgressIPs: []string{"192.168.126.10", "192.168.126.11", "192.168.126.12"},
+				Strategy: &egressipv1.EgressIPStrategy{
+					Type: egressipv1.EgressIPStrategyActiveStandby,
+					EgressIPs: []egressipv1.EgressIPPreference{
+						{EgressIP: "192.168.126.12"},
+					},
+				},
+			},
+			expected: []string{"192.168.126.12", "192.168.126.10", "192.168.126.11"},
+		},
+		{
+			name: "egress IPs are normalized and unknown ones are ignored",
+			spec: egressipv1.EgressIPSpec{
+				EgressIPs: []string{"0:0:0:0:0:feff:c0a8:8e0c", "192.168.126.10"},
+				Strategy: &egressipv1.EgressIPStrategy{
+					Type: egressipv1.EgressIPStrategyActiveStandby,
+					EgressIPs: []egressipv1.EgressIPPreference{
+						{EgressIP: "192.168.126.20"},
+						{EgressIP: "::feff:c0a8:8e0c"},
+					},
+				},
+			},
+			expected: []string{"::feff:c0a8:8e0c", "192.168.126.10"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, GetEgressIPsByPreference(&tc.spec))
+		})
+	}
+}
+
+func TestGetEgressIPPreferredNodeSelectors(t *testing.T) {
+	spec := egressipv1.EgressIPSpec{
+		EgressIPs: []string{"192.168.126.10", "192.168.126.11"},
+		Strategy: &egressipv1.EgressIPStrategy{
+			Type: egressipv1.EgressIPStrategyActiveStandby,
+			EgressIPs: []egressipv1.EgressIPPreference{
+				{
+					EgressIP:              "192.168.126.10",
+					PreferredNodeSelector: &egressipv1.EgressIPNodeSelector{MatchLabels: map[string]string{"zone": "a"}},
+				},
+				{EgressIP: "192.168.126.11"},
+			},
+		},
+	}
+	selectors, err := GetEgressIPPreferredNodeSelectors(&spec)
+	require.NoError(t, err)
+	require.Len(t, selectors, 1)
+	assert.True(t, selectors["192.168.126.10"].Matches(labels.Set{"zone": "a"}))
+	assert.False(t, selectors["192.168.126.10"].Matches(labels.Set{"zone": "b"}))
+
+	spec.Strategy.EgressIPs[1].PreferredNodeSelector = &egressipv1.EgressIPNodeSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "zone", Operator: "bad"}},
+	}
+	_, err = GetEgressIPPreferredNodeSelectors(&spec)
+	assert.Error(t, err)
+
+	spec.Strategy = nil
+	selectors, err = GetEgressIPPreferredNodeSelectors(&spec)
+	require.NoError(t, err)
+	assert.Empty(t, selectors)
+}
+
+func TestSelectEgressIPStatusItems(t *testing.T) {
+	items := []egressipv1.EgressIPStatusItem{
+		{Node: "node1", EgressIP: "192.168.126.10"},
+		{Node: "node2", EgressIP: "192.168.126.11"},
+		{Node: "node3", EgressIP: "192.168.126.12"},
+	}
+	egressIPs := []string{"192.168.126.10", "192.168.126.11", "192.168.126.12"}
+
+	t.Run("without strategy all egress IPs are selected", func(t *testing.T) {
+		spec := egressipv1.EgressIPSpec{EgressIPs: egressIPs}
+		assert.Equal(t, items, SelectEgressIPStatusItems(&spec, "ns/pod", items))
+	})
+
+	t.Run("active standby selects the most preferred assigned egress IP", func(t *testing.T) {
+		spec := egressipv1.EgressIPSpec{
+			EgressIPs: egressIPs,
+			Strategy: &egressipv1.EgressIPStrategy{
+				Type: egressipv1.EgressIPStrategyActiveStandby,
+				EgressIPs: []egressipv1.EgressIPPreference{
+					{EgressIP: "192.168.126.11"},
+					{EgressIP: "192.168.126.12"},
+				},
+			},
+		}
+		assert.Equal(t, items[1:2], SelectEgressIPStatusItems(&spec, "ns/pod", items))
+		// the active egress IP is not assigned: the next one takes over
+		assert.Equal(t, items[2:3], SelectEgressIPStatusItems(&spec, "ns/pod", []egressipv1.EgressIPStatusItem{items[0], items[2]}))
+	})
+
+	t.Run("weighted selects a single egress IP following the weights", func(t *testing.T) {
+		spec := egressipv1.EgressIPSpec{
+			EgressIPs: egressIPs,
+			Strategy: &egressipv1.EgressIPStrategy{
+				Type: egressipv1.EgressIPStrategyWeighted,
+				EgressIPs: []egressipv1.EgressIPPreference{
+					{EgressIP: "192.168.126.10", Weight: ptr.To[int32](6)},
+					{EgressIP: "192.168.126.11", Weight: ptr.To[int32](3)},
+				},
+			},
+		}
+		const pods = 10000
+		counts := map[string]int{}
+		selections := map[string]string{}
+		for i := 0; i < pods; i++ {
+			podKey := fmt.Sprintf("ns/pod-%d", i)
+			selected := SelectEgressIPStatusItems(&spec, podKey, items)
+			require.Len(t, selected, 1)
+			counts[selected[0].EgressIP]++
+			selections[podKey] = selected[0].EgressIP
+			// the selection is stable
+			assert.Equal(t, selected, SelectEgressIPStatusItems(&spec, podKey, items))
+		}
+		// weights are 6, 3 and 1 (default)
+		assert.InDelta(t, 0.6*pods, counts["192.168.126.10"], 0.05*pods)
+		assert.InDelta(t, 0.3*pods, counts["192.168.126.11"], 0.05*pods)
+		assert.InDelta(t, 0.1*pods, counts["192.168.126.12"], 0.05*pods)
+
+		// unassigning an egress IP only moves the pods that were using it
+		for podKey, egressIP := range selections {
+			selected := SelectEgressIPStatusItems(&spec, podKey, items[:2])
+			require.Len(t, selected, 1)
+			if egressIP != "192.168.126.12" {
+				assert.Equal(t, egressIP, selected[0].EgressIP)
+			}
+		}
+	})
+}
@@ -33,6 +33,8 @@ import (
 	egressservicefake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1/apis/clientset/versioned/fake"
 	networkqos "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1"
 	networkqosfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/networkqos/v1alpha1/apis/clientset/versioned/fake"
+	observabilityconfig "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1"
+	observabilityconfigfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/observabilityconfig/v1/apis/clientset/versioned/fake"
 	routeadvertisements "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1"
 	routeadvertisementsfake "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1/apis/clientset/versioned/fake"
 	udnv1 "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/crd/userdefinednetwork/v1"
@@ -48,6 +50,7 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 	apbExternalRouteObjects := []runtime.Object{}
 	anpObjects := []runtime.Object{}
 	networkQoSObjects := []runtime.Object{}
+	observabilityConfigObjects := []runtime.Object{}
 	v1Objects := []runtime.Object{}
 	nads := []runtime.Object{}
 	cloudObjects := []runtime.Object{}
@@ -59,7 +62,7 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 		switch object.(type) {
 		case *egressip.EgressIP:
 			egressIPObjects = append(egressIPObjects, object)
-		case *egressfirewall.EgressFirewall:
+		case *egressfirewall.EgressFirewall, *egressfirewall.ClusterEgressFirewall:
 			egressFirewallObjects = append(egressFirewallObjects, object)
 		case *egressqos.EgressQoS:
 			egressQoSObjects = append(egressQoSObjects, object)
@@ -85,6 +88,8 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 			frrObjects = append(frrObjects, object)
 		case *networkqos.NetworkQoS:
 			networkQoSObjects = append(networkQoSObjects, object)
+		case *observabilityconfig.ObservabilityConfig:
+			observabilityConfigObjects = append(observabilityConfigObjects, object)
 		default:
 			v1Objects = append(v1Objects, object)
 		}
@@ -113,6 +118,7 @@ func GetOVNClientset(objects ...runtime.Object) *OVNClientset {
 		RouteAdvertisementsClient: routeadvertisementsfake.NewSimpleClientset(raObjects...),
 		FRRClient:                 frrfake.NewSimpleClientset(frrObjects...),
 		NetworkQoSClient:          networkqosfake.NewSimpleClientset(networkQoSObjects...),
+		ObservabilityConfigClient: observabilityconfigfake.NewSimpleClientset(observabilityConfigObjects...),
 	}
 }
 
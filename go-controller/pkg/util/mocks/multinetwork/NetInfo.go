@@ -6,6 +6,8 @@ import (
 	config "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
 	mock "github.com/stretchr/testify/mock"
 
+	types "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
+
 	net "net"
 
 	util "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/util"
@@ -16,6 +18,24 @@ type NetInfo struct {
 	mock.Mock
 }
 
+// AllowsMulticast provides a mock function with given fields:
+func (_m *NetInfo) AllowsMulticast() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllowsMulticast")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // AllowsPersistentIPs provides a mock function with given fields:
 func (_m *NetInfo) AllowsPersistentIPs() bool {
 	ret := _m.Called()
@@ -34,6 +54,26 @@ func (_m *NetInfo) AllowsPersistentIPs() bool {
 	return r0
 }
 
+// Bandwidth provides a mock function with given fields:
+func (_m *NetInfo) Bandwidth() *types.BandwidthConf {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Bandwidth")
+	}
+
+	var r0 *types.BandwidthConf
+	if rf, ok := ret.Get(0).(func() *types.BandwidthConf); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BandwidthConf)
+		}
+	}
+
+	return r0
+}
+
 // EqualNADs provides a mock function with given fields: nads
 func (_m *NetInfo) EqualNADs(nads ...string) bool {
 	_va := make([]interface{}, len(nads))
@@ -704,6 +744,26 @@ func (_m *NetInfo) RemoveNetworkScopeFromName(name string) string {
 	return r0
 }
 
+// ReservedSubnets provides a mock function with given fields:
+func (_m *NetInfo) ReservedSubnets() []*net.IPNet {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReservedSubnets")
+	}
+
+	var r0 []*net.IPNet
+	if rf, ok := ret.Get(0).(func() []*net.IPNet); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*net.IPNet)
+		}
+	}
+
+	return r0
+}
+
 // Subnets provides a mock function with given fields:
 func (_m *NetInfo) Subnets() []config.CIDRNetworkEntry {
 	ret := _m.Called()
@@ -760,6 +820,26 @@ func (_m *NetInfo) Vlan() uint {
 	return r0
 }
 
+// VlanTrunk provides a mock function with given fields:
+func (_m *NetInfo) VlanTrunk() *util.VLANTrunk {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for VlanTrunk")
+	}
+
+	var r0 *util.VLANTrunk
+	if rf, ok := ret.Get(0).(func() *util.VLANTrunk); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*util.VLANTrunk)
+		}
+	}
+
+	return r0
+}
+
 // NewNetInfo creates a new instance of NetInfo. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewNetInfo(t interface {
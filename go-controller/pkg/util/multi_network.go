@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -39,16 +40,22 @@ type NetInfo interface {
 	TopologyType() string
 	MTU() int
 	IPMode() (bool, bool)
-	Subnets() []config.CIDRNetworkEntry
 	ExcludeSubnets() []*net.IPNet
+	ReservedSubnets() []*net.IPNet
 	JoinSubnetV4() *net.IPNet
 	JoinSubnetV6() *net.IPNet
 	JoinSubnets() []*net.IPNet
 	Vlan() uint
+	VlanTrunk() *VLANTrunk
 	AllowsPersistentIPs() bool
+	AllowsMulticast() bool
+	Bandwidth() *ovncnitypes.BandwidthConf
 	PhysicalNetworkName() string
 
 	// dynamic information, can change over time
+	// Subnets returns the network subnets. Subnets can be appended to a
+	// running layer3 network but never changed or removed.
+	Subnets() []config.CIDRNetworkEntry
 	GetNADs() []string
 	EqualNADs(nads ...string) bool
 	HasNAD(nadName string) bool
@@ -109,6 +116,14 @@ type MutableNetInfo interface {
 	AddNADs(nadName ...string)
 	DeleteNADs(nadName ...string)
 
+	// SetSubnets sets the subnets of a secondary network, only to append new
+	// subnets to a running network
+	SetSubnets(subnets []config.CIDRNetworkEntry)
+
+	// SetBandwidth sets the default bandwidth of the pod interfaces of a
+	// secondary network
+	SetBandwidth(bandwidth *ovncnitypes.BandwidthConf)
+
 	// VRFs a pod network is being advertised on, also per node
 	SetPodNetworkAdvertisedVRFs(podAdvertisements map[string][]string)
 
@@ -220,6 +235,8 @@ type mutableNetInfo struct {
 	id int
 
 	nads                     sets.Set[string]
+	subnets                  []config.CIDRNetworkEntry
+	bandwidth                *ovncnitypes.BandwidthConf
 	podNetworkAdvertisements map[string][]string
 	eipAdvertisements        map[string][]string
 
@@ -261,6 +278,8 @@ func (l *mutableNetInfo) equals(r *mutableNetInfo) bool {
 	defer r.RUnlock()
 	return reflect.DeepEqual(l.id, r.id) &&
 		reflect.DeepEqual(l.nads, r.nads) &&
+		cmp.Equal(l.subnets, r.subnets, cmpopts.SortSlices(lessCIDRNetworkEntry), cmpopts.EquateEmpty()) &&
+		reflect.DeepEqual(l.bandwidth, r.bandwidth) &&
 		reflect.DeepEqual(l.podNetworkAdvertisements, r.podNetworkAdvertisements) &&
 		reflect.DeepEqual(l.eipAdvertisements, r.eipAdvertisements)
 }
@@ -273,6 +292,8 @@ func (l *mutableNetInfo) copyFrom(r *mutableNetInfo) {
 	r.RLock()
 	aux.id = r.id
 	aux.nads = r.nads.Clone()
+	aux.subnets = r.subnets
+	aux.bandwidth = r.bandwidth
 	aux.setPodNetworkAdvertisedOnVRFs(r.podNetworkAdvertisements)
 	aux.setEgressIPAdvertisedAtNodes(r.eipAdvertisements)
 	aux.namespaces = r.namespaces.Clone()
@@ -281,6 +302,8 @@ func (l *mutableNetInfo) copyFrom(r *mutableNetInfo) {
 	defer l.Unlock()
 	l.id = aux.id
 	l.nads = aux.nads
+	l.subnets = aux.subnets
+	l.bandwidth = aux.bandwidth
 	l.podNetworkAdvertisements = aux.podNetworkAdvertisements
 	l.eipAdvertisements = aux.eipAdvertisements
 	l.namespaces = aux.namespaces
@@ -298,6 +321,22 @@ func (nInfo *mutableNetInfo) SetNetworkID(id int) {
 	nInfo.id = id
 }
 
+// SetSubnets sets the subnets of the network. The slice is replaced as a whole
+// so that the slices returned before are never modified.
+func (nInfo *mutableNetInfo) SetSubnets(subnets []config.CIDRNetworkEntry) {
+	nInfo.Lock()
+	defer nInfo.Unlock()
+	nInfo.subnets = subnets
+}
+
+// SetBandwidth sets the default bandwidth of the pod interfaces of the network.
+// It only applies to the pods created afterwards.
+func (nInfo *mutableNetInfo) SetBandwidth(bandwidth *ovncnitypes.BandwidthConf) {
+	nInfo.Lock()
+	defer nInfo.Unlock()
+	nInfo.bandwidth = bandwidth
+}
+
 func (nInfo *mutableNetInfo) SetPodNetworkAdvertisedVRFs(podAdvertisements map[string][]string) {
 	nInfo.Lock()
 	defer nInfo.Unlock()
@@ -578,6 +617,11 @@ func (nInfo *DefaultNetInfo) ExcludeSubnets() []*net.IPNet {
 	return nil
 }
 
+// ReservedSubnets returns the defaultNetConfInfo's ReservedSubnets value
+func (nInfo *DefaultNetInfo) ReservedSubnets() []*net.IPNet {
+	return nil
+}
+
 // JoinSubnetV4 returns the defaultNetConfInfo's JoinSubnetV4 value
 // call when ipv4mode=true
 func (nInfo *DefaultNetInfo) JoinSubnetV4() *net.IPNet {
@@ -624,11 +668,28 @@ func (nInfo *DefaultNetInfo) Vlan() uint {
 	return config.Gateway.VLANID
 }
 
+// VlanTrunk returns nil since the default network is not a VLAN trunk
+func (nInfo *DefaultNetInfo) VlanTrunk() *VLANTrunk {
+	return nil
+}
+
 // AllowsPersistentIPs returns the defaultNetConfInfo's AllowPersistentIPs value
 func (nInfo *DefaultNetInfo) AllowsPersistentIPs() bool {
 	return false
 }
 
+// AllowsMulticast returns false, multicast on the default network is driven
+// by the cluster wide configuration
+func (nInfo *DefaultNetInfo) AllowsMulticast() bool {
+	return false
+}
+
+// Bandwidth returns nil, the bandwidth on the default network is driven by
+// the pod annotations
+func (nInfo *DefaultNetInfo) Bandwidth() *ovncnitypes.BandwidthConf {
+	return nil
+}
+
 // PhysicalNetworkName has no impact on defaultNetConfInfo (localnet feature)
 func (nInfo *DefaultNetInfo) PhysicalNetworkName() string {
 	return ""
@@ -645,11 +706,13 @@ type secondaryNetInfo struct {
 	topology           string
 	mtu                int
 	vlan               uint
+	vlanTrunk          *VLANTrunk
 	allowPersistentIPs bool
+	allowMulticast     bool
 
 	ipv4mode, ipv6mode bool
-	subnets            []config.CIDRNetworkEntry
 	excludeSubnets     []*net.IPNet
+	reservedSubnets    []*net.IPNet
 	joinSubnets        []*net.IPNet
 
 	physicalNetworkName string
@@ -765,11 +828,28 @@ func (nInfo *secondaryNetInfo) Vlan() uint {
 	return nInfo.vlan
 }
 
+// VlanTrunk returns the VLAN trunk configuration, nil if the network is not a VLAN trunk
+func (nInfo *secondaryNetInfo) VlanTrunk() *VLANTrunk {
+	return nInfo.vlanTrunk
+}
+
 // AllowsPersistentIPs returns the defaultNetConfInfo's AllowPersistentIPs value
 func (nInfo *secondaryNetInfo) AllowsPersistentIPs() bool {
 	return nInfo.allowPersistentIPs
 }
 
+// AllowsMulticast returns the secondaryNetInfo's Multicast value
+func (nInfo *secondaryNetInfo) AllowsMulticast() bool {
+	return nInfo.allowMulticast
+}
+
+// Bandwidth returns the secondaryNetInfo's default pod interface bandwidth
+func (nInfo *secondaryNetInfo) Bandwidth() *ovncnitypes.BandwidthConf {
+	nInfo.RLock()
+	defer nInfo.RUnlock()
+	return nInfo.bandwidth
+}
+
 // PhysicalNetworkName returns the user provided physical network name value
 func (nInfo *secondaryNetInfo) PhysicalNetworkName() string {
 	return nInfo.physicalNetworkName
@@ -782,6 +862,8 @@ func (nInfo *secondaryNetInfo) IPMode() (bool, bool) {
 
 // Subnets returns the Subnets value
 func (nInfo *secondaryNetInfo) Subnets() []config.CIDRNetworkEntry {
+	nInfo.RLock()
+	defer nInfo.RUnlock()
 	return nInfo.subnets
 }
 
@@ -790,6 +872,11 @@ func (nInfo *secondaryNetInfo) ExcludeSubnets() []*net.IPNet {
 	return nInfo.excludeSubnets
 }
 
+// ReservedSubnets returns the ReservedSubnets value
+func (nInfo *secondaryNetInfo) ReservedSubnets() []*net.IPNet {
+	return nInfo.reservedSubnets
+}
+
 // JoinSubnetV4 returns the defaultNetConfInfo's JoinSubnetV4 value
 // call when ipv4mode=true
 func (nInfo *secondaryNetInfo) JoinSubnetV4() *net.IPNet {
@@ -834,18 +921,22 @@ func (nInfo *secondaryNetInfo) canReconcile(other NetInfo) bool {
 	if nInfo.vlan != other.Vlan() {
 		return false
 	}
+	if !reflect.DeepEqual(nInfo.vlanTrunk, other.VlanTrunk()) {
+		return false
+	}
 	if nInfo.allowPersistentIPs != other.AllowsPersistentIPs() {
 		return false
 	}
+	if nInfo.allowMulticast != other.AllowsMulticast() {
+		return false
+	}
 	if nInfo.primaryNetwork != other.IsPrimaryNetwork() {
 		return false
 	}
 	if nInfo.physicalNetworkName != other.PhysicalNetworkName() {
 		return false
 	}
-
-	lessCIDRNetworkEntry := func(a, b config.CIDRNetworkEntry) bool { return a.String() < b.String() }
-	if !cmp.Equal(nInfo.subnets, other.Subnets(), cmpopts.SortSlices(lessCIDRNetworkEntry)) {
+	if !nInfo.canReconcileSubnets(other) {
 		return false
 	}
 
@@ -853,9 +944,41 @@ func (nInfo *secondaryNetInfo) canReconcile(other NetInfo) bool {
 	if !cmp.Equal(nInfo.excludeSubnets, other.ExcludeSubnets(), cmpopts.SortSlices(lessIPNet)) {
 		return false
 	}
+	if !cmp.Equal(nInfo.reservedSubnets, other.ReservedSubnets(), cmpopts.SortSlices(lessIPNet)) {
+		return false
+	}
 	return cmp.Equal(nInfo.joinSubnets, other.JoinSubnets(), cmpopts.SortSlices(lessIPNet))
 }
 
+// canReconcileSubnets checks if the other network has the same subnets or, for
+// layer3 networks, the same subnets plus some appended ones of the same IP
+// families. Other topologies allocate the pod IPs out of all their subnets so
+// their subnets can't change.
+func (nInfo *secondaryNetInfo) canReconcileSubnets(other NetInfo) bool {
+	subnets, otherSubnets := nInfo.Subnets(), other.Subnets()
+	if cmp.Equal(subnets, otherSubnets, cmpopts.SortSlices(lessCIDRNetworkEntry)) {
+		return true
+	}
+	if nInfo.topology != types.Layer3Topology || len(otherSubnets) < len(subnets) {
+		return false
+	}
+	if ipv4Mode, ipv6Mode := other.IPMode(); ipv4Mode != nInfo.ipv4mode || ipv6Mode != nInfo.ipv6mode {
+		return false
+	}
+	for _, subnet := range subnets {
+		if !slices.ContainsFunc(otherSubnets, func(otherSubnet config.CIDRNetworkEntry) bool {
+			return otherSubnet.String() == subnet.String()
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+func lessCIDRNetworkEntry(a, b config.CIDRNetworkEntry) bool {
+	return a.String() < b.String()
+}
+
 func (nInfo *secondaryNetInfo) copy() *secondaryNetInfo {
 	// everything here is immutable
 	c := &secondaryNetInfo{
@@ -864,11 +987,13 @@ func (nInfo *secondaryNetInfo) copy() *secondaryNetInfo {
 		topology:            nInfo.topology,
 		mtu:                 nInfo.mtu,
 		vlan:                nInfo.vlan,
+		vlanTrunk:           nInfo.vlanTrunk,
 		allowPersistentIPs:  nInfo.allowPersistentIPs,
+		allowMulticast:      nInfo.allowMulticast,
 		ipv4mode:            nInfo.ipv4mode,
 		ipv6mode:            nInfo.ipv6mode,
-		subnets:             nInfo.subnets,
 		excludeSubnets:      nInfo.excludeSubnets,
+		reservedSubnets:     nInfo.reservedSubnets,
 		joinSubnets:         nInfo.joinSubnets,
 		physicalNetworkName: nInfo.physicalNetworkName,
 	}
@@ -879,24 +1004,32 @@ func (nInfo *secondaryNetInfo) copy() *secondaryNetInfo {
 }
 
 func newLayer3NetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error) {
-	subnets, _, err := parseSubnets(netconf.Subnets, "", types.Layer3Topology)
+	subnets, excludes, err := parseSubnets(netconf.Subnets, netconf.ExcludeSubnets, types.Layer3Topology)
 	if err != nil {
 		return nil, err
 	}
+	reserved, err := parseReservedSubnets(netconf.ReservedSubnets, subnets, excludes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s netconf %s: %v", netconf.Topology, netconf.Name, err)
+	}
 	joinSubnets, err := parseJoinSubnet(netconf.JoinSubnet)
 	if err != nil {
 		return nil, err
 	}
 	ni := &secondaryNetInfo{
-		netName:        netconf.Name,
-		primaryNetwork: netconf.Role == types.NetworkRolePrimary,
-		topology:       types.Layer3Topology,
-		subnets:        subnets,
-		joinSubnets:    joinSubnets,
-		mtu:            netconf.MTU,
+		netName:         netconf.Name,
+		primaryNetwork:  netconf.Role == types.NetworkRolePrimary,
+		topology:        types.Layer3Topology,
+		excludeSubnets:  excludes,
+		reservedSubnets: reserved,
+		joinSubnets:     joinSubnets,
+		mtu:             netconf.MTU,
+		allowMulticast:  netconf.Multicast,
 		mutableNetInfo: mutableNetInfo{
-			id:   types.InvalidID,
-			nads: sets.Set[string]{},
+			id:        types.InvalidID,
+			nads:      sets.Set[string]{},
+			subnets:   subnets,
+			bandwidth: netconf.Bandwidth,
 		},
 	}
 	ni.ipv4mode, ni.ipv6mode = getIPMode(subnets)
@@ -908,6 +1041,10 @@ func newLayer2NetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error)
 	if err != nil {
 		return nil, fmt.Errorf("invalid %s netconf %s: %v", netconf.Topology, netconf.Name, err)
 	}
+	reserved, err := parseReservedSubnets(netconf.ReservedSubnets, subnets, excludes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s netconf %s: %v", netconf.Topology, netconf.Name, err)
+	}
 	joinSubnets, err := parseJoinSubnet(netconf.JoinSubnet)
 	if err != nil {
 		return nil, err
@@ -916,14 +1053,17 @@ func newLayer2NetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error)
 		netName:            netconf.Name,
 		primaryNetwork:     netconf.Role == types.NetworkRolePrimary,
 		topology:           types.Layer2Topology,
-		subnets:            subnets,
 		joinSubnets:        joinSubnets,
 		excludeSubnets:     excludes,
+		reservedSubnets:    reserved,
 		mtu:                netconf.MTU,
 		allowPersistentIPs: netconf.AllowPersistentIPs,
+		allowMulticast:     netconf.Multicast,
 		mutableNetInfo: mutableNetInfo{
-			id:   types.InvalidID,
-			nads: sets.Set[string]{},
+			id:        types.InvalidID,
+			nads:      sets.Set[string]{},
+			subnets:   subnets,
+			bandwidth: netconf.Bandwidth,
 		},
 	}
 	ni.ipv4mode, ni.ipv6mode = getIPMode(subnets)
@@ -935,19 +1075,33 @@ func newLocalnetNetConfInfo(netconf *ovncnitypes.NetConf) (MutableNetInfo, error
 	if err != nil {
 		return nil, fmt.Errorf("invalid %s netconf %s: %v", netconf.Topology, netconf.Name, err)
 	}
+	var vlanTrunk *VLANTrunk
+	if netconf.VLANTrunk != "" {
+		allowedVLANs, err := ParseVLANRanges(netconf.VLANTrunk)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s netconf %s: %v", netconf.Topology, netconf.Name, err)
+		}
+		vlanTrunk = &VLANTrunk{
+			AllowedVLANs:  allowedVLANs,
+			AllowUntagged: netconf.NativeVLANID != 0,
+		}
+	}
 
 	ni := &secondaryNetInfo{
 		netName:             netconf.Name,
 		topology:            types.LocalnetTopology,
-		subnets:             subnets,
 		excludeSubnets:      excludes,
 		mtu:                 netconf.MTU,
 		vlan:                uint(netconf.VLANID),
+		vlanTrunk:           vlanTrunk,
 		allowPersistentIPs:  netconf.AllowPersistentIPs,
+		allowMulticast:      netconf.Multicast,
 		physicalNetworkName: netconf.PhysicalNetworkName,
 		mutableNetInfo: mutableNetInfo{
-			id:   types.InvalidID,
-			nads: sets.Set[string]{},
+			id:        types.InvalidID,
+			nads:      sets.Set[string]{},
+			subnets:   subnets,
+			bandwidth: netconf.Bandwidth,
 		},
 	}
 	ni.ipv4mode, ni.ipv6mode = getIPMode(subnets)
@@ -1005,6 +1159,41 @@ func parseSubnets(subnetsString, excludeSubnetsString, topology string) ([]confi
 	return subnets, excludeIPNets, nil
 }
 
+// parseReservedSubnets parses the reserved subnets of a network. Reserved
+// subnets must be contained in the network subnets and must not overlap with
+// the excluded subnets.
+func parseReservedSubnets(reservedSubnetsString string, subnets []config.CIDRNetworkEntry, excludeSubnets []*net.IPNet) ([]*net.IPNet, error) {
+	if strings.TrimSpace(reservedSubnetsString) == "" {
+		return nil, nil
+	}
+	reservedSubnets, err := config.ParseClusterSubnetEntriesWithDefaults(reservedSubnetsString, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	reservedIPNets := make([]*net.IPNet, 0, len(reservedSubnets))
+	for _, reservedSubnet := range reservedSubnets {
+		found := false
+		for _, subnet := range subnets {
+			if ContainsCIDR(subnet.CIDR, reservedSubnet.CIDR) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("the provided network subnets %v do not contain reserved subnets %v",
+				subnets, reservedSubnet.CIDR)
+		}
+		for _, excludeSubnet := range excludeSubnets {
+			if excludeSubnet.Contains(reservedSubnet.CIDR.IP) || reservedSubnet.CIDR.Contains(excludeSubnet.IP) {
+				return nil, fmt.Errorf("reserved subnet %v overlaps with excluded subnet %v",
+					reservedSubnet.CIDR, excludeSubnet)
+			}
+		}
+		reservedIPNets = append(reservedIPNets, reservedSubnet.CIDR)
+	}
+	return reservedIPNets, nil
+}
+
 func parseJoinSubnet(joinSubnet string) ([]*net.IPNet, error) {
 	// assign the default values first
 	// if user provided only 1 family; we still populate the default value
@@ -1038,6 +1227,62 @@ func parseJoinSubnet(joinSubnet string) ([]*net.IPNet, error) {
 	return joinSubnets, nil
 }
 
+// VLANRange is an inclusive range of VLAN IDs
+type VLANRange struct {
+	Start uint
+	End   uint
+}
+
+func (r VLANRange) String() string {
+	if r.Start == r.End {
+		return strconv.Itoa(int(r.Start))
+	}
+	return fmt.Sprintf("%d-%d", r.Start, r.End)
+}
+
+// VLANTrunk is the VLAN trunk configuration of a localnet network
+type VLANTrunk struct {
+	// AllowedVLANs are the VLANs pods can send and receive tagged traffic for
+	AllowedVLANs []VLANRange
+	// AllowUntagged is true when the trunk has a native VLAN. OVN doesn't tag
+	// the untagged traffic with the native VLAN ID: it is forwarded as is and
+	// the physical network maps it to its native VLAN. Otherwise untagged
+	// traffic is dropped.
+	AllowUntagged bool
+}
+
+// ParseVLANRanges parses a comma-separated list of VLAN IDs and VLAN ID
+// ranges, eg. "100,200-210"
+func ParseVLANRanges(vlans string) ([]VLANRange, error) {
+	parseVLANID := func(vlan string) (uint, error) {
+		id, err := strconv.ParseUint(strings.TrimSpace(vlan), 10, 16)
+		if err != nil || id < 1 || id > 4094 {
+			return 0, fmt.Errorf("invalid VLAN ID %q, must be between 1 and 4094", vlan)
+		}
+		return uint(id), nil
+	}
+	var ranges []VLANRange
+	for _, item := range strings.Split(vlans, ",") {
+		start, end, isRange := strings.Cut(item, "-")
+		startID, err := parseVLANID(start)
+		if err != nil {
+			return nil, err
+		}
+		endID := startID
+		if isRange {
+			endID, err = parseVLANID(end)
+			if err != nil {
+				return nil, err
+			}
+			if endID < startID {
+				return nil, fmt.Errorf("invalid VLAN range %q, start is greater than end", item)
+			}
+		}
+		ranges = append(ranges, VLANRange{Start: startID, End: endID})
+	}
+	return ranges, nil
+}
+
 func getIPMode(subnets []config.CIDRNetworkEntry) (bool, bool) {
 	var ipv6Mode, ipv4Mode bool
 	for _, subnet := range subnets {
@@ -1190,6 +1435,22 @@ func ValidateNetConf(nadName string, netconf *ovncnitypes.NetConf) error {
 		return fmt.Errorf("error parsing Network Attachment Definition %s: %w", nadName, ErrorUnsupportedIPAMKey)
 	}
 
+	if netconf.VLANTrunk != "" && netconf.Topology != types.LocalnetTopology {
+		return fmt.Errorf("vlanTrunk is only supported for localnet topology")
+	}
+
+	if netconf.VLANTrunk != "" && netconf.VLANID != 0 {
+		return fmt.Errorf("vlanID and vlanTrunk are mutually exclusive")
+	}
+
+	if netconf.NativeVLANID != 0 && netconf.VLANTrunk == "" {
+		return fmt.Errorf("nativeVlanID requires vlanTrunk to be set")
+	}
+
+	if netconf.ReservedSubnets != "" && netconf.Topology == types.LocalnetTopology {
+		return fmt.Errorf("reservedSubnets is only supported for layer3 and layer2 topologies")
+	}
+
 	if netconf.JoinSubnet != "" && netconf.Topology == types.LocalnetTopology {
 		return fmt.Errorf("localnet topology does not allow specifying join-subnet as services are not supported")
 	}
@@ -1347,7 +1608,7 @@ func GetPodNADToNetworkMappingWithActiveNetwork(pod *corev1.Pod, nInfo NetInfo,
 	}
 
 	if nInfo.IsPrimaryNetwork() && AllowsPersistentIPs(nInfo) {
-		ipamClaimName, wasPersistentIPRequested := pod.Annotations[OvnUDNIPAMClaimName]
+		ipamClaimName, wasPersistentIPRequested := GetPrimaryNetworkIPAMClaimName(pod, nInfo, activeNetworkNADKey[1])
 		if wasPersistentIPRequested {
 			networkSelections[activeNetworkNADs[0]].IPAMClaimReference = ipamClaimName
 		}
@@ -1356,6 +1617,32 @@ func GetPodNADToNetworkMappingWithActiveNetwork(pod *corev1.Pod, nInfo NetInfo,
 	return true, networkSelections, nil
 }
 
+// GetPrimaryNetworkIPAMClaimName returns the name of the IPAMClaim holding the
+// persistent IPs of the pod on the primary network attached through nadName.
+// The claim is either requested explicitly through the OvnUDNIPAMClaimName
+// annotation or, for StatefulSet pods on layer2 networks, derived from the pod
+// ordinal identity when EnableStatefulSetPersistentIPs is set.
+func GetPrimaryNetworkIPAMClaimName(pod *corev1.Pod, nInfo NetInfo, nadName string) (string, bool) {
+	if ipamClaimName, ok := pod.Annotations[OvnUDNIPAMClaimName]; ok {
+		return ipamClaimName, true
+	}
+	if !IsStatefulSetPersistentIPsEnabled() || nInfo.TopologyType() != types.Layer2Topology {
+		return "", false
+	}
+	if _, _, isStatefulSetPod := GetStatefulSetPodOrdinal(pod); !isStatefulSetPod {
+		return "", false
+	}
+	return GetStatefulSetIPAMClaimName(pod.Name, nadName), true
+}
+
+// IsStatefulSetPersistentIPsEnabled tells whether ovnkube manages the
+// IPAMClaims of StatefulSet pods. The claims are managed by the cluster
+// manager, which only allocates the pod IPs in interconnect mode.
+func IsStatefulSetPersistentIPsEnabled() bool {
+	return config.OVNKubernetesFeature.EnablePersistentIPs && config.OVNKubernetesFeature.EnableStatefulSetPersistentIPs &&
+		config.OVNKubernetesFeature.EnableInterconnect
+}
+
 func IsMultiNetworkPoliciesSupportEnabled() bool {
 	return config.OVNKubernetesFeature.EnableMultiNetwork && config.OVNKubernetesFeature.EnableMultiNetworkPolicy
 }
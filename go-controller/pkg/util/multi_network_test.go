@@ -11,6 +11,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	ovncnitypes "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/cni/types"
 	"github.com/ovn-org/ovn-kubernetes/go-controller/pkg/config"
@@ -859,6 +860,9 @@ func TestGetPodNADToNetworkMappingWithActiveNetwork(t *testing.T) {
 		inputNetConf                     *ovncnitypes.NetConf
 		inputPrimaryUDNConfig            *ovncnitypes.NetConf
 		inputPodAnnotations              map[string]string
+		inputPodName                     string
+		inputPodOwner                    *metav1.OwnerReference
+		enableStatefulSetPersistentIPs   bool
 		expectedError                    error
 		expectedIsAttachmentRequested    bool
 		expectedNetworkSelectionElements map[string]*nadv1.NetworkSelectionElement
@@ -956,6 +960,91 @@ func TestGetPodNADToNetworkMappingWithActiveNetwork(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "the network configuration for a primary layer2 UDN features allow persistent IPs, and the pod is a StatefulSet member",
+			inputNetConf: &ovncnitypes.NetConf{
+				NetConf:            cnitypes.NetConf{Name: networkName},
+				Topology:           ovntypes.Layer2Topology,
+				NADName:            GetNADName(namespaceName, attachmentName),
+				Role:               ovntypes.NetworkRolePrimary,
+				AllowPersistentIPs: true,
+			},
+			inputPrimaryUDNConfig: &ovncnitypes.NetConf{
+				NetConf:            cnitypes.NetConf{Name: networkName},
+				Topology:           ovntypes.Layer2Topology,
+				NADName:            GetNADName(namespaceName, attachmentName),
+				Role:               ovntypes.NetworkRolePrimary,
+				AllowPersistentIPs: true,
+			},
+			inputPodName:                   "web-0",
+			inputPodOwner:                  &metav1.OwnerReference{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "web", Controller: ptr.To(true)},
+			enableStatefulSetPersistentIPs: true,
+			expectedIsAttachmentRequested:  true,
+			expectedNetworkSelectionElements: map[string]*nadv1.NetworkSelectionElement{
+				"ns1/attachment1": {
+					Name:               "attachment1",
+					Namespace:          "ns1",
+					IPAMClaimReference: "web-0.attachment1",
+				},
+			},
+		},
+		{
+			desc: "the network configuration for a primary layer2 UDN features allow persistent IPs, and the StatefulSet pod requests its own claim",
+			inputNetConf: &ovncnitypes.NetConf{
+				NetConf:            cnitypes.NetConf{Name: networkName},
+				Topology:           ovntypes.Layer2Topology,
+				NADName:            GetNADName(namespaceName, attachmentName),
+				Role:               ovntypes.NetworkRolePrimary,
+				AllowPersistentIPs: true,
+			},
+			inputPrimaryUDNConfig: &ovncnitypes.NetConf{
+				NetConf:            cnitypes.NetConf{Name: networkName},
+				Topology:           ovntypes.Layer2Topology,
+				NADName:            GetNADName(namespaceName, attachmentName),
+				Role:               ovntypes.NetworkRolePrimary,
+				AllowPersistentIPs: true,
+			},
+			inputPodAnnotations: map[string]string{
+				OvnUDNIPAMClaimName: "the-one-to-the-left-of-the-pony",
+			},
+			inputPodName:                   "web-0",
+			inputPodOwner:                  &metav1.OwnerReference{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "web", Controller: ptr.To(true)},
+			enableStatefulSetPersistentIPs: true,
+			expectedIsAttachmentRequested:  true,
+			expectedNetworkSelectionElements: map[string]*nadv1.NetworkSelectionElement{
+				"ns1/attachment1": {
+					Name:               "attachment1",
+					Namespace:          "ns1",
+					IPAMClaimReference: "the-one-to-the-left-of-the-pony",
+				},
+			},
+		},
+		{
+			desc: "the network configuration for a primary layer2 UDN features allow persistent IPs, but StatefulSet persistent IPs are disabled",
+			inputNetConf: &ovncnitypes.NetConf{
+				NetConf:            cnitypes.NetConf{Name: networkName},
+				Topology:           ovntypes.Layer2Topology,
+				NADName:            GetNADName(namespaceName, attachmentName),
+				Role:               ovntypes.NetworkRolePrimary,
+				AllowPersistentIPs: true,
+			},
+			inputPrimaryUDNConfig: &ovncnitypes.NetConf{
+				NetConf:            cnitypes.NetConf{Name: networkName},
+				Topology:           ovntypes.Layer2Topology,
+				NADName:            GetNADName(namespaceName, attachmentName),
+				Role:               ovntypes.NetworkRolePrimary,
+				AllowPersistentIPs: true,
+			},
+			inputPodName:                  "web-0",
+			inputPodOwner:                 &metav1.OwnerReference{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "web", Controller: ptr.To(true)},
+			expectedIsAttachmentRequested: true,
+			expectedNetworkSelectionElements: map[string]*nadv1.NetworkSelectionElement{
+				"ns1/attachment1": {
+					Name:      "attachment1",
+					Namespace: "ns1",
+				},
+			},
+		},
 		{
 			desc: "the network configuration for a secondary layer2 UDN features allow persistent IPs and the pod requests it",
 			inputNetConf: &ovncnitypes.NetConf{
@@ -1034,13 +1123,30 @@ func TestGetPodNADToNetworkMappingWithActiveNetwork(t *testing.T) {
 				}
 			}
 
+			enableInterconnect := config.OVNKubernetesFeature.EnableInterconnect
+			config.OVNKubernetesFeature.EnablePersistentIPs = test.enableStatefulSetPersistentIPs
+			config.OVNKubernetesFeature.EnableStatefulSetPersistentIPs = test.enableStatefulSetPersistentIPs
+			config.OVNKubernetesFeature.EnableInterconnect = test.enableStatefulSetPersistentIPs
+			defer func() {
+				config.OVNKubernetesFeature.EnablePersistentIPs = false
+				config.OVNKubernetesFeature.EnableStatefulSetPersistentIPs = false
+				config.OVNKubernetesFeature.EnableInterconnect = enableInterconnect
+			}()
+
+			podName := "test-pod"
+			if test.inputPodName != "" {
+				podName = test.inputPodName
+			}
 			pod := &corev1.Pod{
 				ObjectMeta: metav1.ObjectMeta{
-					Name:        "test-pod",
+					Name:        podName,
 					Namespace:   test.inputNamespace,
 					Annotations: test.inputPodAnnotations,
 				},
 			}
+			if test.inputPodOwner != nil {
+				pod.OwnerReferences = []metav1.OwnerReference{*test.inputPodOwner}
+			}
 
 			isAttachmentRequested, networkSelectionElements, err := GetPodNADToNetworkMappingWithActiveNetwork(
 				pod,
@@ -1261,6 +1367,20 @@ func TestNewNetInfo(t *testing.T) {
 }
 
 func TestAreNetworksCompatible(t *testing.T) {
+	config.IPv4Mode = true
+	config.IPv6Mode = true
+	networkWithSubnets := func(topology, subnets string) NetInfo {
+		netInfo, err := NewNetInfo(&ovncnitypes.NetConf{
+			NetConf:  cnitypes.NetConf{Name: "network"},
+			Topology: topology,
+			Role:     ovntypes.NetworkRoleSecondary,
+			Subnets:  subnets,
+		})
+		if err != nil {
+			t.Fatalf("Failed to create network: %v", err)
+		}
+		return netInfo
+	}
 	tests := []struct {
 		desc                   string
 		aNetwork               NetInfo
@@ -1275,6 +1395,59 @@ func TestAreNetworksCompatible(t *testing.T) {
 			expectedResult:         false,
 			expectationDescription: "we should reconcile on physical network name updates",
 		},
+		{
+			desc:                   "layer3 subnets reordered",
+			aNetwork:               networkWithSubnets(ovntypes.Layer3Topology, "192.168.0.0/16/24, fda6::/48/64"),
+			anotherNetwork:         networkWithSubnets(ovntypes.Layer3Topology, "fda6::/48/64, 192.168.0.0/16/24"),
+			expectedResult:         true,
+			expectationDescription: "the order of the subnets does not matter",
+		},
+		{
+			desc:                   "layer3 subnet appended",
+			aNetwork:               networkWithSubnets(ovntypes.Layer3Topology, "192.168.0.0/16/24"),
+			anotherNetwork:         networkWithSubnets(ovntypes.Layer3Topology, "192.168.0.0/16/24, 10.10.0.0/16/26"),
+			expectedResult:         true,
+			expectationDescription: "subnets can be appended to layer3 networks",
+		},
+		{
+			desc:                   "layer3 subnet removed",
+			aNetwork:               networkWithSubnets(ovntypes.Layer3Topology, "192.168.0.0/16/24, 10.10.0.0/16/26"),
+			anotherNetwork:         networkWithSubnets(ovntypes.Layer3Topology, "192.168.0.0/16/24"),
+			expectedResult:         false,
+			expectationDescription: "subnets can't be removed from layer3 networks",
+		},
+		{
+			desc:                   "layer3 host subnet length changed",
+			aNetwork:               networkWithSubnets(ovntypes.Layer3Topology, "192.168.0.0/16/24"),
+			anotherNetwork:         networkWithSubnets(ovntypes.Layer3Topology, "192.168.0.0/16/25, 10.10.0.0/16/24"),
+			expectedResult:         false,
+			expectationDescription: "subnets can't be changed on layer3 networks",
+		},
+		{
+			desc:                   "layer3 subnet of another IP family appended",
+			aNetwork:               networkWithSubnets(ovntypes.Layer3Topology, "192.168.0.0/16/24"),
+			anotherNetwork:         networkWithSubnets(ovntypes.Layer3Topology, "192.168.0.0/16/24, fda6::/48/64"),
+			expectedResult:         false,
+			expectationDescription: "the IP families of a layer3 network can't change",
+		},
+		{
+			desc:                   "layer2 subnet appended",
+			aNetwork:               networkWithSubnets(ovntypes.Layer2Topology, "192.168.0.0/16"),
+			anotherNetwork:         networkWithSubnets(ovntypes.Layer2Topology, "192.168.0.0/16, 10.10.0.0/16"),
+			expectedResult:         false,
+			expectationDescription: "subnets can't be appended to layer2 networks",
+		},
+		{
+			desc: "bandwidth update",
+			aNetwork: &secondaryNetInfo{
+				mutableNetInfo: mutableNetInfo{bandwidth: &ovncnitypes.BandwidthConf{IngressRate: 1000}},
+			},
+			anotherNetwork: &secondaryNetInfo{
+				mutableNetInfo: mutableNetInfo{bandwidth: &ovncnitypes.BandwidthConf{IngressRate: 2000}},
+			},
+			expectedResult:         true,
+			expectationDescription: "the bandwidth only applies to new pods and is reconciled in place",
+		},
 	}
 
 	for _, test := range tests {
@@ -1297,3 +1470,191 @@ func applyNADDefaults(nad *nadv1.NetworkAttachmentDefinition) *nadv1.NetworkAtta
 	nad.Namespace = namespace
 	return nad
 }
+
+func TestParseVLANRanges(t *testing.T) {
+	tests := []struct {
+		desc           string
+		vlans          string
+		expectedRanges []VLANRange
+		expectedError  string
+	}{
+		{
+			desc:           "single VLAN ID",
+			vlans:          "100",
+			expectedRanges: []VLANRange{{Start: 100, End: 100}},
+		},
+		{
+			desc:           "VLAN IDs and ranges",
+			vlans:          "100, 200-210,4094",
+			expectedRanges: []VLANRange{{Start: 100, End: 100}, {Start: 200, End: 210}, {Start: 4094, End: 4094}},
+		},
+		{
+			desc:          "VLAN ID out of range",
+			vlans:         "4095",
+			expectedError: `invalid VLAN ID "4095", must be between 1 and 4094`,
+		},
+		{
+			desc:          "VLAN ID zero",
+			vlans:         "0-10",
+			expectedError: `invalid VLAN ID "0", must be between 1 and 4094`,
+		},
+		{
+			desc:          "VLAN range start greater than end",
+			vlans:         "210-200",
+			expectedError: `invalid VLAN range "210-200", start is greater than end`,
+		},
+		{
+			desc:          "not a VLAN ID",
+			vlans:         "100,foo",
+			expectedError: `invalid VLAN ID "foo", must be between 1 and 4094`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			ranges, err := ParseVLANRanges(test.vlans)
+			if test.expectedError != "" {
+				g.Expect(err).To(gomega.MatchError(test.expectedError))
+				return
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(ranges).To(gomega.Equal(test.expectedRanges))
+		})
+	}
+}
+
+func TestLocalnetVLANTrunk(t *testing.T) {
+	tests := []struct {
+		desc              string
+		vlanID            int
+		vlanTrunk         string
+		nativeVLANID      int
+		expectedVLANTrunk *VLANTrunk
+		expectedError     string
+	}{
+		{
+			desc: "no trunk",
+		},
+		{
+			desc:         "trunk with native VLAN",
+			vlanTrunk:    "100,200-210",
+			nativeVLANID: 10,
+			expectedVLANTrunk: &VLANTrunk{
+				AllowedVLANs:  []VLANRange{{Start: 100, End: 100}, {Start: 200, End: 210}},
+				AllowUntagged: true,
+			},
+		},
+		{
+			desc:          "trunk and access VLAN",
+			vlanID:        10,
+			vlanTrunk:     "100",
+			expectedError: "vlanID and vlanTrunk are mutually exclusive",
+		},
+		{
+			desc:          "native VLAN without trunk",
+			nativeVLANID:  10,
+			expectedError: "nativeVlanID requires vlanTrunk to be set",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			netconf := &ovncnitypes.NetConf{
+				NetConf:      cnitypes.NetConf{Name: "localnet-network"},
+				Topology:     ovntypes.LocalnetTopology,
+				NADName:      "ns1/nad1",
+				VLANID:       test.vlanID,
+				VLANTrunk:    test.vlanTrunk,
+				NativeVLANID: test.nativeVLANID,
+			}
+			err := ValidateNetConf("ns1/nad1", netconf)
+			if test.expectedError != "" {
+				g.Expect(err).To(gomega.MatchError(test.expectedError))
+				return
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			netInfo, err := NewNetInfo(netconf)
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+			g.Expect(netInfo.VlanTrunk()).To(gomega.Equal(test.expectedVLANTrunk))
+		})
+	}
+}
+
+func TestReservedSubnets(t *testing.T) {
+	tests := []struct {
+		desc                    string
+		topology                string
+		subnets                 string
+		excludeSubnets          string
+		reservedSubnets         string
+		expectedExcludeSubnets  []string
+		expectedReservedSubnets []string
+		expectedError           string
+	}{
+		{
+			desc:                    "layer3 with excluded and reserved subnets",
+			topology:                ovntypes.Layer3Topology,
+			subnets:                 "10.1.0.0/16/24",
+			excludeSubnets:          "10.1.0.0/28",
+			reservedSubnets:         "10.1.10.0/24,10.1.11.5/32",
+			expectedExcludeSubnets:  []string{"10.1.0.0/28"},
+			expectedReservedSubnets: []string{"10.1.10.0/24", "10.1.11.5/32"},
+		},
+		{
+			desc:                    "layer2 with reserved subnets",
+			topology:                ovntypes.Layer2Topology,
+			subnets:                 "10.1.130.0/24",
+			reservedSubnets:         "10.1.130.128/25",
+			expectedReservedSubnets: []string{"10.1.130.128/25"},
+		},
+		{
+			desc:            "reserved subnets not contained in subnets",
+			topology:        ovntypes.Layer2Topology,
+			subnets:         "10.1.130.0/24",
+			reservedSubnets: "10.1.131.0/28",
+			expectedError:   "the provided network subnets [10.1.130.0/24/0] do not contain reserved subnets 10.1.131.0/28",
+		},
+		{
+			desc:            "reserved subnets overlapping with excluded subnets",
+			topology:        ovntypes.Layer2Topology,
+			subnets:         "10.1.130.0/24",
+			excludeSubnets:  "10.1.130.0/28",
+			reservedSubnets: "10.1.130.8/29",
+			expectedError:   "reserved subnet 10.1.130.8/29 overlaps with excluded subnet 10.1.130.0/28",
+		},
+		{
+			desc:            "reserved subnets on localnet",
+			topology:        ovntypes.LocalnetTopology,
+			subnets:         "10.1.130.0/24",
+			reservedSubnets: "10.1.130.128/25",
+			expectedError:   "reservedSubnets is only supported for layer3 and layer2 topologies",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+			netconf := &ovncnitypes.NetConf{
+				NetConf:         cnitypes.NetConf{Name: "test-network"},
+				Topology:        test.topology,
+				NADName:         "ns1/nad1",
+				Subnets:         test.subnets,
+				ExcludeSubnets:  test.excludeSubnets,
+				ReservedSubnets: test.reservedSubnets,
+			}
+			err := ValidateNetConf("ns1/nad1", netconf)
+			if err == nil {
+				var netInfo NetInfo
+				netInfo, err = NewNetInfo(netconf)
+				if err == nil {
+					g.Expect(StringSlice(netInfo.ExcludeSubnets())).To(gomega.ConsistOf(test.expectedExcludeSubnets))
+					g.Expect(StringSlice(netInfo.ReservedSubnets())).To(gomega.ConsistOf(test.expectedReservedSubnets))
+				}
+			}
+			if test.expectedError != "" {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(test.expectedError)))
+				return
+			}
+			g.Expect(err).ToNot(gomega.HaveOccurred())
+		})
+	}
+}
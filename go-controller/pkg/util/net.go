@@ -285,6 +285,23 @@ func ContainsCIDR(ipnet1, ipnet2 *net.IPNet) bool {
 	return mask1 <= mask2 && ipnet1.Contains(ipnet2.IP)
 }
 
+// IntersectCIDRs returns the parts of ipnets that fall within any of the
+// containers. As CIDRs are either nested or disjoint, each returned CIDR is
+// either one of ipnets or one of the containers.
+func IntersectCIDRs(containers []*net.IPNet, ipnets ...*net.IPNet) []*net.IPNet {
+	var intersection []*net.IPNet
+	for _, ipnet := range ipnets {
+		for _, container := range containers {
+			if ContainsCIDR(container, ipnet) {
+				intersection = append(intersection, ipnet)
+			} else if ContainsCIDR(ipnet, container) {
+				intersection = append(intersection, container)
+			}
+		}
+	}
+	return intersection
+}
+
 // ParseIPNets parses the provided string formatted CIDRs
 func ParseIPNets(strs []string) ([]*net.IPNet, error) {
 	ipnets := make([]*net.IPNet, len(strs))
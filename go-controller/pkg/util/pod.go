@@ -2,9 +2,12 @@ package util
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
@@ -64,3 +67,30 @@ func UpdatePodWithRetryOrRollback(podLister listers.PodLister, kube kube.Interfa
 
 	return nil
 }
+
+// GetStatefulSetPodOrdinal returns the name of the StatefulSet controlling the
+// pod and the ordinal of the pod within it. The last return value is false if
+// the pod is not controlled by a StatefulSet.
+func GetStatefulSetPodOrdinal(pod *corev1.Pod) (string, int, bool) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != "StatefulSet" || !strings.HasPrefix(owner.APIVersion, "apps/") {
+		return "", 0, false
+	}
+	// StatefulSet pods are named <statefulset name>-<ordinal>
+	suffix, found := strings.CutPrefix(pod.Name, owner.Name+"-")
+	if !found {
+		return "", 0, false
+	}
+	ordinal, err := strconv.Atoi(suffix)
+	if err != nil || ordinal < 0 {
+		return "", 0, false
+	}
+	return owner.Name, ordinal, true
+}
+
+// GetStatefulSetIPAMClaimName returns the name of the IPAMClaim persisting the
+// IPs of a StatefulSet pod on the given NAD. It only depends on the pod name,
+// which is derived from the pod ordinal, so that the claim outlives the pod.
+func GetStatefulSetIPAMClaimName(podName, nadName string) string {
+	return podName + "." + nadName
+}
@@ -698,6 +698,51 @@ func AddRoutesGatewayIP(
 	return nil
 }
 
+// AddMissingClusterSubnetRoutes adds to the provided pod annotation the routes
+// towards the cluster subnets appended to the network after the annotation was
+// allocated. It returns whether any route was added. Only the default network
+// and layer3 networks have routes towards their cluster subnets, and only the
+// IP families that already have a route towards one of them are completed.
+func AddMissingClusterSubnetRoutes(netinfo NetInfo, podAnnotation *PodAnnotation) bool {
+	if netinfo.TopologyType() != types.Layer3Topology && !netinfo.IsDefault() {
+		return false
+	}
+	routes := sets.New[string]()
+	for _, route := range podAnnotation.Routes {
+		routes.Insert(route.Dest.String())
+	}
+	var added bool
+	for _, podIfAddr := range podAnnotation.IPs {
+		isIPv6 := utilnet.IsIPv6CIDR(podIfAddr)
+		var clusterSubnets []*net.IPNet
+		var hasClusterSubnetRoute bool
+		for _, clusterSubnet := range netinfo.Subnets() {
+			if isIPv6 != utilnet.IsIPv6CIDR(clusterSubnet.CIDR) {
+				continue
+			}
+			if routes.Has(clusterSubnet.CIDR.String()) {
+				hasClusterSubnetRoute = true
+				continue
+			}
+			clusterSubnets = append(clusterSubnets, clusterSubnet.CIDR)
+		}
+		// annotations without routes towards the original cluster subnets
+		// were not allocated with them, leave them alone
+		if !hasClusterSubnetRoute {
+			continue
+		}
+		gatewayIPnet := GetNodeGatewayIfAddr(IPsToNetworkIPs(podIfAddr)[0])
+		for _, clusterSubnet := range clusterSubnets {
+			podAnnotation.Routes = append(podAnnotation.Routes, PodRoute{
+				Dest:    clusterSubnet,
+				NextHop: gatewayIPnet.IP,
+			})
+			added = true
+		}
+	}
+	return added
+}
+
 // UnmarshalUDNOpenPortsAnnotation returns the OpenPorts from the pod annotation. If annotation is not present,
 // empty list with no error is returned.
 func UnmarshalUDNOpenPortsAnnotation(annotations map[string]string) ([]*OpenPort, error) {
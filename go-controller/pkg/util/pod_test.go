@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	kubemocks "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/kube/mocks"
 	v1mocks "github.com/ovn-org/ovn-kubernetes/go-controller/pkg/testing/mocks/k8s.io/client-go/listers/core/v1"
@@ -114,3 +116,56 @@ func TestUpdatePodWithAllocationOrRollback(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStatefulSetPodOrdinal(t *testing.T) {
+	stsOwner := metav1.OwnerReference{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "web", Controller: ptr.To(true)}
+	tests := []struct {
+		name            string
+		podName         string
+		owner           *metav1.OwnerReference
+		expectStsName   string
+		expectOrdinal   int
+		expectStsMember bool
+	}{
+		{
+			name:            "StatefulSet pod",
+			podName:         "web-3",
+			owner:           &stsOwner,
+			expectStsName:   "web",
+			expectOrdinal:   3,
+			expectStsMember: true,
+		},
+		{
+			name:    "pod without controller",
+			podName: "web-3",
+		},
+		{
+			name:    "pod controlled by a ReplicaSet",
+			podName: "web-3",
+			owner:   &metav1.OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web", Controller: ptr.To(true)},
+		},
+		{
+			name:    "pod name not derived from the StatefulSet name",
+			podName: "other-3",
+			owner:   &stsOwner,
+		},
+		{
+			name:    "pod name without ordinal",
+			podName: "web-abc",
+			owner:   &stsOwner,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: tt.podName}}
+			if tt.owner != nil {
+				pod.OwnerReferences = []metav1.OwnerReference{*tt.owner}
+			}
+			stsName, ordinal, isStsMember := GetStatefulSetPodOrdinal(pod)
+			if isStsMember != tt.expectStsMember || stsName != tt.expectStsName || ordinal != tt.expectOrdinal {
+				t.Errorf("GetStatefulSetPodOrdinal() = (%q, %d, %v), expected (%q, %d, %v)",
+					stsName, ordinal, isStsMember, tt.expectStsName, tt.expectOrdinal, tt.expectStsMember)
+			}
+		})
+	}
+}